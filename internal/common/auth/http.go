@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/pkg/errors"
+)
+
+// User is the identity of the caller, taken from a verified token.
+type User struct {
+	UUID        string
+	Email       string
+	Role        string
+	DisplayName string
+}
+
+// Middleware authenticates incoming requests. Implementations put the
+// authenticated User into the request context.
+type Middleware interface {
+	Middleware(next http.Handler) http.Handler
+}
+
+// JWTHttpMiddleware verifies bearer tokens signed with the configured keys.
+// Requests without a token pass through anonymously, so public endpoints keep
+// working; handlers that need a caller use UserFromCtx.
+type JWTHttpMiddleware struct {
+	Keys *KeySet
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	Name  string `json:"name"`
+}
+
+func (a JWTHttpMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearerToken := a.tokenFromHeader(r)
+		if bearerToken == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, err := jwt.ParseWithClaims(
+			bearerToken,
+			&claims{},
+			a.Keys.keyFunc,
+			jwt.WithValidMethods(a.Keys.methods()),
+			jwt.WithExpirationRequired(),
+		)
+		if err != nil {
+			httperr.Unauthorised("unable-to-verify-jwt", err, w, r)
+			return
+		}
+
+		tokenClaims, ok := token.Claims.(*claims)
+		if !ok || tokenClaims.Subject == "" {
+			httperr.Unauthorised("invalid-jwt-claims", errors.New("token has no subject"), w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userContextKey, User{
+			UUID:        tokenClaims.Subject,
+			Email:       tokenClaims.Email,
+			Role:        tokenClaims.Role,
+			DisplayName: tokenClaims.Name,
+		})
+		r = r.WithContext(ctx)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a JWTHttpMiddleware) tokenFromHeader(r *http.Request) string {
+	headerValue := r.Header.Get("Authorization")
+
+	if len(headerValue) > 7 && strings.EqualFold(headerValue[0:7], "bearer ") {
+		return headerValue[7:]
+	}
+
+	return ""
+}
+
+type ctxKey int
+
+const (
+	userContextKey ctxKey = iota
+)
+
+var (
+	// if we expect that the user of the function may be interested with concrete error,
+	// it's a good idea to provide variable with this error
+	NoUserInContextError = commonerrors.NewAuthorizationError("no user in context", "no-user-found")
+)
+
+func UserFromCtx(ctx context.Context) (User, error) {
+	u, ok := ctx.Value(userContextKey).(User)
+	if ok {
+		return u, nil
+	}
+
+	return User{}, NoUserInContextError
+}
+
+// ContextWithUser returns a copy of ctx carrying the user. It is meant for
+// tests and for alternative Middleware implementations.
+func ContextWithUser(ctx context.Context, u User) context.Context {
+	return context.WithValue(ctx, userContextKey, u)
+}
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maixuanbach174/online-course-app/internal/common/logs"
+	"github.com/sirupsen/logrus"
+)
+
+const testSecret = "test-secret"
+
+func TestJWTHttpMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rsaKey := generateRSAKey(t)
+	otherRSAKey := generateRSAKey(t)
+	jwksFile := writeJWKS(t, "key-1", &rsaKey.PublicKey)
+
+	bothKeys, err := NewKeySet(testSecret, jwksFile)
+	if err != nil {
+		t.Fatalf("failed to create key set: %v", err)
+	}
+	rsaOnlyKeys, err := NewKeySet("", jwksFile)
+	if err != nil {
+		t.Fatalf("failed to create key set: %v", err)
+	}
+
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: marshalPublicKey(t, &rsaKey.PublicKey)})
+	valid := testClaims("user-1", time.Now().Add(time.Hour))
+	expired := testClaims("user-1", time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name       string
+		keys       *KeySet
+		header     string
+		wantStatus int
+		wantUser   string
+	}{
+		{"no header passes through anonymously", bothKeys, "", http.StatusOK, ""},
+		{"non-bearer header passes through anonymously", bothKeys, "Basic dXNlcjpwYXNz", http.StatusOK, ""},
+		{"valid HS256 token", bothKeys, "Bearer " + signHS256(t, []byte(testSecret), valid), http.StatusOK, "user-1"},
+		{"valid RS256 token", bothKeys, "Bearer " + signRS256(t, rsaKey, "key-1", valid), http.StatusOK, "user-1"},
+		{"lowercase bearer scheme", bothKeys, "bearer " + signHS256(t, []byte(testSecret), valid), http.StatusOK, "user-1"},
+		{"expired HS256 token", bothKeys, "Bearer " + signHS256(t, []byte(testSecret), expired), http.StatusUnauthorized, ""},
+		{"expired RS256 token", bothKeys, "Bearer " + signRS256(t, rsaKey, "key-1", expired), http.StatusUnauthorized, ""},
+		{"token without expiry", bothKeys, "Bearer " + signHS256(t, []byte(testSecret), testClaims("user-1", time.Time{})), http.StatusUnauthorized, ""},
+		{"HS256 token with wrong secret", bothKeys, "Bearer " + signHS256(t, []byte("other-secret"), valid), http.StatusUnauthorized, ""},
+		{"RS256 token signed by another key", bothKeys, "Bearer " + signRS256(t, otherRSAKey, "key-1", valid), http.StatusUnauthorized, ""},
+		{"HS256 token signed with the RSA public key", rsaOnlyKeys, "Bearer " + signHS256(t, publicPEM, valid), http.StatusUnauthorized, ""},
+		{"HS256 token signed with the RSA public key and a secret configured", bothKeys, "Bearer " + signHS256(t, publicPEM, valid), http.StatusUnauthorized, ""},
+		{"RS256 token with unknown kid", bothKeys, "Bearer " + signRS256(t, rsaKey, "key-2", valid), http.StatusUnauthorized, ""},
+		{"RS256 token without kid", bothKeys, "Bearer " + signRS256(t, rsaKey, "", valid), http.StatusUnauthorized, ""},
+		{"token without subject", bothKeys, "Bearer " + signHS256(t, []byte(testSecret), testClaims("", time.Now().Add(time.Hour))), http.StatusUnauthorized, ""},
+		{"malformed token", bothKeys, "Bearer not-a-token", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUser *User
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if u, err := UserFromCtx(r.Context()); err == nil {
+					gotUser = &u
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			// Errors are responded through the request logger, like in the server
+			quiet := logrus.New()
+			quiet.SetOutput(io.Discard)
+			handler := logs.NewStructuredLogger(quiet)(JWTHttpMiddleware{Keys: tt.keys}.Middleware(next))
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantUser == "" {
+				if gotUser != nil {
+					t.Errorf("expected anonymous request, got user '%s'", gotUser.UUID)
+				}
+				return
+			}
+			if gotUser == nil {
+				t.Fatal("expected user in context, got none")
+			}
+			if gotUser.UUID != tt.wantUser || gotUser.Email != "user@example.com" || gotUser.Role != "student" || gotUser.DisplayName != "Test User" {
+				t.Errorf("unexpected user %+v", *gotUser)
+			}
+		})
+	}
+}
+
+func TestNewKeySet(t *testing.T) {
+	t.Parallel()
+
+	rsaKey := generateRSAKey(t)
+	dir := t.TempDir()
+
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name        string
+		secret      string
+		jwksFile    string
+		wantErr     bool
+		wantMethods []string
+	}{
+		{"secret only", testSecret, "", false, []string{"HS256"}},
+		{"JWKS only", "", writeJWKS(t, "key-1", &rsaKey.PublicKey), false, []string{"RS256"}},
+		{"secret and JWKS", testSecret, writeJWKS(t, "key-1", &rsaKey.PublicKey), false, []string{"HS256", "RS256"}},
+		{"neither secret nor JWKS", "", "", true, nil},
+		{"missing JWKS file", "", filepath.Join(dir, "missing.json"), true, nil},
+		{"invalid JWKS", "", writeFile("invalid.json", "{"), true, nil},
+		{"JWKS without RS256 signing keys", "", writeFile("enc.json", `{"keys":[{"kty":"RSA","kid":"k","use":"enc","n":"AQAB","e":"AQAB"}]}`), true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := NewKeySet(tt.secret, tt.jwksFile)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			methods := keys.methods()
+			if len(methods) != len(tt.wantMethods) {
+				t.Fatalf("expected methods %v, got %v", tt.wantMethods, methods)
+			}
+			for i := range methods {
+				if methods[i] != tt.wantMethods[i] {
+					t.Errorf("expected methods %v, got %v", tt.wantMethods, methods)
+				}
+			}
+		})
+	}
+}
+
+func testClaims(subject string, expiresAt time.Time) claims {
+	c := claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: subject},
+		Email:            "user@example.com",
+		Role:             "student",
+		Name:             "Test User",
+	}
+	if !expiresAt.IsZero() {
+		c.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	}
+	return c
+}
+
+func signHS256(t *testing.T, secret []byte, c claims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(secret)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, c claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	return key
+}
+
+func marshalPublicKey(t *testing.T, key *rsa.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	return der
+}
+
+// writeJWKS writes a JWKS file holding the public key under kid and returns its path
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+
+	data, err := json.Marshal(jwks{Keys: []jwk{{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+	return path
+}
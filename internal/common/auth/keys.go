@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// KeySet holds the keys accepted when verifying tokens: an optional HS256
+// shared secret and RS256 public keys loaded from a JWKS file, indexed by kid.
+type KeySet struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+}
+
+// NewKeySet creates a key set. At least one of hmacSecret or jwksFile must be set.
+func NewKeySet(hmacSecret string, jwksFile string) (*KeySet, error) {
+	if hmacSecret == "" && jwksFile == "" {
+		return nil, errors.New("either HS256 secret or JWKS file is required")
+	}
+
+	keys := &KeySet{
+		rsaKeys: map[string]*rsa.PublicKey{},
+	}
+	if hmacSecret != "" {
+		keys.hmacSecret = []byte(hmacSecret)
+	}
+
+	if jwksFile != "" {
+		data, err := os.ReadFile(jwksFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read JWKS file")
+		}
+		if err := keys.loadJWKS(data); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k *KeySet) loadJWKS(data []byte) error {
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return errors.Wrap(err, "failed to parse JWKS")
+	}
+
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		if key.Alg != "" && key.Alg != "RS256" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return errors.Wrapf(err, "invalid modulus of key '%s'", key.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return errors.Wrapf(err, "invalid exponent of key '%s'", key.Kid)
+		}
+
+		k.rsaKeys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	if len(k.rsaKeys) == 0 {
+		return errors.New("JWKS contains no RS256 signing keys")
+	}
+
+	return nil
+}
+
+func (k *KeySet) methods() []string {
+	var methods []string
+	if k.hmacSecret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(k.rsaKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	return methods
+}
+
+func (k *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if k.hmacSecret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return k.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		key, ok := k.rsaKeys[kid]
+		if !ok {
+			return nil, errors.Errorf("unknown key id '%s'", kid)
+		}
+		return key, nil
+	default:
+		return nil, errors.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
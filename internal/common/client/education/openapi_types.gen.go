@@ -3,6 +3,14 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package education
 
+import (
+	"time"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for CourseDomain.
 const (
 	Business            CourseDomain = "business"
@@ -24,6 +32,26 @@ const (
 	CourseLevelIntermediate CourseLevel = "intermediate"
 )
 
+// Defines values for CourseSort.
+const (
+	CreatedAt      CourseSort = "created_at"
+	Duration       CourseSort = "duration"
+	MinusCreatedAt CourseSort = "-created_at"
+	MinusDuration  CourseSort = "-duration"
+	MinusRating    CourseSort = "-rating"
+	MinusTitle     CourseSort = "-title"
+	Rating         CourseSort = "rating"
+	Title          CourseSort = "title"
+)
+
+// Defines values for CourseStatus.
+const (
+	Archived  CourseStatus = "archived"
+	Draft     CourseStatus = "draft"
+	InReview  CourseStatus = "in_review"
+	Published CourseStatus = "published"
+)
+
 // Defines values for CourseTag.
 const (
 	CourseTagAdvanced         CourseTag = "advanced"
@@ -47,6 +75,193 @@ const (
 	CourseTagWebDevelopment   CourseTag = "web_development"
 )
 
+// Defines values for Difficulty.
+const (
+	Easy   Difficulty = "easy"
+	Hard   Difficulty = "hard"
+	Medium Difficulty = "medium"
+)
+
+// Defines values for ExerciseKind.
+const (
+	MultipleChoice ExerciseKind = "multiple_choice"
+	Numeric        ExerciseKind = "numeric"
+	Ordering       ExerciseKind = "ordering"
+	ShortText      ExerciseKind = "short_text"
+	SingleChoice   ExerciseKind = "single_choice"
+	TrueFalse      ExerciseKind = "true_false"
+)
+
+// Defines values for LessonLockReason.
+const (
+	ModuleNotReleased         LessonLockReason = "module-not-released"
+	PreviousLessonsIncomplete LessonLockReason = "previous-lessons-incomplete"
+	ScoreTooLow               LessonLockReason = "score-too-low"
+)
+
+// Defines values for ProgressStatus.
+const (
+	Completed  ProgressStatus = "completed"
+	Enrolled   ProgressStatus = "enrolled"
+	InProgress ProgressStatus = "in_progress"
+	Started    ProgressStatus = "started"
+)
+
+// Defines values for TextMatch.
+const (
+	Normalized TextMatch = "normalized"
+	Regex      TextMatch = "regex"
+)
+
+// AnswerResult defines model for AnswerResult.
+type AnswerResult struct {
+	// Answer The submitted answer, empty when the exercise was left unanswered or answered with several values
+	Answer string `json:"answer"`
+
+	// Answers Every submitted value, in the order they were submitted
+	Answers *[]string `json:"answers,omitempty"`
+
+	// Correct Whether the submitted answer earned every point of the exercise
+	Correct bool `json:"correct"`
+
+	// EarnedPoints Points earned, a share of them for partially correct multiple-choice answers
+	EarnedPoints float64 `json:"earnedPoints"`
+
+	// ExerciseId Unique identifier of the exercise
+	ExerciseId string `json:"exerciseId"`
+
+	// Points Points the exercise is worth
+	Points int `json:"points"`
+}
+
+// Attempt defines model for Attempt.
+type Attempt struct {
+	// EarnedPoints Points earned by the answers, including partial credit
+	EarnedPoints float64 `json:"earnedPoints"`
+
+	// Id Unique identifier for the attempt
+	Id string `json:"id"`
+
+	// LessonId Unique identifier of the lesson
+	LessonId string `json:"lessonId"`
+
+	// Results Correctness of every exercise of the lesson
+	Results []AnswerResult `json:"results"`
+
+	// Score Percentage of the points of the lesson earned by the answers
+	Score float64 `json:"score"`
+
+	// SubmittedAt When the answers were submitted
+	SubmittedAt time.Time `json:"submittedAt"`
+
+	// TotalPoints Points of every exercise of the lesson
+	TotalPoints int `json:"totalPoints"`
+}
+
+// BankPool defines model for BankPool.
+type BankPool struct {
+	// BankId Unique identifier of the question bank to draw from
+	BankId string `json:"bankId"`
+
+	// Count Number of questions every quiz session draws from the pool
+	Count int `json:"count"`
+
+	// Difficulty How difficult a bank question is
+	Difficulty *Difficulty `json:"difficulty,omitempty"`
+
+	// Topic Only draw questions tagged with this topic, any topic when omitted
+	Topic *string `json:"topic,omitempty"`
+}
+
+// BankQuestion defines model for BankQuestion.
+type BankQuestion struct {
+	// Answers Answer options presented to the student, empty for numeric and short text questions
+	Answers []string `json:"answers"`
+
+	// BankId Unique identifier of the question bank the question belongs to
+	BankId string `json:"bankId"`
+
+	// Difficulty How difficult a bank question is
+	Difficulty Difficulty `json:"difficulty"`
+
+	// Id Unique identifier for the bank question
+	Id string `json:"id"`
+
+	// Kind Type of question the exercise asks
+	Kind ExerciseKind `json:"kind"`
+
+	// Points Weight of the question in the score of the lessons asking it
+	Points int `json:"points"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+
+	// Topics Topics the question covers, in lowercase
+	Topics []string `json:"topics"`
+}
+
+// BundleLesson defines model for BundleLesson.
+type BundleLesson struct {
+	// Content Body of the lesson
+	Content *string `json:"content,omitempty"`
+
+	// DrawCount Number of exercises drawn at random for every quiz session, omit to draw every exercise
+	DrawCount *int `json:"drawCount,omitempty"`
+
+	// Duration Duration of the lesson in minutes
+	Duration int `json:"duration"`
+
+	// Exercises Exercises of the lesson in order
+	Exercises []CreateExerciseRequest `json:"exercises"`
+	Gate      *LessonGate             `json:"gate,omitempty"`
+
+	// Id Identifier of the lesson in the exported course. Score gates of the bundle point at it, the imported lesson gets a new ID.
+	Id *string `json:"id,omitempty"`
+
+	// MaxAttempts Number of attempts a student gets at the lesson, omit for no cap. Capped lessons can only be answered in quiz sessions.
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// Order Position of the lesson within the module
+	Order int `json:"order"`
+
+	// Overview Short summary of the lesson
+	Overview *string `json:"overview,omitempty"`
+
+	// PassScore Exercise score needed before the lesson counts as completed, omit to let the student complete it at will
+	PassScore *float64 `json:"passScore,omitempty"`
+
+	// TimeLimit Minutes a quiz session on the lesson lasts, omit for no limit. Timed lessons can only be answered in quiz sessions.
+	TimeLimit *int `json:"timeLimit,omitempty"`
+
+	// Title Title of the lesson
+	Title string `json:"title"`
+
+	// VideoId Identifier of the lesson video
+	VideoId *string `json:"videoId,omitempty"`
+}
+
+// BundleModule defines model for BundleModule.
+type BundleModule struct {
+	// Lessons Lessons of the module in order
+	Lessons []BundleLesson `json:"lessons"`
+
+	// Order Position of the module within the course
+	Order int `json:"order"`
+
+	// Release When the module opens to a student. A module without days after
+	// enrollment and without a date opens as soon as the student enrolls.
+	Release *ModuleRelease `json:"release,omitempty"`
+
+	// Title Title of the module
+	Title string `json:"title"`
+}
+
+// ChangeCourseStatusRequest defines model for ChangeCourseStatusRequest.
+type ChangeCourseStatusRequest struct {
+	// Status Publishing status of the course
+	Status CourseStatus `json:"status"`
+}
+
 // Course defines model for Course.
 type Course struct {
 	// Description Detailed description of the course
@@ -55,8 +270,8 @@ type Course struct {
 	// Domain Domain/category of the course
 	Domain CourseDomain `json:"domain"`
 
-	// Duration Duration of the course in minutes
-	Duration int `json:"duration"`
+	// Duration Duration of the course in minutes, the sum of its lesson durations
+	Duration *int `json:"duration,omitempty"`
 
 	// Id Unique identifier for the course
 	Id string `json:"id"`
@@ -64,9 +279,18 @@ type Course struct {
 	// Level Difficulty level of the course
 	Level CourseLevel `json:"level"`
 
-	// Rating Average rating of the course
+	// Prerequisites Courses students should take first, set through the prerequisites endpoint
+	Prerequisites *[]CoursePrerequisite `json:"prerequisites,omitempty"`
+
+	// Rating Average star rating of the course reviews, 0 until the course is reviewed
 	Rating float32 `json:"rating"`
 
+	// ReviewCount Number of reviews the rating is averaged from
+	ReviewCount int `json:"reviewCount"`
+
+	// Status Publishing status of the course
+	Status CourseStatus `json:"status"`
+
 	// Tags List of tags associated with the course
 	Tags *[]CourseTag `json:"tags,omitempty"`
 
@@ -80,15 +304,145 @@ type Course struct {
 	Title string `json:"title"`
 }
 
+// CourseBundle defines model for CourseBundle.
+type CourseBundle struct {
+	Course CreateCourseRequest `json:"course"`
+
+	// FormatVersion Version of the bundle format
+	FormatVersion int `json:"formatVersion"`
+
+	// Modules Modules of the course in order
+	Modules []BundleModule `json:"modules"`
+}
+
 // CourseDomain Domain/category of the course
 type CourseDomain string
 
 // CourseLevel Difficulty level of the course
 type CourseLevel string
 
+// CourseOutline defines model for CourseOutline.
+type CourseOutline struct {
+	Course Course `json:"course"`
+
+	// Modules Modules of the course in order
+	Modules []OutlineModule `json:"modules"`
+}
+
+// CoursePage defines model for CoursePage.
+type CoursePage struct {
+	Items []Course `json:"items"`
+
+	// Limit Maximum number of courses per page
+	Limit int `json:"limit"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// CoursePrerequisite defines model for CoursePrerequisite.
+type CoursePrerequisite struct {
+	// CourseId Unique identifier of the prior course
+	CourseId string `json:"courseId"`
+
+	// Required Whether the prior course has to be completed before enrolling, or is only recommended
+	Required bool `json:"required"`
+}
+
+// CourseSearchHit defines model for CourseSearchHit.
+type CourseSearchHit struct {
+	Course Course `json:"course"`
+
+	// Rank Relevance of the course to the search terms
+	Rank float32 `json:"rank"`
+
+	// Snippet HTML-escaped excerpt of the description and published lessons with matched terms wrapped in <mark> tags
+	Snippet string `json:"snippet"`
+
+	// TitleHighlight HTML-escaped course title with matched terms wrapped in <mark> tags
+	TitleHighlight string `json:"titleHighlight"`
+}
+
+// CourseSearchResults defines model for CourseSearchResults.
+type CourseSearchResults struct {
+	Items []CourseSearchHit `json:"items"`
+}
+
+// CourseSort Course listing sort order
+type CourseSort string
+
+// CourseStatus Publishing status of the course
+type CourseStatus string
+
 // CourseTag Tags for categorizing and filtering courses
 type CourseTag string
 
+// CourseVersion defines model for CourseVersion.
+type CourseVersion struct {
+	// CourseId Unique identifier of the course
+	CourseId string `json:"courseId"`
+
+	// Modules Modules of the version in order
+	Modules []CourseVersionModule `json:"modules"`
+
+	// PublishedAt When the version was published
+	PublishedAt time.Time `json:"publishedAt"`
+
+	// Version Version number, starting at 1
+	Version int `json:"version"`
+}
+
+// CourseVersionLesson defines model for CourseVersionLesson.
+type CourseVersionLesson struct {
+	// Exercises Exercises of the lesson in order, empty in enrollment content when they are drawn in quiz sessions
+	Exercises []Exercise `json:"exercises"`
+	Lesson    Lesson     `json:"lesson"`
+}
+
+// CourseVersionModule defines model for CourseVersionModule.
+type CourseVersionModule struct {
+	// Lessons Lessons of the module in order
+	Lessons []CourseVersionLesson `json:"lessons"`
+	Module  Module                `json:"module"`
+}
+
+// CreateBankQuestionRequest defines model for CreateBankQuestionRequest.
+type CreateBankQuestionRequest struct {
+	// Answers Answer options presented to the student. Empty for numeric and short text
+	// questions, and may be omitted for true/false questions.
+	Answers []string `json:"answers"`
+
+	// CorrectAnswer The correct answer of a single-choice, true/false or numeric question.
+	// Shorthand for a correctAnswers list with one entry.
+	CorrectAnswer *string `json:"correctAnswer,omitempty"`
+
+	// CorrectAnswers Every correct option of a multiple-choice question, the accepted answers of
+	// a short text question or the answers in the correct order of an ordering question
+	CorrectAnswers *[]string `json:"correctAnswers,omitempty"`
+
+	// Difficulty How difficult a bank question is
+	Difficulty Difficulty `json:"difficulty"`
+
+	// Kind Type of question the exercise asks
+	Kind *ExerciseKind `json:"kind,omitempty"`
+
+	// Points Weight of the question in the score of the lessons asking it, 1 when omitted
+	Points *int `json:"points,omitempty"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+
+	// TextMatch How short text answers are compared to the accepted answers: ignoring case and
+	// extra whitespace, or as regular expressions matching the whole answer
+	TextMatch *TextMatch `json:"textMatch,omitempty"`
+
+	// Tolerance How far a numeric answer may be from the correct value
+	Tolerance *float64 `json:"tolerance,omitempty"`
+
+	// Topics Topics the question covers, compared ignoring case
+	Topics *[]string `json:"topics,omitempty"`
+}
+
 // CreateCourseRequest defines model for CreateCourseRequest.
 type CreateCourseRequest struct {
 	// Description Detailed description of the course
@@ -97,21 +451,12 @@ type CreateCourseRequest struct {
 	// Domain Domain/category of the course
 	Domain CourseDomain `json:"domain"`
 
-	// Duration Duration of the course in minutes
-	Duration int `json:"duration"`
-
 	// Level Difficulty level of the course
 	Level CourseLevel `json:"level"`
 
-	// Rating Initial rating of the course
-	Rating *float32 `json:"rating,omitempty"`
-
 	// Tags List of tags associated with the course
 	Tags *[]CourseTag `json:"tags,omitempty"`
 
-	// TeacherId Unique identifier of the teacher creating the course
-	TeacherId string `json:"teacherId"`
-
 	// Thumbnail URL to the course thumbnail image
 	Thumbnail *string `json:"thumbnail,omitempty"`
 
@@ -119,10 +464,564 @@ type CreateCourseRequest struct {
 	Title string `json:"title"`
 }
 
-// Error defines model for Error.
+// CreateExerciseRequest defines model for CreateExerciseRequest.
+type CreateExerciseRequest struct {
+	// Answers Answer options presented to the student. Empty for numeric and short text
+	// questions, and may be omitted for true/false questions.
+	Answers []string `json:"answers"`
+
+	// CorrectAnswer The correct answer of a single-choice, true/false or numeric question.
+	// Shorthand for a correctAnswers list with one entry.
+	CorrectAnswer *string `json:"correctAnswer,omitempty"`
+
+	// CorrectAnswers Every correct option of a multiple-choice question, the accepted answers of
+	// a short text question or the answers in the correct order of an ordering question
+	CorrectAnswers *[]string `json:"correctAnswers,omitempty"`
+
+	// Kind Type of question the exercise asks
+	Kind *ExerciseKind `json:"kind,omitempty"`
+
+	// Order Position of the exercise within the lesson
+	Order int `json:"order"`
+
+	// Points Weight of the exercise in the score of the lesson, 1 when omitted
+	Points *int `json:"points,omitempty"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+
+	// TextMatch How short text answers are compared to the accepted answers: ignoring case and
+	// extra whitespace, or as regular expressions matching the whole answer
+	TextMatch *TextMatch `json:"textMatch,omitempty"`
+
+	// Tolerance How far a numeric answer may be from the correct value
+	Tolerance *float64 `json:"tolerance,omitempty"`
+}
+
+// CreateLessonRequest defines model for CreateLessonRequest.
+type CreateLessonRequest struct {
+	// Content Body of the lesson
+	Content *string `json:"content,omitempty"`
+
+	// DrawCount Number of exercises drawn at random for every quiz session, omit to draw every exercise
+	DrawCount *int `json:"drawCount,omitempty"`
+
+	// Duration Duration of the lesson in minutes
+	Duration int `json:"duration"`
+
+	// MaxAttempts Number of attempts a student gets at the lesson, omit for no cap. Capped lessons can only be answered in quiz sessions.
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// Order Position of the lesson within the module
+	Order int `json:"order"`
+
+	// Overview Short summary of the lesson
+	Overview *string `json:"overview,omitempty"`
+
+	// PassScore Exercise score needed before the lesson counts as completed, omit to let the student complete it at will
+	PassScore *float64 `json:"passScore,omitempty"`
+
+	// TimeLimit Minutes a quiz session on the lesson lasts, omit for no limit. Timed lessons can only be answered in quiz sessions.
+	TimeLimit *int `json:"timeLimit,omitempty"`
+
+	// Title Title of the lesson
+	Title string `json:"title"`
+
+	// VideoId Identifier of the lesson video
+	VideoId *string `json:"videoId,omitempty"`
+}
+
+// CreateModuleRequest defines model for CreateModuleRequest.
+type CreateModuleRequest struct {
+	// Order Position of the module within the course
+	Order int `json:"order"`
+
+	// Title Title of the module
+	Title string `json:"title"`
+}
+
+// CreateQuestionBankRequest defines model for CreateQuestionBankRequest.
+type CreateQuestionBankRequest struct {
+	// Title Title of the question bank
+	Title string `json:"title"`
+}
+
+// Difficulty How difficult a bank question is
+type Difficulty string
+
+// EnrollRequest defines model for EnrollRequest.
+type EnrollRequest struct {
+	// CourseId Unique identifier of the course to enroll in
+	CourseId string `json:"courseId"`
+}
+
+// Enrollment defines model for Enrollment.
+type Enrollment struct {
+	// CompletedAt When the student completed the course
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// ContentVersion Version of the course content the enrollment is pinned to
+	ContentVersion int `json:"contentVersion"`
+
+	// CourseId Unique identifier of the course
+	CourseId string `json:"courseId"`
+
+	// EnrolledAt When the student enrolled
+	EnrolledAt time.Time `json:"enrolledAt"`
+
+	// Id Unique identifier for the enrollment
+	Id string `json:"id"`
+
+	// Lessons Progress of every lesson the student has started
+	Lessons []LessonProgress `json:"lessons"`
+
+	// Modules Progress of every module the student has started
+	Modules  []ModuleProgress `json:"modules"`
+	Progress Progress         `json:"progress"`
+
+	// StartedAt When the student started the course
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// StudentId Unique identifier of the enrolled student
+	StudentId string `json:"studentId"`
+}
+
+// Error Problem details document (RFC 7807)
 type Error struct {
+	// Detail Human readable explanation of this occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Per-field validation failures
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// RequestId ID of the request, for correlating with server logs
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Slug Machine readable error code
+	Slug string `json:"slug"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the HTTP status
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type
+	Type string `json:"type"`
+}
+
+// Exercise defines model for Exercise.
+type Exercise struct {
+	// Answers Answer options presented to the student, empty for numeric and short text questions
+	Answers []string `json:"answers"`
+
+	// Id Unique identifier for the exercise
+	Id string `json:"id"`
+
+	// Kind Type of question the exercise asks
+	Kind ExerciseKind `json:"kind"`
+
+	// LessonId Unique identifier of the lesson the exercise belongs to
+	LessonId string `json:"lessonId"`
+
+	// Order Position of the exercise within the lesson
+	Order int `json:"order"`
+
+	// Points Weight of the exercise in the score of the lesson
+	Points int `json:"points"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+}
+
+// ExerciseKind Type of question the exercise asks
+type ExerciseKind string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Name of the invalid field
+	Field string `json:"field"`
+
+	// Message Why the field was rejected
 	Message string `json:"message"`
-	Slug    string `json:"slug"`
+}
+
+// Lesson defines model for Lesson.
+type Lesson struct {
+	// BankPools Question bank pools every quiz session on the lesson draws from
+	BankPools *[]BankPool `json:"bankPools,omitempty"`
+
+	// BankQuestionIds Question bank questions the lesson always asks next to its own exercises
+	BankQuestionIds *[]string `json:"bankQuestionIds,omitempty"`
+
+	// Content Body of the lesson
+	Content *string `json:"content,omitempty"`
+
+	// DrawCount Number of exercises drawn at random for every quiz session, absent when every exercise is drawn
+	DrawCount *int `json:"drawCount,omitempty"`
+
+	// Duration Duration of the lesson in minutes
+	Duration int         `json:"duration"`
+	Gate     *LessonGate `json:"gate,omitempty"`
+
+	// Id Unique identifier for the lesson
+	Id string `json:"id"`
+
+	// MaxAttempts Number of attempts a student gets at the lesson, absent when they are unlimited
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// ModuleId Unique identifier of the module the lesson belongs to
+	ModuleId string `json:"moduleId"`
+
+	// Order Position of the lesson within the module
+	Order int `json:"order"`
+
+	// Overview Short summary of the lesson
+	Overview *string `json:"overview,omitempty"`
+
+	// PassScore Exercise score needed before the lesson counts as completed, absent when the student completes it at will
+	PassScore *float64 `json:"passScore,omitempty"`
+
+	// TimeLimit Minutes a quiz session on the lesson lasts, absent when it is untimed
+	TimeLimit *int `json:"timeLimit,omitempty"`
+
+	// Title Title of the lesson
+	Title string `json:"title"`
+
+	// VideoId Identifier of the lesson video
+	VideoId *string `json:"videoId,omitempty"`
+}
+
+// LessonGate defines model for LessonGate.
+type LessonGate struct {
+	// MinScore Minimum exercise score on the score lesson, required with scoreLessonId
+	MinScore *float64 `json:"minScore,omitempty"`
+
+	// RequirePrevious Whether every previous lesson of the course has to be completed first
+	RequirePrevious bool `json:"requirePrevious"`
+
+	// ScoreLessonId Earlier lesson of the course the minimum score has to be reached on
+	ScoreLessonId *string `json:"scoreLessonId,omitempty"`
+}
+
+// LessonLock defines model for LessonLock.
+type LessonLock struct {
+	// LessonId Lesson the student has to complete, or reach the minimum score on, set for lesson gate rules
+	LessonId *string `json:"lessonId,omitempty"`
+
+	// MinScore Minimum exercise score to reach, set for score-too-low
+	MinScore *float64 `json:"minScore,omitempty"`
+
+	// Reason Which rule keeping the lesson locked is not met yet
+	Reason LessonLockReason `json:"reason"`
+
+	// UnlockAt Date the module of the lesson opens on, set for module-not-released
+	UnlockAt *time.Time `json:"unlockAt,omitempty"`
+}
+
+// LessonLockReason Which rule keeping the lesson locked is not met yet
+type LessonLockReason string
+
+// LessonProgress defines model for LessonProgress.
+type LessonProgress struct {
+	// Attempts Number of attempts the student made at the lesson
+	Attempts int `json:"attempts"`
+
+	// ExerciseScore Best attempt score on the lesson, as a percentage of the points of its exercises
+	ExerciseScore float64 `json:"exerciseScore"`
+
+	// LessonId Unique identifier of the lesson
+	LessonId string   `json:"lessonId"`
+	Progress Progress `json:"progress"`
+}
+
+// Module defines model for Module.
+type Module struct {
+	// CourseId Unique identifier of the course the module belongs to
+	CourseId string `json:"courseId"`
+
+	// Duration Duration of the module in minutes, the sum of its lesson durations
+	Duration *int `json:"duration,omitempty"`
+
+	// Id Unique identifier for the module
+	Id string `json:"id"`
+
+	// Order Position of the module within the course
+	Order int `json:"order"`
+
+	// Release When the module opens to a student. A module without days after
+	// enrollment and without a date opens as soon as the student enrolls.
+	Release *ModuleRelease `json:"release,omitempty"`
+
+	// Title Title of the module
+	Title string `json:"title"`
+}
+
+// ModuleProgress defines model for ModuleProgress.
+type ModuleProgress struct {
+	// ModuleId Unique identifier of the module
+	ModuleId string   `json:"moduleId"`
+	Progress Progress `json:"progress"`
+}
+
+// ModuleRelease When the module opens to a student. A module without days after
+// enrollment and without a date opens as soon as the student enrolls.
+type ModuleRelease struct {
+	// Date Date the module opens on for every student, exclusive with daysAfterEnrollment
+	Date *time.Time `json:"date,omitempty"`
+
+	// DaysAfterEnrollment Number of days after enrollment the module opens on
+	DaysAfterEnrollment *int `json:"daysAfterEnrollment,omitempty"`
+}
+
+// OrderItem defines model for OrderItem.
+type OrderItem struct {
+	// Id Unique identifier of the item
+	Id string `json:"id"`
+
+	// Order New position of the item
+	Order int `json:"order"`
+}
+
+// OutlineLesson defines model for OutlineLesson.
+type OutlineLesson struct {
+	// Duration Duration of the lesson in minutes
+	Duration int `json:"duration"`
+
+	// ExerciseCount Number of questions a student answers in the lesson, bank questions and draws included
+	ExerciseCount int `json:"exerciseCount"`
+
+	// HasVideo Whether the lesson has a video
+	HasVideo bool `json:"hasVideo"`
+
+	// Id Unique identifier of the lesson
+	Id   string      `json:"id"`
+	Lock *LessonLock `json:"lock,omitempty"`
+
+	// Locked Whether the lesson is locked for the student, only set for enrollment outlines
+	Locked *bool `json:"locked,omitempty"`
+
+	// Order Position of the lesson within the module
+	Order int `json:"order"`
+
+	// Status Progress status
+	Status *ProgressStatus `json:"status,omitempty"`
+
+	// Title Title of the lesson
+	Title string `json:"title"`
+}
+
+// OutlineModule defines model for OutlineModule.
+type OutlineModule struct {
+	// Duration Duration of the module in minutes, the sum of its lesson durations
+	Duration int `json:"duration"`
+
+	// Id Unique identifier of the module
+	Id string `json:"id"`
+
+	// Lessons Lessons of the module in order
+	Lessons []OutlineLesson `json:"lessons"`
+
+	// Order Position of the module within the course
+	Order int `json:"order"`
+
+	// Release When the module opens to a student. A module without days after
+	// enrollment and without a date opens as soon as the student enrolls.
+	Release *ModuleRelease `json:"release,omitempty"`
+
+	// Title Title of the module
+	Title string `json:"title"`
+
+	// UnlockAt Date the module opens on for the student, only set for enrollment outlines of scheduled modules
+	UnlockAt *time.Time `json:"unlockAt,omitempty"`
+}
+
+// Progress defines model for Progress.
+type Progress struct {
+	// Percentage Completion percentage
+	Percentage float64 `json:"percentage"`
+
+	// Status Progress status
+	Status ProgressStatus `json:"status"`
+}
+
+// ProgressStatus Progress status
+type ProgressStatus string
+
+// QuestionBank defines model for QuestionBank.
+type QuestionBank struct {
+	// CreatedAt When the question bank was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier for the question bank
+	Id string `json:"id"`
+
+	// TeacherId Unique identifier of the teacher owning the question bank
+	TeacherId string `json:"teacherId"`
+
+	// Title Title of the question bank
+	Title string `json:"title"`
+}
+
+// QuizQuestion defines model for QuizQuestion.
+type QuizQuestion struct {
+	// ExerciseId Unique identifier of the exercise
+	ExerciseId string `json:"exerciseId"`
+
+	// Kind Type of question the exercise asks
+	Kind ExerciseKind `json:"kind"`
+
+	// Options Answer options in the order they are presented, empty for numeric and short text questions
+	Options []string `json:"options"`
+
+	// Points Weight of the exercise in the score of the quiz
+	Points int `json:"points"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+}
+
+// QuizSession defines model for QuizSession.
+type QuizSession struct {
+	// AttemptId Attempt grading the session, absent until it is submitted
+	AttemptId *string `json:"attemptId,omitempty"`
+
+	// Deadline When the quiz session stops accepting answers, absent when it is untimed
+	Deadline *time.Time `json:"deadline,omitempty"`
+
+	// Id Unique identifier for the quiz session
+	Id string `json:"id"`
+
+	// LessonId Unique identifier of the lesson the exercises are drawn from
+	LessonId string `json:"lessonId"`
+
+	// Questions Drawn exercises, in the order they are presented
+	Questions []QuizQuestion `json:"questions"`
+
+	// RemainingSeconds Seconds left to submit the quiz session, absent when it is untimed
+	RemainingSeconds *int `json:"remainingSeconds,omitempty"`
+
+	// StartedAt When the quiz session was started
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// ReorderRequest defines model for ReorderRequest.
+type ReorderRequest struct {
+	// Items New positions of the reordered items
+	Items []OrderItem `json:"items"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	// CourseId Unique identifier of the reviewed course
+	CourseId string `json:"courseId"`
+
+	// CreatedAt When the review was posted
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier for the review
+	Id string `json:"id"`
+
+	// Rating Star rating given to the course
+	Rating int `json:"rating"`
+
+	// Text Written review, may be empty
+	Text string `json:"text"`
+
+	// UpdatedAt When the review was last edited
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UserId Unique identifier of the student who wrote the review
+	UserId string `json:"userId"`
+}
+
+// ReviewRequest defines model for ReviewRequest.
+type ReviewRequest struct {
+	// Rating Star rating given to the course
+	Rating int `json:"rating"`
+
+	// Text Written review
+	Text *string `json:"text,omitempty"`
+}
+
+// SetCoursePrerequisitesRequest defines model for SetCoursePrerequisitesRequest.
+type SetCoursePrerequisitesRequest struct {
+	// Prerequisites Every prerequisite of the course, replacing the current ones
+	Prerequisites []CoursePrerequisite `json:"prerequisites"`
+}
+
+// SetLessonBankQuestionsRequest defines model for SetLessonBankQuestionsRequest.
+type SetLessonBankQuestionsRequest struct {
+	// Pools Pools every quiz session on the lesson draws questions from
+	Pools []BankPool `json:"pools"`
+
+	// QuestionIds Bank questions the lesson always asks
+	QuestionIds []string `json:"questionIds"`
+}
+
+// SubmitAnswersRequest defines model for SubmitAnswersRequest.
+type SubmitAnswersRequest struct {
+	// Answers Answers to the exercises of the lesson
+	Answers []SubmittedAnswer `json:"answers"`
+}
+
+// SubmittedAnswer defines model for SubmittedAnswer.
+type SubmittedAnswer struct {
+	// Answer The chosen option or typed answer
+	Answer *string `json:"answer,omitempty"`
+
+	// Answers The selected options of a multiple-choice question or the answers in order of
+	// an ordering question. Takes precedence over answer.
+	Answers *[]string `json:"answers,omitempty"`
+
+	// Choices Positions of the chosen options, counted from 0 in the order the quiz session
+	// presented them. Only accepted when submitting a quiz session, where it takes
+	// precedence over answer and answers.
+	Choices *[]int `json:"choices,omitempty"`
+
+	// ExerciseId Unique identifier of the exercise
+	ExerciseId string `json:"exerciseId"`
+}
+
+// TextMatch How short text answers are compared to the accepted answers: ignoring case and
+// extra whitespace, or as regular expressions matching the whole answer
+type TextMatch string
+
+// UpdateBankQuestionRequest defines model for UpdateBankQuestionRequest.
+type UpdateBankQuestionRequest struct {
+	// Answers Answer options presented to the student. Empty for numeric and short text
+	// questions, and may be omitted for true/false questions.
+	Answers []string `json:"answers"`
+
+	// CorrectAnswer The correct answer of a single-choice, true/false or numeric question.
+	// Shorthand for a correctAnswers list with one entry.
+	CorrectAnswer *string `json:"correctAnswer,omitempty"`
+
+	// CorrectAnswers Every correct option of a multiple-choice question, the accepted answers of
+	// a short text question or the answers in the correct order of an ordering question
+	CorrectAnswers *[]string `json:"correctAnswers,omitempty"`
+
+	// Difficulty How difficult a bank question is
+	Difficulty Difficulty `json:"difficulty"`
+
+	// Kind Type of question the exercise asks
+	Kind *ExerciseKind `json:"kind,omitempty"`
+
+	// Points Weight of the question in the score of the lessons asking it, 1 when omitted
+	Points *int `json:"points,omitempty"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+
+	// TextMatch How short text answers are compared to the accepted answers: ignoring case and
+	// extra whitespace, or as regular expressions matching the whole answer
+	TextMatch *TextMatch `json:"textMatch,omitempty"`
+
+	// Tolerance How far a numeric answer may be from the correct value
+	Tolerance *float64 `json:"tolerance,omitempty"`
+
+	// Topics Topics the question covers, compared ignoring case
+	Topics *[]string `json:"topics,omitempty"`
 }
 
 // UpdateCourseRequest defines model for UpdateCourseRequest.
@@ -133,15 +1032,9 @@ type UpdateCourseRequest struct {
 	// Domain Domain/category of the course
 	Domain *CourseDomain `json:"domain,omitempty"`
 
-	// Duration Duration of the course in minutes
-	Duration *int `json:"duration,omitempty"`
-
 	// Level Difficulty level of the course
 	Level *CourseLevel `json:"level,omitempty"`
 
-	// Rating Updated rating of the course
-	Rating *float32 `json:"rating,omitempty"`
-
 	// Tags List of tags associated with the course
 	Tags *[]CourseTag `json:"tags,omitempty"`
 
@@ -152,6 +1045,82 @@ type UpdateCourseRequest struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// UpdateExerciseRequest defines model for UpdateExerciseRequest.
+type UpdateExerciseRequest struct {
+	// Answers Answer options presented to the student. Empty for numeric and short text
+	// questions, and may be omitted for true/false questions.
+	Answers []string `json:"answers"`
+
+	// CorrectAnswer The correct answer of a single-choice, true/false or numeric question.
+	// Shorthand for a correctAnswers list with one entry.
+	CorrectAnswer *string `json:"correctAnswer,omitempty"`
+
+	// CorrectAnswers Every correct option of a multiple-choice question, the accepted answers of
+	// a short text question or the answers in the correct order of an ordering question
+	CorrectAnswers *[]string `json:"correctAnswers,omitempty"`
+
+	// Kind Type of question the exercise asks
+	Kind *ExerciseKind `json:"kind,omitempty"`
+
+	// Order Position of the exercise within the lesson
+	Order int `json:"order"`
+
+	// Points Weight of the exercise in the score of the lesson, 1 when omitted
+	Points *int `json:"points,omitempty"`
+
+	// Question Question presented to the student
+	Question string `json:"question"`
+
+	// TextMatch How short text answers are compared to the accepted answers: ignoring case and
+	// extra whitespace, or as regular expressions matching the whole answer
+	TextMatch *TextMatch `json:"textMatch,omitempty"`
+
+	// Tolerance How far a numeric answer may be from the correct value
+	Tolerance *float64 `json:"tolerance,omitempty"`
+}
+
+// UpdateLessonRequest defines model for UpdateLessonRequest.
+type UpdateLessonRequest struct {
+	// Content Body of the lesson
+	Content *string `json:"content,omitempty"`
+
+	// DrawCount Number of exercises drawn at random for every quiz session, omit to draw every exercise
+	DrawCount *int `json:"drawCount,omitempty"`
+
+	// Duration Duration of the lesson in minutes
+	Duration int `json:"duration"`
+
+	// MaxAttempts Number of attempts a student gets at the lesson, omit for no cap. Capped lessons can only be answered in quiz sessions.
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// Order Position of the lesson within the module
+	Order int `json:"order"`
+
+	// Overview Short summary of the lesson
+	Overview *string `json:"overview,omitempty"`
+
+	// PassScore Exercise score needed before the lesson counts as completed, omit to let the student complete it at will
+	PassScore *float64 `json:"passScore,omitempty"`
+
+	// TimeLimit Minutes a quiz session on the lesson lasts, omit for no limit. Timed lessons can only be answered in quiz sessions.
+	TimeLimit *int `json:"timeLimit,omitempty"`
+
+	// Title Title of the lesson
+	Title string `json:"title"`
+
+	// VideoId Identifier of the lesson video
+	VideoId *string `json:"videoId,omitempty"`
+}
+
+// UpdateModuleRequest defines model for UpdateModuleRequest.
+type UpdateModuleRequest struct {
+	// Order Position of the module within the course
+	Order int `json:"order"`
+
+	// Title Title of the module
+	Title string `json:"title"`
+}
+
 // GetCoursesParams defines parameters for GetCourses.
 type GetCoursesParams struct {
 	// Domain Filter by course domain
@@ -160,12 +1129,123 @@ type GetCoursesParams struct {
 	// Level Filter by course level
 	Level *CourseLevel `form:"level,omitempty" json:"level,omitempty"`
 
-	// Tag Filter by course tag
-	Tag *CourseTag `form:"tag,omitempty" json:"tag,omitempty"`
+	// Tag Filter by course tags; repeat to require several tags
+	Tag *[]CourseTag `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// TeacherId Filter by the teacher who owns the course
+	TeacherId *string `form:"teacherId,omitempty" json:"teacherId,omitempty"`
+
+	// MinRating Minimum course rating
+	MinRating *float32 `form:"minRating,omitempty" json:"minRating,omitempty"`
+
+	// MaxRating Maximum course rating
+	MaxRating *float32 `form:"maxRating,omitempty" json:"maxRating,omitempty"`
+
+	// MinDuration Minimum course duration in seconds
+	MinDuration *int `form:"minDuration,omitempty" json:"minDuration,omitempty"`
+
+	// MaxDuration Maximum course duration in seconds
+	MaxDuration *int `form:"maxDuration,omitempty" json:"maxDuration,omitempty"`
+
+	// Sort Sort order; a leading '-' sorts descending
+	Sort *CourseSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of courses per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Cursor of the page to fetch, taken from nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// SearchCoursesParams defines parameters for SearchCourses.
+type SearchCoursesParams struct {
+	// Q Search terms; supports quoted phrases, "or" and "-" to exclude a term
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetBankQuestionsParams defines parameters for GetBankQuestions.
+type GetBankQuestionsParams struct {
+	// Topic Only return questions tagged with this topic
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty"`
+
+	// Difficulty Only return questions of this difficulty
+	Difficulty *Difficulty `form:"difficulty,omitempty" json:"difficulty,omitempty"`
 }
 
+// UpdateBankQuestionJSONRequestBody defines body for UpdateBankQuestion for application/json ContentType.
+type UpdateBankQuestionJSONRequestBody = UpdateBankQuestionRequest
+
 // CreateCourseJSONRequestBody defines body for CreateCourse for application/json ContentType.
 type CreateCourseJSONRequestBody = CreateCourseRequest
 
+// ImportCourseJSONRequestBody defines body for ImportCourse for application/json ContentType.
+type ImportCourseJSONRequestBody = CourseBundle
+
 // UpdateCourseJSONRequestBody defines body for UpdateCourse for application/json ContentType.
 type UpdateCourseJSONRequestBody = UpdateCourseRequest
+
+// CreateModuleJSONRequestBody defines body for CreateModule for application/json ContentType.
+type CreateModuleJSONRequestBody = CreateModuleRequest
+
+// ReorderModulesJSONRequestBody defines body for ReorderModules for application/json ContentType.
+type ReorderModulesJSONRequestBody = ReorderRequest
+
+// SetCoursePrerequisitesJSONRequestBody defines body for SetCoursePrerequisites for application/json ContentType.
+type SetCoursePrerequisitesJSONRequestBody = SetCoursePrerequisitesRequest
+
+// PostReviewJSONRequestBody defines body for PostReview for application/json ContentType.
+type PostReviewJSONRequestBody = ReviewRequest
+
+// ChangeCourseStatusJSONRequestBody defines body for ChangeCourseStatus for application/json ContentType.
+type ChangeCourseStatusJSONRequestBody = ChangeCourseStatusRequest
+
+// UpdateExerciseJSONRequestBody defines body for UpdateExercise for application/json ContentType.
+type UpdateExerciseJSONRequestBody = UpdateExerciseRequest
+
+// UpdateLessonJSONRequestBody defines body for UpdateLesson for application/json ContentType.
+type UpdateLessonJSONRequestBody = UpdateLessonRequest
+
+// SetLessonBankQuestionsJSONRequestBody defines body for SetLessonBankQuestions for application/json ContentType.
+type SetLessonBankQuestionsJSONRequestBody = SetLessonBankQuestionsRequest
+
+// CreateExerciseJSONRequestBody defines body for CreateExercise for application/json ContentType.
+type CreateExerciseJSONRequestBody = CreateExerciseRequest
+
+// ReorderExercisesJSONRequestBody defines body for ReorderExercises for application/json ContentType.
+type ReorderExercisesJSONRequestBody = ReorderRequest
+
+// SetLessonGateJSONRequestBody defines body for SetLessonGate for application/json ContentType.
+type SetLessonGateJSONRequestBody = LessonGate
+
+// EnrollInCourseJSONRequestBody defines body for EnrollInCourse for application/json ContentType.
+type EnrollInCourseJSONRequestBody = EnrollRequest
+
+// SubmitLessonAnswersJSONRequestBody defines body for SubmitLessonAnswers for application/json ContentType.
+type SubmitLessonAnswersJSONRequestBody = SubmitAnswersRequest
+
+// SubmitQuizSessionJSONRequestBody defines body for SubmitQuizSession for application/json ContentType.
+type SubmitQuizSessionJSONRequestBody = SubmitAnswersRequest
+
+// UpdateModuleJSONRequestBody defines body for UpdateModule for application/json ContentType.
+type UpdateModuleJSONRequestBody = UpdateModuleRequest
+
+// CreateLessonJSONRequestBody defines body for CreateLesson for application/json ContentType.
+type CreateLessonJSONRequestBody = CreateLessonRequest
+
+// ReorderLessonsJSONRequestBody defines body for ReorderLessons for application/json ContentType.
+type ReorderLessonsJSONRequestBody = ReorderRequest
+
+// SetModuleReleaseJSONRequestBody defines body for SetModuleRelease for application/json ContentType.
+type SetModuleReleaseJSONRequestBody = ModuleRelease
+
+// CreateQuestionBankJSONRequestBody defines body for CreateQuestionBank for application/json ContentType.
+type CreateQuestionBankJSONRequestBody = CreateQuestionBankRequest
+
+// CreateBankQuestionJSONRequestBody defines body for CreateBankQuestion for application/json ContentType.
+type CreateBankQuestionJSONRequestBody = CreateBankQuestionRequest
+
+// EditReviewJSONRequestBody defines body for EditReview for application/json ContentType.
+type EditReviewJSONRequestBody = ReviewRequest
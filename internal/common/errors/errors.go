@@ -8,12 +8,16 @@ var (
 	ErrorTypeUnknown        = ErrorType{"unknown"}
 	ErrorTypeAuthorization  = ErrorType{"authorization"}
 	ErrorTypeIncorrectInput = ErrorType{"incorrect-input"}
+	ErrorTypeNotFound       = ErrorType{"not-found"}
+	ErrorTypeConflict       = ErrorType{"conflict"}
+	ErrorTypeForbidden      = ErrorType{"forbidden"}
 )
 
 type SlugError struct {
 	error     string
 	slug      string
 	errorType ErrorType
+	fields    []FieldError
 }
 
 func (s SlugError) Error() string {
@@ -28,6 +32,11 @@ func (s SlugError) ErrorType() ErrorType {
 	return s.errorType
 }
 
+// Fields lists the input fields that failed validation, if any.
+func (s SlugError) Fields() []FieldError {
+	return s.fields
+}
+
 func NewSlugError(error string, slug string) SlugError {
 	return SlugError{
 		error:     error,
@@ -51,3 +60,27 @@ func NewIncorrectInputError(error string, slug string) SlugError {
 		errorType: ErrorTypeIncorrectInput,
 	}
 }
+
+func NewNotFoundError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeNotFound,
+	}
+}
+
+func NewConflictError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeConflict,
+	}
+}
+
+func NewForbiddenError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeForbidden,
+	}
+}
@@ -0,0 +1,57 @@
+package errors
+
+import "strings"
+
+// FieldError describes why a single input field was rejected.
+type FieldError struct {
+	field   string
+	message string
+}
+
+func NewFieldError(field string, message string) FieldError {
+	return FieldError{
+		field:   field,
+		message: message,
+	}
+}
+
+func (f FieldError) Field() string {
+	return f.field
+}
+
+func (f FieldError) Message() string {
+	return f.message
+}
+
+// NewValidationError is an incorrect input error carrying the fields that failed.
+// Its message joins the field messages, so a single failure reads as that failure alone.
+func NewValidationError(slug string, fields ...FieldError) SlugError {
+	messages := make([]string, 0, len(fields))
+	for _, f := range fields {
+		messages = append(messages, f.message)
+	}
+
+	return SlugError{
+		error:     strings.Join(messages, "; "),
+		slug:      slug,
+		errorType: ErrorTypeIncorrectInput,
+		fields:    fields,
+	}
+}
+
+// Validation collects field errors, so constructors can report every invalid field at once.
+type Validation struct {
+	fields []FieldError
+}
+
+func (v *Validation) Add(field string, message string) {
+	v.fields = append(v.fields, NewFieldError(field, message))
+}
+
+// Err returns nil when nothing was collected, and a validation error otherwise.
+func (v *Validation) Err(slug string) error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return NewValidationError(slug, v.fields...)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/maixuanbach174/online-course-app/internal/common/auth"
 	"github.com/maixuanbach174/online-course-app/internal/common/logs"
 	"github.com/sirupsen/logrus"
 )
@@ -45,6 +46,24 @@ func setMiddlewares(router *chi.Mux) {
 		middleware.SetHeader("X-Frame-Options", "deny"),
 	)
 	router.Use(middleware.NoCache)
+
+	addAuthMiddleware(router)
+}
+
+func addAuthMiddleware(router *chi.Mux) {
+	secret := os.Getenv("JWT_HS256_SECRET")
+	jwksFile := os.Getenv("JWT_JWKS_FILE")
+	if secret == "" && jwksFile == "" {
+		logrus.Warn("No JWT keys configured, all requests are anonymous")
+		return
+	}
+
+	keys, err := auth.NewKeySet(secret, jwksFile)
+	if err != nil {
+		logrus.WithError(err).Panic("Unable to load JWT keys")
+	}
+
+	router.Use(auth.JWTHttpMiddleware{Keys: keys}.Middleware)
 }
 
 func addCorsMiddleware(router *chi.Mux) {
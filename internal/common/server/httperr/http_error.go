@@ -1,57 +1,121 @@
 package httperr
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"net/http"
 
-	"github.com/go-chi/render"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/maixuanbach174/online-course-app/internal/common/errors"
 	"github.com/maixuanbach174/online-course-app/internal/common/logs"
 )
 
+// ProblemContentType is the media type of error responses, as defined by RFC 7807
+const ProblemContentType = "application/problem+json"
+
 func InternalError(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Internal server error", http.StatusInternalServerError)
+	// Internal details are logged but never exposed to the client
+	httpRespondWithError(err, slug, http.StatusText(http.StatusInternalServerError), nil, w, r, "Internal server error", http.StatusInternalServerError)
 }
 
 func Unauthorised(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Unauthorised", http.StatusUnauthorized)
+	httpRespondWithError(err, slug, messageOf(err), nil, w, r, "Unauthorised", http.StatusUnauthorized)
+}
+
+func Forbidden(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, messageOf(err), nil, w, r, "Forbidden", http.StatusForbidden)
 }
 
 func BadRequest(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Bad request", http.StatusBadRequest)
+	httpRespondWithError(err, slug, messageOf(err), nil, w, r, "Bad request", http.StatusBadRequest)
+}
+
+func NotFound(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, messageOf(err), nil, w, r, "Not found", http.StatusNotFound)
+}
+
+func Conflict(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, messageOf(err), nil, w, r, "Conflict", http.StatusConflict)
 }
 
 func RespondWithSlugError(err error, w http.ResponseWriter, r *http.Request) {
-	slugError, ok := err.(errors.SlugError)
-	if !ok {
+	// Slug errors are usually wrapped on their way up from the adapters
+	var slugError errors.SlugError
+	if !stderrors.As(err, &slugError) {
 		InternalError("internal-server-error", err, w, r)
 		return
 	}
 
+	message := slugError.Error()
+	fields := slugError.Fields()
+
 	switch slugError.ErrorType() {
 	case errors.ErrorTypeAuthorization:
-		Unauthorised(slugError.Slug(), slugError, w, r)
+		httpRespondWithError(err, slugError.Slug(), message, fields, w, r, "Unauthorised", http.StatusUnauthorized)
+	case errors.ErrorTypeForbidden:
+		httpRespondWithError(err, slugError.Slug(), message, fields, w, r, "Forbidden", http.StatusForbidden)
 	case errors.ErrorTypeIncorrectInput:
-		BadRequest(slugError.Slug(), slugError, w, r)
+		httpRespondWithError(err, slugError.Slug(), message, fields, w, r, "Bad request", http.StatusBadRequest)
+	case errors.ErrorTypeNotFound:
+		httpRespondWithError(err, slugError.Slug(), message, fields, w, r, "Not found", http.StatusNotFound)
+	case errors.ErrorTypeConflict:
+		httpRespondWithError(err, slugError.Slug(), message, fields, w, r, "Conflict", http.StatusConflict)
 	default:
-		InternalError(slugError.Slug(), slugError, w, r)
+		InternalError(slugError.Slug(), err, w, r)
+	}
+}
+
+func messageOf(err error) string {
+	if err == nil {
+		return ""
 	}
+	return err.Error()
 }
 
-func httpRespondWithError(err error, slug string, w http.ResponseWriter, r *http.Request, logMSg string, status int) {
+func httpRespondWithError(
+	err error,
+	slug string,
+	detail string,
+	fields []errors.FieldError,
+	w http.ResponseWriter,
+	r *http.Request,
+	logMSg string,
+	status int,
+) {
 	logs.GetLogEntry(r).WithError(err).WithField("error-slug", slug).Warn(logMSg)
-	resp := ErrorResponse{slug, status}
 
-	if err := render.Render(w, r, resp); err != nil {
+	resp := ErrorResponse{
+		Type:      "/problems/" + slug,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Slug:      slug,
+		RequestID: middleware.GetReqID(r.Context()),
+	}
+	for _, f := range fields {
+		resp.Errors = append(resp.Errors, FieldErrorResponse{Field: f.Field(), Message: f.Message()})
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
 		panic(err)
 	}
 }
 
+// ErrorResponse is an RFC 7807 problem details document, extended with the error slug,
+// the request ID and per-field validation failures.
 type ErrorResponse struct {
-	Slug       string `json:"slug"`
-	httpStatus int
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Status    int                  `json:"status"`
+	Detail    string               `json:"detail,omitempty"`
+	Slug      string               `json:"slug"`
+	RequestID string               `json:"requestId,omitempty"`
+	Errors    []FieldErrorResponse `json:"errors,omitempty"`
 }
 
-func (e ErrorResponse) Render(w http.ResponseWriter, r *http.Request) error {
-	w.WriteHeader(e.httpStatus)
-	return nil
+type FieldErrorResponse struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
@@ -0,0 +1,171 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/pkg/errors"
+)
+
+type AttemptRepository struct {
+	db          *pgxpool.Pool
+	queries     *database.Queries
+	enrollments *EnrollmentRepository
+}
+
+func NewAttemptRepository(db *pgxpool.Pool) *AttemptRepository {
+	return &AttemptRepository{
+		db:          db,
+		queries:     database.New(db),
+		enrollments: NewEnrollmentRepository(db),
+	}
+}
+
+// attemptAnswer is the JSON representation of a graded answer in lesson_attempts.answers
+type attemptAnswer struct {
+	ExerciseID string   `json:"exercise_id"`
+	Response   []string `json:"response,omitempty"`
+	// Answer is only set on attempts graded before responses could hold
+	// several values
+	Answer  string `json:"answer,omitempty"`
+	Correct bool   `json:"correct"`
+	// Earned and Points are absent on attempts graded before exercises were
+	// weighted, where every exercise was worth one point
+	Earned float64 `json:"earned,omitempty"`
+	Points int     `json:"points,omitempty"`
+}
+
+// Create implements attempt.AttemptRepository
+func (r *AttemptRepository) Create(ctx context.Context, a *attempt.Attempt, updateEnrollment func(e *enrollment.Enrollment) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	// The enrollment is updated first, so an attempt it refuses is never saved
+	if err := r.enrollments.updateLocked(ctx, qtx, a.EnrollmentID(), updateEnrollment); err != nil {
+		return err
+	}
+	if err := r.createAttempt(ctx, qtx, a); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Get implements attempt.AttemptRepository
+func (r *AttemptRepository) Get(ctx context.Context, id string) (*attempt.Attempt, error) {
+	dbAttempt, err := r.queries.GetLessonAttemptByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "attempt"), "failed to get attempt")
+	}
+
+	return r.toDomainAttempt(dbAttempt)
+}
+
+// GetByEnrollmentAndLesson implements attempt.AttemptRepository
+func (r *AttemptRepository) GetByEnrollmentAndLesson(ctx context.Context, enrollmentID, lessonID string) ([]*attempt.Attempt, error) {
+	dbAttempts, err := r.queries.GetLessonAttemptsByEnrollmentAndLesson(ctx, database.GetLessonAttemptsByEnrollmentAndLessonParams{
+		EnrollmentID: enrollmentID,
+		LessonID:     lessonID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attempts by enrollment and lesson")
+	}
+
+	attempts := make([]*attempt.Attempt, 0, len(dbAttempts))
+	for _, dbAttempt := range dbAttempts {
+		domainAttempt, err := r.toDomainAttempt(dbAttempt)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, domainAttempt)
+	}
+
+	return attempts, nil
+}
+
+// Helper methods
+
+func (r *AttemptRepository) createAttempt(ctx context.Context, q *database.Queries, a *attempt.Attempt) error {
+	dbAnswers := make([]attemptAnswer, 0, len(a.Answers()))
+	for _, ans := range a.Answers() {
+		dbAnswers = append(dbAnswers, attemptAnswer{
+			ExerciseID: ans.ExerciseID(),
+			Response:   ans.Response(),
+			Correct:    ans.Correct(),
+			Earned:     ans.Earned(),
+			Points:     ans.Points(),
+		})
+	}
+
+	answers, err := json.Marshal(dbAnswers)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal answers")
+	}
+
+	var score pgtype.Numeric
+	if err := score.Scan(fmt.Sprintf("%.2f", a.Score())); err != nil {
+		return errors.Wrap(err, "failed to convert score")
+	}
+
+	params := database.CreateLessonAttemptParams{
+		ID:           a.ID(),
+		EnrollmentID: a.EnrollmentID(),
+		LessonID:     a.LessonID(),
+		Answers:      answers,
+		Score:        score,
+		SubmittedAt:  pgtype.Timestamp{Time: a.SubmittedAt(), Valid: true},
+	}
+
+	if err := q.CreateLessonAttempt(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "attempt"), "failed to create attempt")
+	}
+
+	return nil
+}
+
+func (r *AttemptRepository) toDomainAttempt(dbAttempt database.LessonAttempt) (*attempt.Attempt, error) {
+	var dbAnswers []attemptAnswer
+	if err := json.Unmarshal(dbAttempt.Answers, &dbAnswers); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal answers")
+	}
+
+	answers := make([]attempt.Answer, 0, len(dbAnswers))
+	for _, ans := range dbAnswers {
+		response := ans.Response
+		if len(response) == 0 && ans.Answer != "" {
+			response = []string{ans.Answer}
+		}
+		earned, points := ans.Earned, ans.Points
+		if points == 0 {
+			points = 1
+			if ans.Correct {
+				earned = 1
+			}
+		}
+		answers = append(answers, attempt.NewAnswer(ans.ExerciseID, response, earned, points))
+	}
+
+	return attempt.UnmarshalAttemptFromDatabase(
+		dbAttempt.ID,
+		dbAttempt.EnrollmentID,
+		dbAttempt.LessonID,
+		answers,
+		numericToFloat64(dbAttempt.Score),
+		dbAttempt.SubmittedAt.Time,
+	), nil
+}
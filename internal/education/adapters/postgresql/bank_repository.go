@@ -0,0 +1,209 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+)
+
+type BankRepository struct {
+	db      *pgxpool.Pool
+	queries *database.Queries
+}
+
+func NewBankRepository(db *pgxpool.Pool) *BankRepository {
+	return &BankRepository{
+		db:      db,
+		queries: database.New(db),
+	}
+}
+
+// Create implements bank.BankRepository
+func (r *BankRepository) Create(ctx context.Context, b *bank.Bank) error {
+	params := database.CreateQuestionBankParams{
+		ID:        b.ID(),
+		TeacherID: b.TeacherID(),
+		Title:     b.Title(),
+		CreatedAt: pgtype.Timestamp{Time: b.CreatedAt(), Valid: true},
+	}
+
+	if err := r.queries.CreateQuestionBank(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "question bank"), "failed to create question bank")
+	}
+
+	return nil
+}
+
+// Get implements bank.BankRepository
+func (r *BankRepository) Get(ctx context.Context, id string) (*bank.Bank, error) {
+	dbBank, err := r.queries.GetQuestionBankByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "question bank"), "failed to get question bank")
+	}
+
+	return bank.UnmarshalBankFromDatabase(dbBank.ID, dbBank.TeacherID, dbBank.Title, dbBank.CreatedAt.Time)
+}
+
+// GetByTeacherID implements bank.BankRepository
+func (r *BankRepository) GetByTeacherID(ctx context.Context, teacherID string) ([]*bank.Bank, error) {
+	dbBanks, err := r.queries.GetQuestionBanksByTeacherID(ctx, teacherID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get question banks by teacher")
+	}
+
+	banks := make([]*bank.Bank, 0, len(dbBanks))
+	for _, dbBank := range dbBanks {
+		b, err := bank.UnmarshalBankFromDatabase(dbBank.ID, dbBank.TeacherID, dbBank.Title, dbBank.CreatedAt.Time)
+		if err != nil {
+			return nil, err
+		}
+		banks = append(banks, b)
+	}
+
+	return banks, nil
+}
+
+// CreateQuestion implements bank.BankRepository
+func (r *BankRepository) CreateQuestion(ctx context.Context, q *bank.Question) error {
+	key := q.AnswerKeyForStorage()
+	params := database.CreateBankQuestionParams{
+		ID:             q.ID(),
+		BankID:         q.BankID(),
+		Question:       q.Question(),
+		Kind:           key.Kind().String(),
+		Answers:        key.Options(),
+		CorrectAnswers: key.Correct(),
+		Tolerance:      key.Tolerance(),
+		TextMatch:      textMatchColumn(key),
+		Points:         int32(q.Points()),
+		Topics:         q.Topics(),
+		Difficulty:     q.Difficulty().String(),
+	}
+
+	if err := r.queries.CreateBankQuestion(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "bank question"), "failed to create bank question")
+	}
+
+	return nil
+}
+
+// UpdateQuestion implements bank.BankRepository
+func (r *BankRepository) UpdateQuestion(ctx context.Context, q *bank.Question) error {
+	key := q.AnswerKeyForStorage()
+	params := database.UpdateBankQuestionParams{
+		ID:             q.ID(),
+		Question:       q.Question(),
+		Kind:           key.Kind().String(),
+		Answers:        key.Options(),
+		CorrectAnswers: key.Correct(),
+		Tolerance:      key.Tolerance(),
+		TextMatch:      textMatchColumn(key),
+		Points:         int32(q.Points()),
+		Topics:         q.Topics(),
+		Difficulty:     q.Difficulty().String(),
+	}
+
+	if err := r.queries.UpdateBankQuestion(ctx, params); err != nil {
+		return errors.Wrap(err, "failed to update bank question")
+	}
+
+	return nil
+}
+
+// DeleteQuestion implements bank.BankRepository. The question is only
+// deleted when no lesson refers to it, so a lesson cannot lose a question
+// between the check and the delete.
+func (r *BankRepository) DeleteQuestion(ctx context.Context, id string) error {
+	deleted, err := r.queries.DeleteUnusedBankQuestion(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete bank question")
+	}
+	if deleted == 0 {
+		return commonerrors.NewConflictError("bank question is used by a lesson", "bank-question-in-use")
+	}
+
+	return nil
+}
+
+// GetQuestion implements bank.BankRepository
+func (r *BankRepository) GetQuestion(ctx context.Context, id string) (*bank.Question, error) {
+	dbQuestion, err := r.queries.GetBankQuestionByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "bank question"), "failed to get bank question")
+	}
+
+	return r.toDomainQuestion(dbQuestion)
+}
+
+// GetQuestions implements bank.BankRepository
+func (r *BankRepository) GetQuestions(ctx context.Context, bankID string, filter bank.Filter) ([]*bank.Question, error) {
+	dbQuestions, err := r.queries.GetBankQuestionsByBankID(ctx, database.GetBankQuestionsByBankIDParams{
+		BankID:     bankID,
+		Topic:      filter.Topic(),
+		Difficulty: filter.Difficulty().String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bank questions")
+	}
+
+	return r.toDomainQuestions(dbQuestions)
+}
+
+// GetQuestionsByIDs implements bank.BankRepository
+func (r *BankRepository) GetQuestionsByIDs(ctx context.Context, ids []string) ([]*bank.Question, error) {
+	dbQuestions, err := r.queries.GetBankQuestionsByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bank questions by IDs")
+	}
+
+	return r.toDomainQuestions(dbQuestions)
+}
+
+// Helper methods
+
+func (r *BankRepository) toDomainQuestions(dbQuestions []database.BankQuestion) ([]*bank.Question, error) {
+	questions := make([]*bank.Question, 0, len(dbQuestions))
+	for _, dbQuestion := range dbQuestions {
+		q, err := r.toDomainQuestion(dbQuestion)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+
+	return questions, nil
+}
+
+func (r *BankRepository) toDomainQuestion(dbQuestion database.BankQuestion) (*bank.Question, error) {
+	key, err := exercise.ParseAnswerKey(
+		dbQuestion.Kind,
+		dbQuestion.Answers,
+		dbQuestion.CorrectAnswers,
+		dbQuestion.Tolerance,
+		dbQuestion.TextMatch.String,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	difficulty, err := bank.NewDifficultyFromString(dbQuestion.Difficulty)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := bank.NewQuestion(dbQuestion.ID, dbQuestion.BankID, dbQuestion.Question, key, dbQuestion.Topics, difficulty)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.SetPoints(int(dbQuestion.Points)); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+)
+
+// BundleRepository saves and loads whole courses, reusing the repositories of
+// the course, module, lesson and exercise tables
+type BundleRepository struct {
+	db        *pgxpool.Pool
+	queries   *database.Queries
+	courses   *CourseRepository
+	modules   *ModuleRepository
+	lessons   *LessonRepository
+	exercises *ExerciseRepository
+}
+
+func NewBundleRepository(db *pgxpool.Pool) *BundleRepository {
+	return &BundleRepository{
+		db:        db,
+		queries:   database.New(db),
+		courses:   NewCourseRepository(db),
+		modules:   NewModuleRepository(db),
+		lessons:   NewLessonRepository(db),
+		exercises: NewExerciseRepository(db),
+	}
+}
+
+// Create implements bundle.BundleRepository
+func (r *BundleRepository) Create(ctx context.Context, b *bundle.Bundle) error {
+	// Start a transaction so a failing row leaves no partial course behind
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	// Create course and its tags
+	if err := r.courses.createCourse(ctx, qtx, b.Course()); err != nil {
+		return err
+	}
+	if err := r.courses.createCourseTags(ctx, qtx, b.Course()); err != nil {
+		return err
+	}
+
+	// Create modules, lessons and exercises
+	for _, mc := range b.Modules() {
+		if err := r.modules.createModule(ctx, qtx, mc.Module()); err != nil {
+			return err
+		}
+		for _, lc := range mc.Lessons() {
+			if err := r.lessons.createLesson(ctx, qtx, lc.Lesson()); err != nil {
+				return err
+			}
+			for _, e := range lc.Exercises() {
+				if err := r.exercises.createExercise(ctx, qtx, e); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Get implements bundle.BundleRepository
+func (r *BundleRepository) Get(ctx context.Context, courseID string) (*bundle.Bundle, error) {
+	c, err := r.courses.Get(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := r.modules.GetByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleContents := make([]version.ModuleContent, 0, len(modules))
+	for _, m := range modules {
+		lessons, err := r.lessons.GetByModuleID(ctx, m.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		lessonContents := make([]version.LessonContent, 0, len(lessons))
+		for _, l := range lessons {
+			exercises, err := r.exercises.GetByLessonID(ctx, l.ID())
+			if err != nil {
+				return nil, err
+			}
+			lessonContents = append(lessonContents, version.NewLessonContent(l, exercises))
+		}
+		moduleContents = append(moduleContents, version.NewModuleContent(m, lessonContents))
+	}
+
+	return bundle.NewBundle(c, moduleContents)
+}
@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+type BundleRepositoryTest struct {
+	Name       string
+	Repository *BundleRepository
+}
+
+func TestBundleRepository(t *testing.T) {
+	t.Parallel()
+	rand.Seed(time.Now().UTC().UnixNano())
+
+	repositories := createBundleRepositories(t)
+
+	for i := range repositories {
+		r := repositories[i]
+
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("CreateAndGet", func(t *testing.T) {
+				t.Parallel()
+				testBundleCreateAndGet(t, r.Repository)
+			})
+			t.Run("AllOrNothing", func(t *testing.T) {
+				t.Parallel()
+				testBundleAllOrNothing(t, r.Repository)
+			})
+		})
+	}
+}
+
+func createBundleRepositories(t *testing.T) []BundleRepositoryTest {
+	return []BundleRepositoryTest{
+		{
+			Name:       "PostgreSQL",
+			Repository: newPostgreSQLBundleRepository(t),
+		},
+	}
+}
+
+func testBundleCreateAndGet(t *testing.T, repository *BundleRepository) {
+	ctx := context.Background()
+	c := newTestBundleCourse(t)
+
+	m, _ := module.NewModule(generateModuleID(), c.ID(), "Basics", 0)
+	l, _ := lesson.NewLesson(generateLessonID(), m.ID(), "Intro", "Overview", "Content", "video-1", 15, 0)
+	e, _ := exercise.NewExercise(generateID(), l.ID(), "2 + 2?", []string{"3", "4"}, "4", 0)
+
+	b, err := bundle.NewBundle(c, []version.ModuleContent{
+		version.NewModuleContent(m, []version.LessonContent{
+			version.NewLessonContent(l, []*exercise.Exercise{e}),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create bundle domain model: %v", err)
+	}
+
+	if err := repository.Create(ctx, b); err != nil {
+		t.Fatalf("failed to create bundle: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get bundle: %v", err)
+	}
+
+	assertCourseEqual(t, c, retrieved.Course())
+	if len(retrieved.Modules()) != 1 || len(retrieved.Modules()[0].Lessons()) != 1 {
+		t.Fatalf("expected 1 module with 1 lesson, got %+v", retrieved.Modules())
+	}
+	assertModuleEqual(t, m, retrieved.Modules()[0].Module())
+
+	lessonContent := retrieved.Modules()[0].Lessons()[0]
+	assertLessonEqual(t, l, lessonContent.Lesson())
+	if len(lessonContent.Exercises()) != 1 {
+		t.Fatalf("expected 1 exercise, got %d", len(lessonContent.Exercises()))
+	}
+	if !lessonContent.Exercises()[0].CheckAnswer("4") {
+		t.Error("expected the correct answer to be part of the bundle")
+	}
+}
+
+func testBundleAllOrNothing(t *testing.T, repository *BundleRepository) {
+	ctx := context.Background()
+	c := newTestBundleCourse(t)
+
+	m, _ := module.NewModule(generateModuleID(), c.ID(), "Basics", 0)
+	first, _ := lesson.NewLesson(generateLessonID(), m.ID(), "First", "", "", "", 10, 0)
+	second, _ := lesson.NewLesson(generateLessonID(), m.ID(), "Second", "", "", "", 10, 0)
+
+	b, _ := bundle.NewBundle(c, []version.ModuleContent{
+		version.NewModuleContent(m, []version.LessonContent{
+			version.NewLessonContent(first, nil),
+			version.NewLessonContent(second, nil),
+		}),
+	})
+
+	if err := repository.Create(ctx, b); err == nil {
+		t.Fatal("expected error when two lessons share an order, got nil")
+	}
+
+	exists, err := repository.courses.Exists(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to check course existence: %v", err)
+	}
+	if exists {
+		t.Error("expected the course to be rolled back with the failing lesson")
+	}
+}
+
+func newTestBundleCourse(t *testing.T) *course.Course {
+	c, err := course.NewCourse(
+		generateID(),
+		"teacher-"+generateID(),
+		"Bundled Course",
+		"Bundled Description",
+		"bundled.jpg",
+		course.DomainProgramming,
+		[]course.Tag{course.TagBackend},
+		0,
+		course.Beginner,
+	)
+	if err != nil {
+		t.Fatalf("failed to create course domain model: %v", err)
+	}
+
+	return c
+}
+
+func newPostgreSQLBundleRepository(t *testing.T) *BundleRepository {
+	// Setup testcontainer for PostgreSQL
+	container, cleanup := SetupTestDatabase(t)
+	t.Cleanup(cleanup)
+
+	pool, err := pgxpool.New(context.Background(), container.ConnectionString)
+	if err != nil {
+		t.Fatalf("unable to create connection pool: %v", err)
+	}
+
+	if err := pool.Ping(context.Background()); err != nil {
+		t.Fatalf("unable to ping database: %v", err)
+	}
+
+	return NewBundleRepository(pool)
+}
@@ -0,0 +1,215 @@
+package postgresql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+)
+
+const courseListColumns = "c.id, c.teacher_id, c.title, c.description, c.thumbnail, c.duration, c.domain, c.rating, c.review_count, c.level, c.status, c.created_at, c.updated_at"
+
+// courseSortColumn is the SQL expression a listing sort orders by, and the type
+// its cursor value is cast back to
+type courseSortColumn struct {
+	expr string
+	cast string
+}
+
+var courseSortColumns = map[string]courseSortColumn{
+	"created_at": {expr: "c.created_at", cast: "timestamp"},
+	"title":      {expr: "c.title", cast: "text"},
+	"rating":     {expr: "COALESCE(c.rating, 0)", cast: "numeric"},
+	"duration":   {expr: "c.duration", cast: "integer"},
+}
+
+// cursorTimeLayout keeps the microseconds of a timestamp, the precision
+// Postgres stores it with
+const cursorTimeLayout = "2006-01-02T15:04:05.999999"
+
+// courseCursor marks the last course of a page. It records the sort it was
+// issued for, so it cannot be replayed against a different ordering.
+type courseCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func encodeCourseCursor(sort course.ListSort, c database.Course) string {
+	var value string
+	switch sort.Field() {
+	case "created_at":
+		value = c.CreatedAt.Time.Format(cursorTimeLayout)
+	case "title":
+		value = c.Title
+	case "rating":
+		value = fmt.Sprintf("%.2f", numericToFloat64(c.Rating))
+	case "duration":
+		value = strconv.Itoa(int(c.Duration))
+	}
+
+	raw, _ := json.Marshal(courseCursor{Sort: sort.String(), Value: value, ID: c.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCourseCursor(sort course.ListSort, cursor string) (courseCursor, error) {
+	invalid := commonerrors.NewValidationError("invalid-cursor", commonerrors.NewFieldError("cursor", "cursor is malformed"))
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return courseCursor{}, invalid
+	}
+
+	var c courseCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == "" {
+		return courseCursor{}, invalid
+	}
+	if c.Sort != sort.String() {
+		return courseCursor{}, commonerrors.NewValidationError(
+			"invalid-cursor",
+			commonerrors.NewFieldError("cursor", "cursor was issued for a different sort"),
+		)
+	}
+	if !isValidCursorValue(sort.Field(), c.Value) || !isValidText(c.ID) {
+		return courseCursor{}, commonerrors.NewValidationError(
+			"invalid-cursor",
+			commonerrors.NewFieldError("cursor", "cursor value does not fit the sort"),
+		)
+	}
+
+	return c, nil
+}
+
+// isValidCursorValue reports whether value parses as the type the sort column
+// is cast to, so a tampered cursor is rejected before it reaches the database
+func isValidCursorValue(field string, value string) bool {
+	switch field {
+	case "created_at":
+		_, err := time.Parse(cursorTimeLayout, value)
+		return err == nil
+	case "title":
+		return isValidText(value)
+	case "rating":
+		f, err := strconv.ParseFloat(value, 64)
+		return err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	case "duration":
+		_, err := strconv.ParseInt(value, 10, 32)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// isValidText reports whether value can be stored in a text column
+func isValidText(value string) bool {
+	return utf8.ValidString(value) && !strings.ContainsRune(value, 0)
+}
+
+// courseListQuery accumulates the conditions and positional arguments of a listing query
+type courseListQuery struct {
+	conditions []string
+	args       []any
+}
+
+func (q *courseListQuery) arg(value any) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *courseListQuery) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+// buildCourseListQuery turns a listing specification into SQL. One row more than
+// the limit is fetched to tell whether another page follows.
+func buildCourseListQuery(spec course.ListSpec) (string, []any, error) {
+	q := &courseListQuery{}
+	filter := spec.Filter()
+
+	if filter.Domain != nil {
+		q.where("c.domain = " + q.arg(filter.Domain.String()))
+	}
+	if filter.Level != nil {
+		q.where("c.level = " + q.arg(filter.Level.String()))
+	}
+	if filter.Status != nil {
+		q.where("c.status = " + q.arg(filter.Status.String()))
+	}
+	if filter.TeacherID != "" {
+		q.where("c.teacher_id = " + q.arg(filter.TeacherID))
+	}
+	if len(filter.Tags) > 0 {
+		tags := make([]string, 0, len(filter.Tags))
+		for _, t := range filter.Tags {
+			tags = append(tags, t.String())
+		}
+		q.where(fmt.Sprintf(
+			"c.id IN (SELECT course_id FROM course_tags WHERE tag = ANY(%s) GROUP BY course_id HAVING COUNT(DISTINCT tag) = %s)",
+			q.arg(tags),
+			q.arg(len(distinct(tags))),
+		))
+	}
+	if filter.MinRating != nil {
+		q.where("COALESCE(c.rating, 0) >= " + q.arg(fmt.Sprintf("%.2f", *filter.MinRating)) + "::numeric")
+	}
+	if filter.MaxRating != nil {
+		q.where("COALESCE(c.rating, 0) <= " + q.arg(fmt.Sprintf("%.2f", *filter.MaxRating)) + "::numeric")
+	}
+	if filter.MinDuration != nil {
+		q.where("c.duration >= " + q.arg(*filter.MinDuration))
+	}
+	if filter.MaxDuration != nil {
+		q.where("c.duration <= " + q.arg(*filter.MaxDuration))
+	}
+
+	column := courseSortColumns[spec.Sort().Field()]
+	direction, comparison := "ASC", ">"
+	if spec.Sort().Descending() {
+		direction, comparison = "DESC", "<"
+	}
+
+	if spec.Cursor() != "" {
+		cursor, err := decodeCourseCursor(spec.Sort(), spec.Cursor())
+		if err != nil {
+			return "", nil, err
+		}
+		q.where(fmt.Sprintf(
+			"(%s, c.id) %s (%s::%s, %s)",
+			column.expr,
+			comparison,
+			q.arg(cursor.Value),
+			column.cast,
+			q.arg(cursor.ID),
+		))
+	}
+
+	var sql strings.Builder
+	sql.WriteString("SELECT " + courseListColumns + " FROM courses c")
+	if len(q.conditions) > 0 {
+		sql.WriteString(" WHERE " + strings.Join(q.conditions, " AND "))
+	}
+	sql.WriteString(fmt.Sprintf(" ORDER BY %s %s, c.id %s", column.expr, direction, direction))
+	sql.WriteString(" LIMIT " + q.arg(spec.Limit()+1))
+
+	return sql.String(), q.args, nil
+}
+
+func distinct(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+)
+
+func TestDecodeCourseCursor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		sort    course.ListSort
+		value   string
+		wantErr bool
+	}{
+		{"created at", course.SortCreatedAtDesc, "2024-03-01T10:15:30.123456", false},
+		{"created at without fraction", course.SortCreatedAtAsc, "2024-03-01T10:15:30", false},
+		{"created at that is not a time", course.SortCreatedAtDesc, "yesterday", true},
+		{"title", course.SortTitleAsc, "Go Basics", false},
+		{"empty title", course.SortTitleAsc, "", false},
+		{"title with NUL byte", course.SortTitleAsc, "Go\x00", true},
+		{"rating", course.SortRatingDesc, "4.50", false},
+		{"rating that is not a number", course.SortRatingDesc, "high", true},
+		{"NaN rating", course.SortRatingDesc, "NaN", true},
+		{"infinite rating", course.SortRatingDesc, "Infinity", true},
+		{"duration", course.SortDurationAsc, "90", false},
+		{"fractional duration", course.SortDurationAsc, "1.5", true},
+		{"duration out of range", course.SortDurationAsc, "99999999999", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := encodeTestCursor(t, courseCursor{Sort: tt.sort.String(), Value: tt.value, ID: "course-1"})
+
+			decoded, err := decodeCourseCursor(tt.sort, cursor)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if decoded.Value != tt.value || decoded.ID != "course-1" {
+					t.Errorf("unexpected cursor %+v", decoded)
+				}
+				return
+			}
+
+			slugErr, ok := err.(commonerrors.SlugError)
+			if !ok {
+				t.Fatalf("expected slug error, got %v", err)
+			}
+			if slugErr.Slug() != "invalid-cursor" || len(slugErr.Fields()) != 1 || slugErr.Fields()[0].Field() != "cursor" {
+				t.Errorf("expected validation error 'invalid-cursor' on the cursor field, got %v", slugErr)
+			}
+			// The value sent by the client is never echoed back
+			if strings.Contains(slugErr.Error(), tt.value) || strings.Contains(slugErr.Fields()[0].Message(), tt.value) {
+				t.Errorf("expected the error not to contain the cursor value, got %v", slugErr)
+			}
+		})
+	}
+}
+
+func encodeTestCursor(t *testing.T, c courseCursor) string {
+	t.Helper()
+
+	raw, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("failed to marshal cursor: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -5,22 +5,28 @@ import (
 
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
 	"github.com/pkg/errors"
 )
 
 type CourseRepository struct {
-	db      *pgxpool.Pool
-	queries *database.Queries
+	db       *pgxpool.Pool
+	queries  *database.Queries
+	versions *VersionRepository
 }
 
 func NewCourseRepository(db *pgxpool.Pool) *CourseRepository {
 	return &CourseRepository{
-		db:      db,
-		queries: database.New(db),
+		db:       db,
+		queries:  database.New(db),
+		versions: NewVersionRepository(db),
 	}
 }
 
@@ -41,13 +47,8 @@ func (r *CourseRepository) Create(ctx context.Context, c *course.Course) error {
 	}
 
 	// Create course tags
-	for _, tag := range c.Tags() {
-		if err := qtx.CreateCourseTag(ctx, database.CreateCourseTagParams{
-			CourseID: c.ID(),
-			Tag:      tag.String(),
-		}); err != nil {
-			return errors.Wrap(err, "failed to create course tag")
-		}
+	if err := r.createCourseTags(ctx, qtx, c); err != nil {
+		return err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -78,15 +79,56 @@ func (r *CourseRepository) Update(ctx context.Context, c *course.Course) error {
 		return errors.Wrap(err, "failed to delete course tags")
 	}
 
-	for _, tag := range c.Tags() {
-		if err := qtx.CreateCourseTag(ctx, database.CreateCourseTagParams{
-			CourseID: c.ID(),
-			Tag:      tag.String(),
-		}); err != nil {
-			return errors.Wrap(err, "failed to create course tag")
+	if err := r.createCourseTags(ctx, qtx, c); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// UpdateStatus implements course.CourseRepository
+func (r *CourseRepository) UpdateStatus(ctx context.Context, id string, updateFn func(c *course.Course) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	// Lock the course, so concurrent status changes and publishes of the same
+	// course wait for each other instead of freezing the same version number
+	dbCourse, err := qtx.GetCourseByIDForUpdate(ctx, id)
+	if err != nil {
+		return errors.Wrap(translateError(err, "course"), "failed to get course")
+	}
+	c, err := r.toDomainCourse(ctx, qtx, dbCourse)
+	if err != nil {
+		return err
+	}
+
+	if err := updateFn(c); err != nil {
+		return err
+	}
+
+	// Freeze the content students will enroll on
+	if c.IsPublished() {
+		if err := r.versions.freeze(ctx, qtx, c.ID()); err != nil {
+			return err
 		}
 	}
 
+	if err := qtx.UpdateCourseStatus(ctx, database.UpdateCourseStatusParams{
+		ID:     c.ID(),
+		Status: c.Status().String(),
+	}); err != nil {
+		return errors.Wrap(err, "failed to update course status")
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -107,10 +149,10 @@ func (r *CourseRepository) Delete(ctx context.Context, id string) error {
 func (r *CourseRepository) Get(ctx context.Context, id string) (*course.Course, error) {
 	dbCourse, err := r.queries.GetCourseByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get course")
+		return nil, errors.Wrap(translateError(err, "course"), "failed to get course")
 	}
 
-	return r.toDomainCourse(ctx, dbCourse)
+	return r.toDomainCourse(ctx, r.queries, dbCourse)
 }
 
 // GetAll implements course.CourseRepository
@@ -122,7 +164,7 @@ func (r *CourseRepository) GetAll(ctx context.Context) ([]*course.Course, error)
 
 	courses := make([]*course.Course, 0, len(dbCourses))
 	for _, dbCourse := range dbCourses {
-		domainCourse, err := r.toDomainCourse(ctx, dbCourse)
+		domainCourse, err := r.toDomainCourse(ctx, r.queries, dbCourse)
 		if err != nil {
 			return nil, err
 		}
@@ -132,6 +174,194 @@ func (r *CourseRepository) GetAll(ctx context.Context) ([]*course.Course, error)
 	return courses, nil
 }
 
+// List implements course.CourseRepository
+func (r *CourseRepository) List(ctx context.Context, spec course.ListSpec) (course.ListPage, error) {
+	query, args, err := buildCourseListQuery(spec)
+	if err != nil {
+		return course.ListPage{}, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return course.ListPage{}, errors.Wrap(err, "failed to list courses")
+	}
+	defer rows.Close()
+
+	var dbCourses []database.Course
+	for rows.Next() {
+		var c database.Course
+		if err := rows.Scan(
+			&c.ID,
+			&c.TeacherID,
+			&c.Title,
+			&c.Description,
+			&c.Thumbnail,
+			&c.Duration,
+			&c.Domain,
+			&c.Rating,
+			&c.ReviewCount,
+			&c.Level,
+			&c.Status,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return course.ListPage{}, errors.Wrap(err, "failed to scan course")
+		}
+		dbCourses = append(dbCourses, c)
+	}
+	if err := rows.Err(); err != nil {
+		return course.ListPage{}, errors.Wrap(err, "failed to list courses")
+	}
+
+	// The extra row only tells that another page follows
+	nextCursor := ""
+	if len(dbCourses) > spec.Limit() {
+		dbCourses = dbCourses[:spec.Limit()]
+		nextCursor = encodeCourseCursor(spec.Sort(), dbCourses[len(dbCourses)-1])
+	}
+
+	courses := make([]*course.Course, 0, len(dbCourses))
+	for _, dbCourse := range dbCourses {
+		domainCourse, err := r.toDomainCourse(ctx, r.queries, dbCourse)
+		if err != nil {
+			return course.ListPage{}, err
+		}
+		courses = append(courses, domainCourse)
+	}
+
+	return course.NewListPage(courses, nextCursor), nil
+}
+
+// SearchCourses implements course_query.CourseSearchReadModel
+func (r *CourseRepository) SearchCourses(ctx context.Context, query string, limit int) ([]course_query.CourseSearchResult, error) {
+	rows, err := r.queries.SearchCourses(ctx, database.SearchCoursesParams{
+		Query:      query,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search courses")
+	}
+
+	results := make([]course_query.CourseSearchResult, 0, len(rows))
+	for _, row := range rows {
+		domainCourse, err := r.toDomainCourse(ctx, r.queries, database.Course{
+			ID:          row.ID,
+			TeacherID:   row.TeacherID,
+			Title:       row.Title,
+			Description: row.Description,
+			Thumbnail:   row.Thumbnail,
+			Duration:    row.Duration,
+			Domain:      row.Domain,
+			Rating:      row.Rating,
+			ReviewCount: row.ReviewCount,
+			Level:       row.Level,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, course_query.CourseSearchResult{
+			Course:         domainCourse,
+			Rank:           float64(row.Rank),
+			TitleHighlight: row.TitleHighlight,
+			Snippet:        row.Snippet,
+		})
+	}
+
+	return results, nil
+}
+
+// CourseOutline implements course_query.CourseOutlineReadModel
+func (r *CourseRepository) CourseOutline(ctx context.Context, courseID string) ([]course_query.OutlineModule, error) {
+	dbVersion, err := r.queries.GetLatestCourseVersion(ctx, courseID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Courses that were never published have no outline yet
+		return []course_query.OutlineModule{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get course outline")
+	}
+
+	v, err := toDomainVersion(dbVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make([]course_query.OutlineModule, 0, len(v.Modules()))
+	for _, mc := range v.Modules() {
+		m := mc.Module()
+		lessons := make([]course_query.OutlineLesson, 0, len(mc.Lessons()))
+		for _, lc := range mc.Lessons() {
+			l := lc.Lesson()
+			lessons = append(lessons, course_query.OutlineLesson{
+				ID:            l.ID(),
+				Title:         l.Title(),
+				Duration:      l.Duration(),
+				Order:         l.Order(),
+				HasVideo:      l.VideoID() != "",
+				ExerciseCount: lc.QuestionCount(),
+				Gate:          l.Gate(),
+			})
+		}
+		modules = append(modules, course_query.OutlineModule{
+			ID:       m.ID(),
+			Title:    m.Title(),
+			Order:    m.Order(),
+			Duration: m.Duration(),
+			Release:  m.Release(),
+			Lessons:  lessons,
+		})
+	}
+
+	return modules, nil
+}
+
+// EnrollmentOutline implements course_query.CourseOutlineReadModel
+func (r *CourseRepository) EnrollmentOutline(ctx context.Context, enrollmentID string) ([]course_query.OutlineModule, error) {
+	rows, err := r.queries.GetEnrollmentOutline(ctx, enrollmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get enrollment outline")
+	}
+	counts, err := r.enrollmentQuestionCounts(ctx, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []course_query.OutlineModule
+	for _, row := range rows {
+		release, err := module.NewRelease(int(row.ReleaseAfterDays), row.ReleaseAt.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid module release in outline")
+		}
+		gate, err := lesson.NewGate(row.RequiresPrevious, row.MinScoreLessonID, row.MinScore)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid lesson gate in outline")
+		}
+
+		modules = appendOutlineRow(modules, course_query.OutlineModule{
+			ID:       row.ModuleID,
+			Title:    row.ModuleTitle,
+			Order:    int(row.ModuleOrder),
+			Duration: int(row.ModuleDuration),
+			Release:  release,
+		}, course_query.OutlineLesson{
+			ID:            row.LessonID,
+			Title:         row.LessonTitle,
+			Duration:      int(row.LessonDuration),
+			Order:         int(row.LessonOrder),
+			HasVideo:      row.HasVideo,
+			ExerciseCount: counts[row.LessonID],
+			Gate:          gate,
+			Status:        row.LessonStatus,
+		})
+	}
+
+	return modules, nil
+}
+
 // GetAllByTeacherID implements course.CourseRepository
 func (r *CourseRepository) GetAllByTeacherID(ctx context.Context, teacherID string) ([]*course.Course, error) {
 	dbCourses, err := r.queries.GetCoursesByTeacherID(ctx, teacherID)
@@ -141,7 +371,7 @@ func (r *CourseRepository) GetAllByTeacherID(ctx context.Context, teacherID stri
 
 	courses := make([]*course.Course, 0, len(dbCourses))
 	for _, dbCourse := range dbCourses {
-		domainCourse, err := r.toDomainCourse(ctx, dbCourse)
+		domainCourse, err := r.toDomainCourse(ctx, r.queries, dbCourse)
 		if err != nil {
 			return nil, err
 		}
@@ -160,6 +390,82 @@ func (r *CourseRepository) Exists(ctx context.Context, id string) (bool, error)
 	return exists, nil
 }
 
+// UpdatePrerequisites implements course.CourseRepository
+func (r *CourseRepository) UpdatePrerequisites(ctx context.Context, c *course.Course) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteCoursePrerequisites(ctx, c.ID()); err != nil {
+		return errors.Wrap(err, "failed to delete course prerequisites")
+	}
+
+	for _, p := range c.Prerequisites() {
+		if err := qtx.CreateCoursePrerequisite(ctx, database.CreateCoursePrerequisiteParams{
+			CourseID:       c.ID(),
+			PrerequisiteID: p.CourseID(),
+			Required:       p.Required(),
+		}); err != nil {
+			return errors.Wrap(translateError(err, "prerequisite"), "failed to create course prerequisite")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// TransitivePrerequisites implements course.CourseRepository
+func (r *CourseRepository) TransitivePrerequisites(ctx context.Context, courseIDs []string) ([]string, error) {
+	ids, err := r.queries.GetTransitivePrerequisites(ctx, courseIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transitive prerequisites")
+	}
+	return ids, nil
+}
+
+// GrantWaiver implements course.PrerequisiteWaiverRepository
+func (r *CourseRepository) GrantWaiver(ctx context.Context, w course.PrerequisiteWaiver) error {
+	if err := r.queries.UpsertPrerequisiteWaiver(ctx, database.UpsertPrerequisiteWaiverParams{
+		CourseID:  w.CourseID(),
+		UserID:    w.UserID(),
+		GrantedBy: w.GrantedBy(),
+		GrantedAt: pgtype.Timestamp{Time: w.GrantedAt(), Valid: true},
+	}); err != nil {
+		return errors.Wrap(err, "failed to grant prerequisite waiver")
+	}
+	return nil
+}
+
+// RevokeWaiver implements course.PrerequisiteWaiverRepository
+func (r *CourseRepository) RevokeWaiver(ctx context.Context, courseID string, userID string) error {
+	if err := r.queries.DeletePrerequisiteWaiver(ctx, database.DeletePrerequisiteWaiverParams{
+		CourseID: courseID,
+		UserID:   userID,
+	}); err != nil {
+		return errors.Wrap(err, "failed to revoke prerequisite waiver")
+	}
+	return nil
+}
+
+// HasWaiver implements course.PrerequisiteWaiverRepository
+func (r *CourseRepository) HasWaiver(ctx context.Context, courseID string, userID string) (bool, error) {
+	exists, err := r.queries.PrerequisiteWaiverExists(ctx, database.PrerequisiteWaiverExistsParams{
+		CourseID: courseID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check prerequisite waiver")
+	}
+	return exists, nil
+}
+
 // Helper methods
 
 func (r *CourseRepository) createCourse(ctx context.Context, q *database.Queries, c *course.Course) error {
@@ -177,14 +483,27 @@ func (r *CourseRepository) createCourse(ctx context.Context, q *database.Queries
 		Title:       c.Title(),
 		Description: description,
 		Thumbnail:   thumbnail,
-		Duration:    int32(c.Duration()),
 		Domain:      c.Domain().String(),
 		Rating:      rating,
 		Level:       c.Level().String(),
+		Status:      c.Status().String(),
 	}
 
 	if err := q.CreateCourse(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create course")
+		return errors.Wrap(translateError(err, "course"), "failed to create course")
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) createCourseTags(ctx context.Context, q *database.Queries, c *course.Course) error {
+	for _, tag := range c.Tags() {
+		if err := q.CreateCourseTag(ctx, database.CreateCourseTagParams{
+			CourseID: c.ID(),
+			Tag:      tag.String(),
+		}); err != nil {
+			return errors.Wrap(err, "failed to create course tag")
+		}
 	}
 
 	return nil
@@ -194,20 +513,15 @@ func (r *CourseRepository) updateCourse(ctx context.Context, q *database.Queries
 	description := pgtype.Text{String: c.Description(), Valid: c.Description() != ""}
 	thumbnail := pgtype.Text{String: c.Thumbnail(), Valid: c.Thumbnail() != ""}
 
-	var rating pgtype.Numeric
-	if err := rating.Scan(fmt.Sprintf("%.2f", c.Rating())); err != nil {
-		return errors.Wrap(err, "failed to convert rating")
-	}
-
+	// The rating is aggregated from reviews and the status changes through
+	// UpdateStatus, so neither is written back by course updates
 	params := database.UpdateCourseParams{
 		ID:          c.ID(),
 		TeacherID:   c.TeacherID(),
 		Title:       c.Title(),
 		Description: description,
 		Thumbnail:   thumbnail,
-		Duration:    int32(c.Duration()),
 		Domain:      c.Domain().String(),
-		Rating:      rating,
 		Level:       c.Level().String(),
 	}
 
@@ -218,9 +532,9 @@ func (r *CourseRepository) updateCourse(ctx context.Context, q *database.Queries
 	return nil
 }
 
-func (r *CourseRepository) toDomainCourse(ctx context.Context, dbCourse database.Course) (*course.Course, error) {
+func (r *CourseRepository) toDomainCourse(ctx context.Context, q *database.Queries, dbCourse database.Course) (*course.Course, error) {
 	// Get tags
-	dbTags, err := r.queries.GetCourseTagsByCourseID(ctx, dbCourse.ID)
+	dbTags, err := q.GetCourseTagsByCourseID(ctx, dbCourse.ID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get course tags")
 	}
@@ -234,6 +548,21 @@ func (r *CourseRepository) toDomainCourse(ctx context.Context, dbCourse database
 		tags = append(tags, tag)
 	}
 
+	// Get prerequisites
+	dbPrerequisites, err := q.GetCoursePrerequisites(ctx, dbCourse.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get course prerequisites")
+	}
+
+	prerequisites := make([]course.Prerequisite, 0, len(dbPrerequisites))
+	for _, dbPrerequisite := range dbPrerequisites {
+		p, err := course.NewPrerequisite(dbPrerequisite.PrerequisiteID, dbPrerequisite.Required)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid prerequisite")
+		}
+		prerequisites = append(prerequisites, p)
+	}
+
 	// Convert domain and level
 	domain, err := course.NewDomainFromString(dbCourse.Domain)
 	if err != nil {
@@ -245,6 +574,11 @@ func (r *CourseRepository) toDomainCourse(ctx context.Context, dbCourse database
 		return nil, errors.Wrap(err, "invalid level")
 	}
 
+	status, err := course.NewCourseStatusFromString(dbCourse.Status)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid status")
+	}
+
 	// Convert optional fields
 	description := ""
 	if dbCourse.Description.Valid {
@@ -264,7 +598,7 @@ func (r *CourseRepository) toDomainCourse(ctx context.Context, dbCourse database
 		}
 	}
 
-	return course.NewCourse(
+	return course.UnmarshalCourseFromDatabase(
 		dbCourse.ID,
 		dbCourse.TeacherID,
 		dbCourse.Title,
@@ -274,6 +608,55 @@ func (r *CourseRepository) toDomainCourse(ctx context.Context, dbCourse database
 		domain,
 		tags,
 		rating,
+		int(dbCourse.ReviewCount),
 		level,
-	)
+		status,
+		prerequisites,
+	), nil
+}
+
+// enrollmentQuestionCounts returns how many questions a student answers on
+// every lesson of the content version an enrollment is pinned to, keyed by
+// lesson
+func (r *CourseRepository) enrollmentQuestionCounts(ctx context.Context, enrollmentID string) (map[string]int, error) {
+	dbEnrollment, err := r.queries.GetEnrollmentByID(ctx, enrollmentID)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "enrollment"), "failed to get enrollment outline")
+	}
+	dbVersion, err := r.queries.GetCourseVersion(ctx, database.GetCourseVersionParams{
+		CourseID: dbEnrollment.CourseID,
+		Version:  dbEnrollment.ContentVersion,
+	})
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "version"), "failed to get enrollment outline")
+	}
+	v, err := toDomainVersion(dbVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, mc := range v.Modules() {
+		for _, lc := range mc.Lessons() {
+			counts[lc.Lesson().ID()] = lc.QuestionCount()
+		}
+	}
+	return counts, nil
+}
+
+// appendOutlineRow adds a row of an outline query to the outline. Rows come
+// ordered by module, and a module without lessons has a single row with an
+// empty lesson ID.
+func appendOutlineRow(modules []course_query.OutlineModule, m course_query.OutlineModule, l course_query.OutlineLesson) []course_query.OutlineModule {
+	if len(modules) == 0 || modules[len(modules)-1].ID != m.ID {
+		m.Lessons = []course_query.OutlineLesson{}
+		modules = append(modules, m)
+	}
+
+	if l.ID != "" {
+		last := &modules[len(modules)-1]
+		last.Lessons = append(last.Lessons, l)
+	}
+
+	return modules
 }
@@ -4,11 +4,20 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
 )
 
 type CourseRepositoryTest struct {
@@ -49,6 +58,30 @@ func TestCourseRepository(t *testing.T) {
 				t.Parallel()
 				testCourseGetAll(t, r.Repository)
 			})
+			t.Run("List", func(t *testing.T) {
+				t.Parallel()
+				testCourseList(t, r.Repository)
+			})
+			t.Run("SearchCourses", func(t *testing.T) {
+				t.Parallel()
+				testCourseSearch(t, r.Repository)
+			})
+			t.Run("DerivedDuration", func(t *testing.T) {
+				t.Parallel()
+				testCourseDerivedDuration(t, r.Repository)
+			})
+			t.Run("CourseOutline", func(t *testing.T) {
+				t.Parallel()
+				testCourseOutline(t, r.Repository)
+			})
+			t.Run("UpdateStatus", func(t *testing.T) {
+				t.Parallel()
+				testCourseUpdateStatus(t, r.Repository)
+			})
+			t.Run("UpdateStatusFreezesVersion", func(t *testing.T) {
+				t.Parallel()
+				testCourseUpdateStatusFreezesVersion(t, r.Repository)
+			})
 			t.Run("GetAllByTeacherID", func(t *testing.T) {
 				t.Parallel()
 				testCourseGetAllByTeacherID(t, r.Repository)
@@ -65,6 +98,14 @@ func TestCourseRepository(t *testing.T) {
 				t.Parallel()
 				testUpdateCourseTags(t, r.Repository)
 			})
+			t.Run("Prerequisites", func(t *testing.T) {
+				t.Parallel()
+				testCoursePrerequisites(t, r.Repository)
+			})
+			t.Run("PrerequisiteWaivers", func(t *testing.T) {
+				t.Parallel()
+				testCoursePrerequisiteWaivers(t, r.Repository)
+			})
 		})
 	}
 }
@@ -88,7 +129,6 @@ func testCourseCreateAndGet(t *testing.T, repository *CourseRepository) {
 		"Test Course",
 		"Test Description",
 		"test-thumbnail.jpg",
-		3600,
 		course.DomainProgramming,
 		[]course.Tag{course.TagBackend},
 		4.5,
@@ -123,7 +163,6 @@ func testCourseUpdate(t *testing.T, repository *CourseRepository) {
 		"Original Title",
 		"Original Description",
 		"original.jpg",
-		3600,
 		course.DomainProgramming,
 		[]course.Tag{course.TagBackend},
 		4.0,
@@ -134,7 +173,6 @@ func testCourseUpdate(t *testing.T, repository *CourseRepository) {
 
 	// Update course
 	c.UpdateBasicInfo("Updated Title", "Updated Description", "updated.jpg")
-	c.UpdateDuration(7200)
 	c.UpdateRating(4.8)
 
 	if err := repository.Update(ctx, c); err != nil {
@@ -156,11 +194,9 @@ func testCourseUpdate(t *testing.T, repository *CourseRepository) {
 	if retrieved.Thumbnail() != "updated.jpg" {
 		t.Errorf("expected thumbnail 'updated.jpg', got '%s'", retrieved.Thumbnail())
 	}
-	if retrieved.Duration() != 7200 {
-		t.Errorf("expected duration 7200, got %d", retrieved.Duration())
-	}
-	if retrieved.Rating() != 4.8 {
-		t.Errorf("expected rating 4.8, got %f", retrieved.Rating())
+	// The rating is aggregated from reviews, so updates leave it alone
+	if retrieved.Rating() != 4.0 {
+		t.Errorf("expected rating 4.0, got %f", retrieved.Rating())
 	}
 }
 
@@ -174,7 +210,6 @@ func testCourseDelete(t *testing.T, repository *CourseRepository) {
 		"Course to Delete",
 		"",
 		"",
-		0,
 		course.DomainProgramming,
 		nil,
 		0,
@@ -214,7 +249,6 @@ func testCourseGetAll(t *testing.T, repository *CourseRepository) {
 			fmt.Sprintf("Course %d", i+1),
 			"",
 			"",
-			0,
 			course.DomainProgramming,
 			nil,
 			0,
@@ -246,6 +280,419 @@ func testCourseGetAll(t *testing.T, repository *CourseRepository) {
 	}
 }
 
+func testCourseList(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	// Create courses with distinct ratings for a unique teacher
+	teacherID := "teacher-" + generateID()
+	ratings := []float64{4.5, 3.0, 5.0, 4.0}
+	for i, rating := range ratings {
+		tags := []course.Tag{course.TagBackend}
+		if i%2 == 0 {
+			tags = append(tags, course.TagAPI)
+		}
+		c, _ := course.NewCourse(
+			generateID(),
+			teacherID,
+			fmt.Sprintf("Listed Course %d", i+1),
+			"",
+			"",
+			course.DomainProgramming,
+			tags,
+			rating,
+			course.Beginner,
+		)
+		if err := repository.Create(ctx, c); err != nil {
+			t.Fatalf("failed to create course: %v", err)
+		}
+	}
+
+	// Page through the teacher's courses, highest rated first
+	var listed []float64
+	cursor := ""
+	for {
+		spec, err := course.NewListSpec(course.ListFilter{TeacherID: teacherID}, course.SortRatingDesc, 3, cursor)
+		if err != nil {
+			t.Fatalf("failed to create list spec: %v", err)
+		}
+		page, err := repository.List(ctx, spec)
+		if err != nil {
+			t.Fatalf("failed to list courses: %v", err)
+		}
+		for _, c := range page.Courses() {
+			listed = append(listed, c.Rating())
+		}
+		if !page.HasMore() {
+			break
+		}
+		cursor = page.NextCursor()
+	}
+
+	expected := []float64{5.0, 4.5, 4.0, 3.0}
+	if fmt.Sprint(listed) != fmt.Sprint(expected) {
+		t.Errorf("expected ratings %v, got %v", expected, listed)
+	}
+
+	// Filter by several tags and a rating range
+	minRating := 4.0
+	spec, _ := course.NewListSpec(course.ListFilter{
+		TeacherID: teacherID,
+		Tags:      []course.Tag{course.TagBackend, course.TagAPI},
+		MinRating: &minRating,
+	}, course.SortDurationAsc, 10, "")
+	page, err := repository.List(ctx, spec)
+	if err != nil {
+		t.Fatalf("failed to list courses: %v", err)
+	}
+	if len(page.Courses()) != 2 {
+		t.Errorf("expected 2 courses with both tags and rating >= 4, got %d", len(page.Courses()))
+	}
+
+	// A cursor issued for another sort is rejected
+	spec, _ = course.NewListSpec(course.ListFilter{TeacherID: teacherID}, course.SortTitleAsc, 3, cursor)
+	if _, err := repository.List(ctx, spec); err == nil {
+		t.Error("expected error for cursor of another sort, got nil")
+	}
+}
+
+func testCourseSearch(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	// Unique terms keep parallel tests from matching each other's courses
+	titleTerm := generateSearchTerm("zircon")
+	lessonTerm := generateSearchTerm("quasar")
+
+	titled, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Mastering "+titleTerm, "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	publishCourse(t, titled)
+	if err := repository.Create(ctx, titled); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	rv, _ := review.NewReview(generateReviewID(), titled.ID(), createTestStudent(t, ctx, repository.db), 4, "")
+	if err := NewReviewRepository(repository.db).Create(ctx, rv); err != nil {
+		t.Fatalf("failed to create review: %v", err)
+	}
+
+	// Drafts are left out of the search results
+	draft, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Drafting "+titleTerm, "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err := repository.Create(ctx, draft); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+
+	taught, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Astronomy Basics", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	publishCourse(t, taught)
+	if err := repository.Create(ctx, taught); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	m, _ := module.NewModule(generateID(), taught.ID(), "Deep Sky", 0)
+	if err := NewModuleRepository(repository.db).Create(ctx, m); err != nil {
+		t.Fatalf("failed to create module: %v", err)
+	}
+	l, _ := lesson.NewLesson(generateID(), m.ID(), "Observing <b>"+lessonTerm+"</b> objects", "", "", "", 0, 0)
+	if err := NewLessonRepository(repository.db).Create(ctx, l); err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+
+	// Matches in the course title are highlighted
+	results, err := repository.SearchCourses(ctx, titleTerm, 10)
+	if err != nil {
+		t.Fatalf("failed to search courses: %v", err)
+	}
+	if len(results) != 1 || results[0].Course.ID() != titled.ID() {
+		t.Fatalf("expected only course '%s', got %d results", titled.ID(), len(results))
+	}
+	if !strings.Contains(results[0].TitleHighlight, course_query.HighlightStart+titleTerm+course_query.HighlightStop) {
+		t.Errorf("expected highlighted title, got '%s'", results[0].TitleHighlight)
+	}
+	if results[0].Course.Rating() != 4 || results[0].Course.ReviewCount() != 1 {
+		t.Errorf("expected rating 4 from 1 review, got %f from %d", results[0].Course.Rating(), results[0].Course.ReviewCount())
+	}
+
+	// Lessons of the working copy are only indexed once they are published
+	results, err = repository.SearchCourses(ctx, lessonTerm, 10)
+	if err != nil {
+		t.Fatalf("failed to search courses: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected unpublished lessons to be left out, got %d results", len(results))
+	}
+	if err := repository.UpdateStatus(ctx, taught.ID(), func(c *course.Course) error { return nil }); err != nil {
+		t.Fatalf("failed to publish course version: %v", err)
+	}
+
+	// Lesson titles are indexed through the course search document
+	results, err = repository.SearchCourses(ctx, lessonTerm, 10)
+	if err != nil {
+		t.Fatalf("failed to search courses: %v", err)
+	}
+	if len(results) != 1 || results[0].Course.ID() != taught.ID() {
+		t.Fatalf("expected only course '%s', got %d results", taught.ID(), len(results))
+	}
+	if !strings.Contains(results[0].Snippet, lessonTerm) {
+		t.Errorf("expected snippet to contain '%s', got '%s'", lessonTerm, results[0].Snippet)
+	}
+}
+
+func testCourseDerivedDuration(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+	modules := NewModuleRepository(repository.db)
+	lessons := NewLessonRepository(repository.db)
+
+	c, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Timed Course", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err := repository.Create(ctx, c); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+
+	first, _ := module.NewModule(generateID(), c.ID(), "First", 0)
+	second, _ := module.NewModule(generateID(), c.ID(), "Second", 1)
+	for _, m := range []*module.Module{first, second} {
+		if err := modules.Create(ctx, m); err != nil {
+			t.Fatalf("failed to create module: %v", err)
+		}
+	}
+
+	short, _ := lesson.NewLesson(generateID(), first.ID(), "Short", "", "", "", 10, 0)
+	long, _ := lesson.NewLesson(generateID(), first.ID(), "Long", "", "", "", 30, 1)
+	other, _ := lesson.NewLesson(generateID(), second.ID(), "Other", "", "", "", 5, 0)
+	for _, l := range []*lesson.Lesson{short, long, other} {
+		if err := lessons.Create(ctx, l); err != nil {
+			t.Fatalf("failed to create lesson: %v", err)
+		}
+	}
+
+	assertDurations := func(courseDuration, firstDuration int) {
+		t.Helper()
+		retrieved, err := repository.Get(ctx, c.ID())
+		if err != nil {
+			t.Fatalf("failed to get course: %v", err)
+		}
+		if retrieved.Duration() != courseDuration {
+			t.Errorf("expected course duration %d, got %d", courseDuration, retrieved.Duration())
+		}
+		m, err := modules.Get(ctx, first.ID())
+		if err != nil {
+			t.Fatalf("failed to get module: %v", err)
+		}
+		if m.Duration() != firstDuration {
+			t.Errorf("expected module duration %d, got %d", firstDuration, m.Duration())
+		}
+	}
+
+	assertDurations(45, 40)
+
+	// Updating a lesson duration updates its module and course
+	long.UpdateDuration(20)
+	if err := lessons.Update(ctx, long); err != nil {
+		t.Fatalf("failed to update lesson: %v", err)
+	}
+	assertDurations(35, 30)
+
+	// Deleting a lesson or a whole module does too
+	if err := lessons.Delete(ctx, short.ID()); err != nil {
+		t.Fatalf("failed to delete lesson: %v", err)
+	}
+	assertDurations(25, 20)
+
+	if err := modules.Delete(ctx, second.ID()); err != nil {
+		t.Fatalf("failed to delete module: %v", err)
+	}
+	assertDurations(20, 20)
+}
+
+func testCourseOutline(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	c, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Outlined Course", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err := repository.Create(ctx, c); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+
+	// The working copy is not part of the outline until it is published
+	draft, _ := module.NewModule(generateID(), c.ID(), "Draft", 0)
+	if err := NewModuleRepository(repository.db).Create(ctx, draft); err != nil {
+		t.Fatalf("failed to create module: %v", err)
+	}
+	outline, err := repository.CourseOutline(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get course outline: %v", err)
+	}
+	if len(outline) != 0 {
+		t.Fatalf("expected no modules before the course is published, got %+v", outline)
+	}
+
+	first, _ := module.NewModule(generateID(), c.ID(), "First", 0)
+	second, _ := module.NewModule(generateID(), c.ID(), "Second", 1)
+	text, _ := lesson.NewLesson(generateID(), first.ID(), "Text only", "", "", "", 5, 0)
+	video, _ := lesson.NewLesson(generateID(), first.ID(), "With video", "", "", "video-1", 15, 1)
+	pool, _ := bank.NewPool(generateID(), bank.NewFilter("go", bank.Easy), 2)
+	_ = video.SetDrawCount(1)
+	_ = video.SetBankQuestions(nil, []bank.Pool{pool})
+
+	// The video lesson draws one of its own two exercises and two of the
+	// three questions frozen from its bank pool
+	var exercises []*exercise.Exercise
+	for i := 0; i < 5; i++ {
+		e, _ := exercise.NewExercise(generateID(), video.ID(), "2 + 2?", []string{"3", "4"}, "4", i)
+		if i >= 2 {
+			e.SetPool(pool.Key())
+		}
+		exercises = append(exercises, e)
+	}
+	v, err := version.NewVersion(c.ID(), 1, []version.ModuleContent{
+		version.NewModuleContent(first, []version.LessonContent{
+			version.NewLessonContent(text, nil),
+			version.NewLessonContent(video, exercises),
+		}),
+		version.NewModuleContent(second, nil),
+	})
+	if err != nil {
+		t.Fatalf("failed to create version domain model: %v", err)
+	}
+	if err := NewVersionRepository(repository.db).Create(ctx, v); err != nil {
+		t.Fatalf("failed to create version: %v", err)
+	}
+
+	outline, err = repository.CourseOutline(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get course outline: %v", err)
+	}
+
+	if len(outline) != 2 || outline[0].ID != first.ID() || outline[1].ID != second.ID() {
+		t.Fatalf("expected modules [First, Second], got %+v", outline)
+	}
+	if len(outline[1].Lessons) != 0 {
+		t.Errorf("expected no lessons in the second module, got %d", len(outline[1].Lessons))
+	}
+	if outline[0].Duration != 20 {
+		t.Errorf("expected the first module to last 20 minutes, got %d", outline[0].Duration)
+	}
+
+	lessons := outline[0].Lessons
+	if len(lessons) != 2 || lessons[0].ID != text.ID() || lessons[1].ID != video.ID() {
+		t.Fatalf("expected lessons [Text only, With video], got %+v", lessons)
+	}
+	if lessons[0].HasVideo || lessons[0].ExerciseCount != 0 {
+		t.Errorf("expected a text lesson without exercises, got %+v", lessons[0])
+	}
+	if !lessons[1].HasVideo || lessons[1].ExerciseCount != 3 || lessons[1].Duration != 15 {
+		t.Errorf("expected a 15 minute video lesson with 3 questions, got %+v", lessons[1])
+	}
+}
+
+func testCourseUpdateStatus(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	c, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Course in Review", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err := repository.Create(ctx, c); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+
+	if err := repository.UpdateStatus(ctx, c.ID(), func(c *course.Course) error {
+		return c.ChangeStatus(course.StatusInReview)
+	}); err != nil {
+		t.Fatalf("failed to update course status: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if retrieved.Status() != course.StatusInReview {
+		t.Errorf("expected status in_review, got %s", retrieved.Status())
+	}
+
+	// Metadata updates leave the status alone
+	if err := repository.Update(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update course: %v", err)
+	}
+	retrieved, err = repository.Get(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if retrieved.Status() != course.StatusInReview {
+		t.Errorf("expected status in_review after update, got %s", retrieved.Status())
+	}
+
+	// Listings filtered by status only include courses in that status
+	spec, _ := course.NewListSpec(course.ListFilter{TeacherID: c.TeacherID(), Status: &course.StatusPublished}, course.ListSort{}, 0, "")
+	page, err := repository.List(ctx, spec)
+	if err != nil {
+		t.Fatalf("failed to list courses: %v", err)
+	}
+	if len(page.Courses()) != 0 {
+		t.Errorf("expected no published courses, got %d", len(page.Courses()))
+	}
+
+	// A failing update saves nothing
+	if err := repository.UpdateStatus(ctx, c.ID(), func(c *course.Course) error {
+		return c.ChangeStatus(course.StatusArchived)
+	}); err == nil {
+		t.Fatal("expected an invalid transition to fail")
+	}
+	if latest, _ := repository.versions.LatestNumber(ctx, c.ID()); latest != 0 {
+		t.Errorf("expected no version before the course is published, got %d", latest)
+	}
+}
+
+func testCourseUpdateStatusFreezesVersion(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	c, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Course to Publish", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err := repository.Create(ctx, c); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	m, _ := module.NewModule(generateID(), c.ID(), "Basics", 0)
+	if err := NewModuleRepository(repository.db).Create(ctx, m); err != nil {
+		t.Fatalf("failed to create module: %v", err)
+	}
+	l, _ := lesson.NewLesson(generateID(), m.ID(), "Intro", "", "", "", 10, 0)
+	if err := NewLessonRepository(repository.db).Create(ctx, l); err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+
+	for _, status := range []course.CourseStatus{course.StatusInReview, course.StatusPublished} {
+		if err := repository.UpdateStatus(ctx, c.ID(), func(c *course.Course) error {
+			return c.ChangeStatus(status)
+		}); err != nil {
+			t.Fatalf("failed to update course status: %v", err)
+		}
+	}
+
+	v, err := repository.versions.Get(ctx, c.ID(), 1)
+	if err != nil {
+		t.Fatalf("expected publishing to freeze version 1: %v", err)
+	}
+	if len(v.Modules()) != 1 || len(v.Modules()[0].Lessons()) != 1 || v.Modules()[0].Lessons()[0].Lesson().ID() != l.ID() {
+		t.Errorf("expected the version to hold the working copy, got %+v", v.Modules())
+	}
+
+	// Concurrent publishes of the same course freeze one version each
+	const publishCount = 3
+	var wg sync.WaitGroup
+	errs := make(chan error, publishCount)
+	for i := 0; i < publishCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repository.UpdateStatus(ctx, c.ID(), func(c *course.Course) error { return nil })
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent publish failed: %v", err)
+		}
+	}
+
+	latest, err := repository.versions.LatestNumber(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to get latest version: %v", err)
+	}
+	if latest != 1+publishCount {
+		t.Errorf("expected version %d, got %d", 1+publishCount, latest)
+	}
+}
+
 func testCourseGetAllByTeacherID(t *testing.T, repository *CourseRepository) {
 	ctx := context.Background()
 
@@ -259,7 +706,6 @@ func testCourseGetAllByTeacherID(t *testing.T, repository *CourseRepository) {
 			fmt.Sprintf("Teacher Course %d", i+1),
 			"",
 			"",
-			0,
 			course.DomainProgramming,
 			nil,
 			0,
@@ -275,7 +721,6 @@ func testCourseGetAllByTeacherID(t *testing.T, repository *CourseRepository) {
 		"Other Course",
 		"",
 		"",
-		0,
 		course.DomainProgramming,
 		nil,
 		0,
@@ -322,7 +767,6 @@ func testCourseExists(t *testing.T, repository *CourseRepository) {
 		"Existence Test",
 		"",
 		"",
-		0,
 		course.DomainProgramming,
 		nil,
 		0,
@@ -350,7 +794,6 @@ func testCourseWithTags(t *testing.T, repository *CourseRepository) {
 		"Course with Tags",
 		"",
 		"",
-		0,
 		course.DomainProgramming,
 		[]course.Tag{course.TagBackend, course.TagAPI, course.TagCloud, course.TagDatabase},
 		4.2,
@@ -400,7 +843,6 @@ func testUpdateCourseTags(t *testing.T, repository *CourseRepository) {
 		"Course for Tag Update",
 		"",
 		"",
-		0,
 		course.DomainProgramming,
 		[]course.Tag{course.TagBackend, course.TagAPI},
 		0,
@@ -442,6 +884,109 @@ func testUpdateCourseTags(t *testing.T, repository *CourseRepository) {
 
 // Helper functions
 
+func testCoursePrerequisites(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	// basics <- intermediate <- advanced
+	newCourse := func(level course.CourseLevel) *course.Course {
+		c, err := course.NewCourse(generateID(), "teacher-"+generateID(), "Prerequisite Test", "", "", course.DomainProgramming, nil, 0, level)
+		if err != nil {
+			t.Fatalf("failed to create course domain model: %v", err)
+		}
+		if err := repository.Create(ctx, c); err != nil {
+			t.Fatalf("failed to create course: %v", err)
+		}
+		return c
+	}
+	setPrerequisite := func(c *course.Course, prerequisiteID string, required bool) {
+		p, _ := course.NewPrerequisite(prerequisiteID, required)
+		if err := c.SetPrerequisites([]course.Prerequisite{p}); err != nil {
+			t.Fatalf("failed to set prerequisites: %v", err)
+		}
+		if err := repository.UpdatePrerequisites(ctx, c); err != nil {
+			t.Fatalf("failed to update prerequisites: %v", err)
+		}
+	}
+
+	basics := newCourse(course.Beginner)
+	intermediate := newCourse(course.Intermediate)
+	advanced := newCourse(course.Advanced)
+	setPrerequisite(intermediate, basics.ID(), false)
+	setPrerequisite(advanced, intermediate.ID(), true)
+
+	retrieved, err := repository.Get(ctx, advanced.ID())
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if required := retrieved.RequiredPrerequisites(); len(required) != 1 || required[0] != intermediate.ID() {
+		t.Errorf("expected %s to be required, got %v", intermediate.ID(), required)
+	}
+
+	transitive, err := repository.TransitivePrerequisites(ctx, []string{advanced.ID()})
+	if err != nil {
+		t.Fatalf("failed to get transitive prerequisites: %v", err)
+	}
+	if len(transitive) != 2 {
+		t.Errorf("expected 2 transitive prerequisites, got %v", transitive)
+	}
+
+	// Clearing the prerequisites removes them all
+	if err := retrieved.SetPrerequisites(nil); err != nil {
+		t.Fatalf("failed to clear prerequisites: %v", err)
+	}
+	if err := repository.UpdatePrerequisites(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update prerequisites: %v", err)
+	}
+	retrieved, err = repository.Get(ctx, advanced.ID())
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if len(retrieved.Prerequisites()) != 0 {
+		t.Errorf("expected no prerequisites, got %d", len(retrieved.Prerequisites()))
+	}
+}
+
+func testCoursePrerequisiteWaivers(t *testing.T, repository *CourseRepository) {
+	ctx := context.Background()
+
+	c, _ := course.NewCourse(generateID(), "teacher-"+generateID(), "Waiver Test", "", "", course.DomainProgramming, nil, 0, course.Advanced)
+	if err := repository.Create(ctx, c); err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	studentID := createTestStudent(t, ctx, repository.db)
+
+	waiver, err := course.NewPrerequisiteWaiver(c.ID(), studentID, c.TeacherID())
+	if err != nil {
+		t.Fatalf("failed to create waiver: %v", err)
+	}
+	if err := repository.GrantWaiver(ctx, waiver); err != nil {
+		t.Fatalf("failed to grant waiver: %v", err)
+	}
+	// Granting twice replaces the waiver
+	if err := repository.GrantWaiver(ctx, waiver); err != nil {
+		t.Fatalf("failed to grant waiver again: %v", err)
+	}
+
+	waived, err := repository.HasWaiver(ctx, c.ID(), studentID)
+	if err != nil {
+		t.Fatalf("failed to check waiver: %v", err)
+	}
+	if !waived {
+		t.Error("expected the student to have a waiver")
+	}
+
+	if err := repository.RevokeWaiver(ctx, c.ID(), studentID); err != nil {
+		t.Fatalf("failed to revoke waiver: %v", err)
+	}
+	waived, err = repository.HasWaiver(ctx, c.ID(), studentID)
+	if err != nil {
+		t.Fatalf("failed to check waiver: %v", err)
+	}
+	if waived {
+		t.Error("expected the waiver to be revoked")
+	}
+}
+
 func assertCourseEqual(t *testing.T, expected, actual *course.Course) {
 	if actual.ID() != expected.ID() {
 		t.Errorf("expected ID '%s', got '%s'", expected.ID(), actual.ID())
@@ -470,11 +1015,34 @@ func assertCourseEqual(t *testing.T, expected, actual *course.Course) {
 	if actual.Level().String() != expected.Level().String() {
 		t.Errorf("expected Level '%s', got '%s'", expected.Level().String(), actual.Level().String())
 	}
+	if actual.Status() != expected.Status() {
+		t.Errorf("expected Status '%s', got '%s'", expected.Status(), actual.Status())
+	}
 	if len(actual.Tags()) != len(expected.Tags()) {
 		t.Errorf("expected %d tags, got %d", len(expected.Tags()), len(actual.Tags()))
 	}
 }
 
+// publishCourse moves a new course through review to published
+func publishCourse(t *testing.T, c *course.Course) {
+	t.Helper()
+	for _, status := range []course.CourseStatus{course.StatusInReview, course.StatusPublished} {
+		if err := c.ChangeStatus(status); err != nil {
+			t.Fatalf("failed to publish course: %v", err)
+		}
+	}
+}
+
+// generateSearchTerm returns a unique single word, which the text search parser keeps whole
+func generateSearchTerm(prefix string) string {
+	return prefix + strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return 'a' + (r - '0')
+		}
+		return -1
+	}, generateID())
+}
+
 func generateID() string {
 	return fmt.Sprintf("test-%d-%d", time.Now().UnixNano(), rand.Intn(10000))
 }
@@ -24,7 +24,7 @@ func (q *Queries) CourseExists(ctx context.Context, id string) (bool, error) {
 
 const createCourse = `-- name: CreateCourse :exec
 
-INSERT INTO courses (id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at)
+INSERT INTO courses (id, teacher_id, title, description, thumbnail, domain, rating, level, status, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 `
 
@@ -34,10 +34,10 @@ type CreateCourseParams struct {
 	Title       string         `json:"title"`
 	Description pgtype.Text    `json:"description"`
 	Thumbnail   pgtype.Text    `json:"thumbnail"`
-	Duration    int32          `json:"duration"`
 	Domain      string         `json:"domain"`
 	Rating      pgtype.Numeric `json:"rating"`
 	Level       string         `json:"level"`
+	Status      string         `json:"status"`
 }
 
 // Course queries
@@ -48,10 +48,10 @@ func (q *Queries) CreateCourse(ctx context.Context, arg CreateCourseParams) erro
 		arg.Title,
 		arg.Description,
 		arg.Thumbnail,
-		arg.Duration,
 		arg.Domain,
 		arg.Rating,
 		arg.Level,
+		arg.Status,
 	)
 	return err
 }
@@ -107,7 +107,7 @@ func (q *Queries) DeleteCourseTag(ctx context.Context, arg DeleteCourseTagParams
 }
 
 const getAllCourses = `-- name: GetAllCourses :many
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 ORDER BY created_at DESC
 `
@@ -130,7 +130,9 @@ func (q *Queries) GetAllCourses(ctx context.Context) ([]Course, error) {
 			&i.Duration,
 			&i.Domain,
 			&i.Rating,
+			&i.ReviewCount,
 			&i.Level,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -145,7 +147,7 @@ func (q *Queries) GetAllCourses(ctx context.Context) ([]Course, error) {
 }
 
 const getCourseByID = `-- name: GetCourseByID :one
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 WHERE id = $1
 `
@@ -162,7 +164,37 @@ func (q *Queries) GetCourseByID(ctx context.Context, id string) (Course, error)
 		&i.Duration,
 		&i.Domain,
 		&i.Rating,
+		&i.ReviewCount,
 		&i.Level,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCourseByIDForUpdate = `-- name: GetCourseByIDForUpdate :one
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
+FROM courses
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCourseByIDForUpdate(ctx context.Context, id string) (Course, error) {
+	row := q.db.QueryRow(ctx, getCourseByIDForUpdate, id)
+	var i Course
+	err := row.Scan(
+		&i.ID,
+		&i.TeacherID,
+		&i.Title,
+		&i.Description,
+		&i.Thumbnail,
+		&i.Duration,
+		&i.Domain,
+		&i.Rating,
+		&i.ReviewCount,
+		&i.Level,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -197,7 +229,7 @@ func (q *Queries) GetCourseTagsByCourseID(ctx context.Context, courseID string)
 }
 
 const getCoursesByTeacherID = `-- name: GetCoursesByTeacherID :many
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 WHERE teacher_id = $1
 ORDER BY created_at DESC
@@ -221,7 +253,9 @@ func (q *Queries) GetCoursesByTeacherID(ctx context.Context, teacherID string) (
 			&i.Duration,
 			&i.Domain,
 			&i.Rating,
+			&i.ReviewCount,
 			&i.Level,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -241,24 +275,20 @@ SET teacher_id = $2,
     title = $3,
     description = $4,
     thumbnail = $5,
-    duration = $6,
-    domain = $7,
-    rating = $8,
-    level = $9,
+    domain = $6,
+    level = $7,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateCourseParams struct {
-	ID          string         `json:"id"`
-	TeacherID   string         `json:"teacher_id"`
-	Title       string         `json:"title"`
-	Description pgtype.Text    `json:"description"`
-	Thumbnail   pgtype.Text    `json:"thumbnail"`
-	Duration    int32          `json:"duration"`
-	Domain      string         `json:"domain"`
-	Rating      pgtype.Numeric `json:"rating"`
-	Level       string         `json:"level"`
+	ID          string      `json:"id"`
+	TeacherID   string      `json:"teacher_id"`
+	Title       string      `json:"title"`
+	Description pgtype.Text `json:"description"`
+	Thumbnail   pgtype.Text `json:"thumbnail"`
+	Domain      string      `json:"domain"`
+	Level       string      `json:"level"`
 }
 
 func (q *Queries) UpdateCourse(ctx context.Context, arg UpdateCourseParams) error {
@@ -268,10 +298,25 @@ func (q *Queries) UpdateCourse(ctx context.Context, arg UpdateCourseParams) erro
 		arg.Title,
 		arg.Description,
 		arg.Thumbnail,
-		arg.Duration,
 		arg.Domain,
-		arg.Rating,
 		arg.Level,
 	)
 	return err
 }
+
+const updateCourseStatus = `-- name: UpdateCourseStatus :exec
+UPDATE courses
+SET status = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCourseStatusParams struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) UpdateCourseStatus(ctx context.Context, arg UpdateCourseStatusParams) error {
+	_, err := q.db.Exec(ctx, updateCourseStatus, arg.ID, arg.Status)
+	return err
+}
@@ -12,14 +12,15 @@ import (
 )
 
 const createEnrollment = `-- name: CreateEnrollment :exec
-INSERT INTO enrollments (id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+INSERT INTO enrollments (id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 `
 
 type CreateEnrollmentParams struct {
 	ID                       string           `json:"id"`
 	UserID                   string           `json:"user_id"`
 	CourseID                 string           `json:"course_id"`
+	ContentVersion           int32            `json:"content_version"`
 	EnrolledAt               pgtype.Timestamp `json:"enrolled_at"`
 	StartedAt                pgtype.Timestamp `json:"started_at"`
 	CompletedAt              pgtype.Timestamp `json:"completed_at"`
@@ -32,6 +33,7 @@ func (q *Queries) CreateEnrollment(ctx context.Context, arg CreateEnrollmentPara
 		arg.ID,
 		arg.UserID,
 		arg.CourseID,
+		arg.ContentVersion,
 		arg.EnrolledAt,
 		arg.StartedAt,
 		arg.CompletedAt,
@@ -42,8 +44,8 @@ func (q *Queries) CreateEnrollment(ctx context.Context, arg CreateEnrollmentPara
 }
 
 const createLessonProgress = `-- name: CreateLessonProgress :exec
-INSERT INTO lesson_progress (enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+INSERT INTO lesson_progress (enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, attempts, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
 `
 
 type CreateLessonProgressParams struct {
@@ -52,6 +54,7 @@ type CreateLessonProgressParams struct {
 	ProgressPercentage pgtype.Numeric `json:"progress_percentage"`
 	ProgressStatus     string         `json:"progress_status"`
 	ExerciseScore      pgtype.Numeric `json:"exercise_score"`
+	Attempts           int32          `json:"attempts"`
 }
 
 func (q *Queries) CreateLessonProgress(ctx context.Context, arg CreateLessonProgressParams) error {
@@ -61,6 +64,7 @@ func (q *Queries) CreateLessonProgress(ctx context.Context, arg CreateLessonProg
 		arg.ProgressPercentage,
 		arg.ProgressStatus,
 		arg.ExerciseScore,
+		arg.Attempts,
 	)
 	return err
 }
@@ -115,7 +119,7 @@ func (q *Queries) DeleteModuleProgressByEnrollmentID(ctx context.Context, enroll
 }
 
 const getAllEnrollments = `-- name: GetAllEnrollments :many
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 ORDER BY enrolled_at DESC
 `
@@ -133,6 +137,7 @@ func (q *Queries) GetAllEnrollments(ctx context.Context) ([]Enrollment, error) {
 			&i.ID,
 			&i.UserID,
 			&i.CourseID,
+			&i.ContentVersion,
 			&i.EnrolledAt,
 			&i.StartedAt,
 			&i.CompletedAt,
@@ -152,7 +157,7 @@ func (q *Queries) GetAllEnrollments(ctx context.Context) ([]Enrollment, error) {
 }
 
 const getEnrollmentByID = `-- name: GetEnrollmentByID :one
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE id = $1
 `
@@ -164,6 +169,33 @@ func (q *Queries) GetEnrollmentByID(ctx context.Context, id string) (Enrollment,
 		&i.ID,
 		&i.UserID,
 		&i.CourseID,
+		&i.ContentVersion,
+		&i.EnrolledAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CourseProgressPercentage,
+		&i.CourseProgressStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEnrollmentByIDForUpdate = `-- name: GetEnrollmentByIDForUpdate :one
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+FROM enrollments
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetEnrollmentByIDForUpdate(ctx context.Context, id string) (Enrollment, error) {
+	row := q.db.QueryRow(ctx, getEnrollmentByIDForUpdate, id)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CourseID,
+		&i.ContentVersion,
 		&i.EnrolledAt,
 		&i.StartedAt,
 		&i.CompletedAt,
@@ -176,7 +208,7 @@ func (q *Queries) GetEnrollmentByID(ctx context.Context, id string) (Enrollment,
 }
 
 const getEnrollmentByUserAndCourse = `-- name: GetEnrollmentByUserAndCourse :one
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE user_id = $1 AND course_id = $2
 `
@@ -193,6 +225,7 @@ func (q *Queries) GetEnrollmentByUserAndCourse(ctx context.Context, arg GetEnrol
 		&i.ID,
 		&i.UserID,
 		&i.CourseID,
+		&i.ContentVersion,
 		&i.EnrolledAt,
 		&i.StartedAt,
 		&i.CompletedAt,
@@ -205,7 +238,7 @@ func (q *Queries) GetEnrollmentByUserAndCourse(ctx context.Context, arg GetEnrol
 }
 
 const getEnrollmentsByUserID = `-- name: GetEnrollmentsByUserID :many
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE user_id = $1
 ORDER BY enrolled_at DESC
@@ -224,6 +257,7 @@ func (q *Queries) GetEnrollmentsByUserID(ctx context.Context, userID string) ([]
 			&i.ID,
 			&i.UserID,
 			&i.CourseID,
+			&i.ContentVersion,
 			&i.EnrolledAt,
 			&i.StartedAt,
 			&i.CompletedAt,
@@ -243,7 +277,7 @@ func (q *Queries) GetEnrollmentsByUserID(ctx context.Context, userID string) ([]
 }
 
 const getLessonProgressByEnrollmentID = `-- name: GetLessonProgressByEnrollmentID :many
-SELECT enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at
+SELECT enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at, attempts
 FROM lesson_progress
 WHERE enrollment_id = $1
 ORDER BY created_at ASC
@@ -266,6 +300,7 @@ func (q *Queries) GetLessonProgressByEnrollmentID(ctx context.Context, enrollmen
 			&i.ExerciseScore,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Attempts,
 		); err != nil {
 			return nil, err
 		}
@@ -315,11 +350,12 @@ const updateEnrollment = `-- name: UpdateEnrollment :exec
 UPDATE enrollments
 SET user_id = $2,
     course_id = $3,
-    enrolled_at = $4,
-    started_at = $5,
-    completed_at = $6,
-    course_progress_percentage = $7,
-    course_progress_status = $8,
+    content_version = $4,
+    enrolled_at = $5,
+    started_at = $6,
+    completed_at = $7,
+    course_progress_percentage = $8,
+    course_progress_status = $9,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -328,6 +364,7 @@ type UpdateEnrollmentParams struct {
 	ID                       string           `json:"id"`
 	UserID                   string           `json:"user_id"`
 	CourseID                 string           `json:"course_id"`
+	ContentVersion           int32            `json:"content_version"`
 	EnrolledAt               pgtype.Timestamp `json:"enrolled_at"`
 	StartedAt                pgtype.Timestamp `json:"started_at"`
 	CompletedAt              pgtype.Timestamp `json:"completed_at"`
@@ -340,6 +377,7 @@ func (q *Queries) UpdateEnrollment(ctx context.Context, arg UpdateEnrollmentPara
 		arg.ID,
 		arg.UserID,
 		arg.CourseID,
+		arg.ContentVersion,
 		arg.EnrolledAt,
 		arg.StartedAt,
 		arg.CompletedAt,
@@ -354,6 +392,7 @@ UPDATE lesson_progress
 SET progress_percentage = $3,
     progress_status = $4,
     exercise_score = $5,
+    attempts = $6,
     updated_at = NOW()
 WHERE enrollment_id = $1 AND lesson_id = $2
 `
@@ -364,6 +403,7 @@ type UpdateLessonProgressParams struct {
 	ProgressPercentage pgtype.Numeric `json:"progress_percentage"`
 	ProgressStatus     string         `json:"progress_status"`
 	ExerciseScore      pgtype.Numeric `json:"exercise_score"`
+	Attempts           int32          `json:"attempts"`
 }
 
 func (q *Queries) UpdateLessonProgress(ctx context.Context, arg UpdateLessonProgressParams) error {
@@ -373,6 +413,7 @@ func (q *Queries) UpdateLessonProgress(ctx context.Context, arg UpdateLessonProg
 		arg.ProgressPercentage,
 		arg.ProgressStatus,
 		arg.ExerciseScore,
+		arg.Attempts,
 	)
 	return err
 }
@@ -7,21 +7,27 @@ package database
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createExercise = `-- name: CreateExercise :exec
 
-INSERT INTO exercises (id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+INSERT INTO exercises (id, lesson_id, question, kind, answers, correct_answers, tolerance, text_match, order_index, points, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
 `
 
 type CreateExerciseParams struct {
-	ID            string   `json:"id"`
-	LessonID      string   `json:"lesson_id"`
-	Question      string   `json:"question"`
-	Answers       []string `json:"answers"`
-	CorrectAnswer string   `json:"correct_answer"`
-	OrderIndex    int32    `json:"order_index"`
+	ID             string      `json:"id"`
+	LessonID       string      `json:"lesson_id"`
+	Question       string      `json:"question"`
+	Kind           string      `json:"kind"`
+	Answers        []string    `json:"answers"`
+	CorrectAnswers []string    `json:"correct_answers"`
+	Tolerance      float64     `json:"tolerance"`
+	TextMatch      pgtype.Text `json:"text_match"`
+	OrderIndex     int32       `json:"order_index"`
+	Points         int32       `json:"points"`
 }
 
 // Exercise queries
@@ -30,9 +36,13 @@ func (q *Queries) CreateExercise(ctx context.Context, arg CreateExerciseParams)
 		arg.ID,
 		arg.LessonID,
 		arg.Question,
+		arg.Kind,
 		arg.Answers,
-		arg.CorrectAnswer,
+		arg.CorrectAnswers,
+		arg.Tolerance,
+		arg.TextMatch,
 		arg.OrderIndex,
+		arg.Points,
 	)
 	return err
 }
@@ -58,7 +68,7 @@ func (q *Queries) ExerciseExists(ctx context.Context, id string) (bool, error) {
 }
 
 const getExerciseByID = `-- name: GetExerciseByID :one
-SELECT id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at
+SELECT id, lesson_id, question, answers, order_index, created_at, updated_at, kind, correct_answers, tolerance, text_match, points
 FROM exercises
 WHERE id = $1
 `
@@ -71,16 +81,20 @@ func (q *Queries) GetExerciseByID(ctx context.Context, id string) (Exercise, err
 		&i.LessonID,
 		&i.Question,
 		&i.Answers,
-		&i.CorrectAnswer,
 		&i.OrderIndex,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Kind,
+		&i.CorrectAnswers,
+		&i.Tolerance,
+		&i.TextMatch,
+		&i.Points,
 	)
 	return i, err
 }
 
 const getExercisesByLessonID = `-- name: GetExercisesByLessonID :many
-SELECT id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at
+SELECT id, lesson_id, question, answers, order_index, created_at, updated_at, kind, correct_answers, tolerance, text_match, points
 FROM exercises
 WHERE lesson_id = $1
 ORDER BY order_index ASC
@@ -100,10 +114,14 @@ func (q *Queries) GetExercisesByLessonID(ctx context.Context, lessonID string) (
 			&i.LessonID,
 			&i.Question,
 			&i.Answers,
-			&i.CorrectAnswer,
 			&i.OrderIndex,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Kind,
+			&i.CorrectAnswers,
+			&i.Tolerance,
+			&i.TextMatch,
+			&i.Points,
 		); err != nil {
 			return nil, err
 		}
@@ -118,28 +136,40 @@ func (q *Queries) GetExercisesByLessonID(ctx context.Context, lessonID string) (
 const updateExercise = `-- name: UpdateExercise :exec
 UPDATE exercises
 SET question = $2,
-    answers = $3,
-    correct_answer = $4,
-    order_index = $5,
+    kind = $3,
+    answers = $4,
+    correct_answers = $5,
+    tolerance = $6,
+    text_match = $7,
+    order_index = $8,
+    points = $9,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateExerciseParams struct {
-	ID            string   `json:"id"`
-	Question      string   `json:"question"`
-	Answers       []string `json:"answers"`
-	CorrectAnswer string   `json:"correct_answer"`
-	OrderIndex    int32    `json:"order_index"`
+	ID             string      `json:"id"`
+	Question       string      `json:"question"`
+	Kind           string      `json:"kind"`
+	Answers        []string    `json:"answers"`
+	CorrectAnswers []string    `json:"correct_answers"`
+	Tolerance      float64     `json:"tolerance"`
+	TextMatch      pgtype.Text `json:"text_match"`
+	OrderIndex     int32       `json:"order_index"`
+	Points         int32       `json:"points"`
 }
 
 func (q *Queries) UpdateExercise(ctx context.Context, arg UpdateExerciseParams) error {
 	_, err := q.db.Exec(ctx, updateExercise,
 		arg.ID,
 		arg.Question,
+		arg.Kind,
 		arg.Answers,
-		arg.CorrectAnswer,
+		arg.CorrectAnswers,
+		arg.Tolerance,
+		arg.TextMatch,
 		arg.OrderIndex,
+		arg.Points,
 	)
 	return err
 }
@@ -13,19 +13,28 @@ import (
 
 const createLesson = `-- name: CreateLesson :exec
 
-INSERT INTO lessons (id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+INSERT INTO lessons (id, module_id, title, overview, content, video_id, duration, order_index, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, NOW(), NOW())
 `
 
 type CreateLessonParams struct {
-	ID         string      `json:"id"`
-	ModuleID   string      `json:"module_id"`
-	Title      string      `json:"title"`
-	Overview   pgtype.Text `json:"overview"`
-	Content    pgtype.Text `json:"content"`
-	VideoID    pgtype.Text `json:"video_id"`
-	Duration   int32       `json:"duration"`
-	OrderIndex int32       `json:"order_index"`
+	ID               string         `json:"id"`
+	ModuleID         string         `json:"module_id"`
+	Title            string         `json:"title"`
+	Overview         pgtype.Text    `json:"overview"`
+	Content          pgtype.Text    `json:"content"`
+	VideoID          pgtype.Text    `json:"video_id"`
+	Duration         int32          `json:"duration"`
+	OrderIndex       int32          `json:"order_index"`
+	RequiresPrevious bool           `json:"requires_previous"`
+	MinScoreLessonID pgtype.Text    `json:"min_score_lesson_id"`
+	MinScore         pgtype.Numeric `json:"min_score"`
+	PassScore        pgtype.Numeric `json:"pass_score"`
+	DrawCount        pgtype.Int4    `json:"draw_count"`
+	TimeLimit        pgtype.Int4    `json:"time_limit"`
+	MaxAttempts      pgtype.Int4    `json:"max_attempts"`
+	BankQuestionIds  []string       `json:"bank_question_ids"`
+	BankPools        []byte         `json:"bank_pools"`
 }
 
 // Lesson queries
@@ -39,6 +48,15 @@ func (q *Queries) CreateLesson(ctx context.Context, arg CreateLessonParams) erro
 		arg.VideoID,
 		arg.Duration,
 		arg.OrderIndex,
+		arg.RequiresPrevious,
+		arg.MinScoreLessonID,
+		arg.MinScore,
+		arg.PassScore,
+		arg.DrawCount,
+		arg.TimeLimit,
+		arg.MaxAttempts,
+		arg.BankQuestionIds,
+		arg.BankPools,
 	)
 	return err
 }
@@ -53,7 +71,7 @@ func (q *Queries) DeleteLesson(ctx context.Context, id string) error {
 }
 
 const getLessonByID = `-- name: GetLessonByID :one
-SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at
+SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools
 FROM lessons
 WHERE id = $1
 `
@@ -72,12 +90,21 @@ func (q *Queries) GetLessonByID(ctx context.Context, id string) (Lesson, error)
 		&i.OrderIndex,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresPrevious,
+		&i.MinScoreLessonID,
+		&i.MinScore,
+		&i.PassScore,
+		&i.DrawCount,
+		&i.TimeLimit,
+		&i.MaxAttempts,
+		&i.BankQuestionIds,
+		&i.BankPools,
 	)
 	return i, err
 }
 
 const getLessonsByModuleID = `-- name: GetLessonsByModuleID :many
-SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at
+SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools
 FROM lessons
 WHERE module_id = $1
 ORDER BY order_index ASC
@@ -103,6 +130,15 @@ func (q *Queries) GetLessonsByModuleID(ctx context.Context, moduleID string) ([]
 			&i.OrderIndex,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresPrevious,
+			&i.MinScoreLessonID,
+			&i.MinScore,
+			&i.PassScore,
+			&i.DrawCount,
+			&i.TimeLimit,
+			&i.MaxAttempts,
+			&i.BankQuestionIds,
+			&i.BankPools,
 		); err != nil {
 			return nil, err
 		}
@@ -133,18 +169,36 @@ SET title = $2,
     video_id = $5,
     duration = $6,
     order_index = $7,
+    requires_previous = $8,
+    min_score_lesson_id = $9,
+    min_score = $10,
+    pass_score = $11,
+    draw_count = $12,
+    time_limit = $13,
+    max_attempts = $14,
+    bank_question_ids = $15,
+    bank_pools = $16,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateLessonParams struct {
-	ID         string      `json:"id"`
-	Title      string      `json:"title"`
-	Overview   pgtype.Text `json:"overview"`
-	Content    pgtype.Text `json:"content"`
-	VideoID    pgtype.Text `json:"video_id"`
-	Duration   int32       `json:"duration"`
-	OrderIndex int32       `json:"order_index"`
+	ID               string         `json:"id"`
+	Title            string         `json:"title"`
+	Overview         pgtype.Text    `json:"overview"`
+	Content          pgtype.Text    `json:"content"`
+	VideoID          pgtype.Text    `json:"video_id"`
+	Duration         int32          `json:"duration"`
+	OrderIndex       int32          `json:"order_index"`
+	RequiresPrevious bool           `json:"requires_previous"`
+	MinScoreLessonID pgtype.Text    `json:"min_score_lesson_id"`
+	MinScore         pgtype.Numeric `json:"min_score"`
+	PassScore        pgtype.Numeric `json:"pass_score"`
+	DrawCount        pgtype.Int4    `json:"draw_count"`
+	TimeLimit        pgtype.Int4    `json:"time_limit"`
+	MaxAttempts      pgtype.Int4    `json:"max_attempts"`
+	BankQuestionIds  []string       `json:"bank_question_ids"`
+	BankPools        []byte         `json:"bank_pools"`
 }
 
 func (q *Queries) UpdateLesson(ctx context.Context, arg UpdateLessonParams) error {
@@ -156,6 +210,15 @@ func (q *Queries) UpdateLesson(ctx context.Context, arg UpdateLessonParams) erro
 		arg.VideoID,
 		arg.Duration,
 		arg.OrderIndex,
+		arg.RequiresPrevious,
+		arg.MinScoreLessonID,
+		arg.MinScore,
+		arg.PassScore,
+		arg.DrawCount,
+		arg.TimeLimit,
+		arg.MaxAttempts,
+		arg.BankQuestionIds,
+		arg.BankPools,
 	)
 	return err
 }
@@ -8,6 +8,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BankQuestion struct {
+	ID             string           `json:"id"`
+	BankID         string           `json:"bank_id"`
+	Question       string           `json:"question"`
+	Kind           string           `json:"kind"`
+	Answers        []string         `json:"answers"`
+	CorrectAnswers []string         `json:"correct_answers"`
+	Tolerance      float64          `json:"tolerance"`
+	TextMatch      pgtype.Text      `json:"text_match"`
+	Points         int32            `json:"points"`
+	Topics         []string         `json:"topics"`
+	Difficulty     string           `json:"difficulty"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
 type Course struct {
 	ID          string           `json:"id"`
 	TeacherID   string           `json:"teacher_id"`
@@ -17,20 +33,44 @@ type Course struct {
 	Duration    int32            `json:"duration"`
 	Domain      string           `json:"domain"`
 	Rating      pgtype.Numeric   `json:"rating"`
+	ReviewCount int32            `json:"review_count"`
 	Level       string           `json:"level"`
+	Status      string           `json:"status"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
 }
 
+type CoursePrerequisite struct {
+	CourseID       string `json:"course_id"`
+	PrerequisiteID string `json:"prerequisite_id"`
+	Required       bool   `json:"required"`
+}
+
+type CourseSearch struct {
+	CourseID    string      `json:"course_id"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	LessonText  string      `json:"lesson_text"`
+	Document    interface{} `json:"document"`
+}
+
 type CourseTag struct {
 	CourseID string `json:"course_id"`
 	Tag      string `json:"tag"`
 }
 
+type CourseVersion struct {
+	CourseID    string           `json:"course_id"`
+	Version     int32            `json:"version"`
+	Content     []byte           `json:"content"`
+	PublishedAt pgtype.Timestamp `json:"published_at"`
+}
+
 type Enrollment struct {
 	ID                       string           `json:"id"`
 	UserID                   string           `json:"user_id"`
 	CourseID                 string           `json:"course_id"`
+	ContentVersion           int32            `json:"content_version"`
 	EnrolledAt               pgtype.Timestamp `json:"enrolled_at"`
 	StartedAt                pgtype.Timestamp `json:"started_at"`
 	CompletedAt              pgtype.Timestamp `json:"completed_at"`
@@ -41,27 +81,49 @@ type Enrollment struct {
 }
 
 type Exercise struct {
-	ID            string           `json:"id"`
-	LessonID      string           `json:"lesson_id"`
-	Question      string           `json:"question"`
-	Answers       []string         `json:"answers"`
-	CorrectAnswer string           `json:"correct_answer"`
-	OrderIndex    int32            `json:"order_index"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	ID             string           `json:"id"`
+	LessonID       string           `json:"lesson_id"`
+	Question       string           `json:"question"`
+	Answers        []string         `json:"answers"`
+	OrderIndex     int32            `json:"order_index"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	Kind           string           `json:"kind"`
+	CorrectAnswers []string         `json:"correct_answers"`
+	Tolerance      float64          `json:"tolerance"`
+	TextMatch      pgtype.Text      `json:"text_match"`
+	Points         int32            `json:"points"`
 }
 
 type Lesson struct {
-	ID         string           `json:"id"`
-	ModuleID   string           `json:"module_id"`
-	Title      string           `json:"title"`
-	Overview   pgtype.Text      `json:"overview"`
-	Content    pgtype.Text      `json:"content"`
-	VideoID    pgtype.Text      `json:"video_id"`
-	Duration   int32            `json:"duration"`
-	OrderIndex int32            `json:"order_index"`
-	CreatedAt  pgtype.Timestamp `json:"created_at"`
-	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	ID               string           `json:"id"`
+	ModuleID         string           `json:"module_id"`
+	Title            string           `json:"title"`
+	Overview         pgtype.Text      `json:"overview"`
+	Content          pgtype.Text      `json:"content"`
+	VideoID          pgtype.Text      `json:"video_id"`
+	Duration         int32            `json:"duration"`
+	OrderIndex       int32            `json:"order_index"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+	RequiresPrevious bool             `json:"requires_previous"`
+	MinScoreLessonID pgtype.Text      `json:"min_score_lesson_id"`
+	MinScore         pgtype.Numeric   `json:"min_score"`
+	PassScore        pgtype.Numeric   `json:"pass_score"`
+	DrawCount        pgtype.Int4      `json:"draw_count"`
+	TimeLimit        pgtype.Int4      `json:"time_limit"`
+	MaxAttempts      pgtype.Int4      `json:"max_attempts"`
+	BankQuestionIds  []string         `json:"bank_question_ids"`
+	BankPools        []byte           `json:"bank_pools"`
+}
+
+type LessonAttempt struct {
+	ID           string           `json:"id"`
+	EnrollmentID string           `json:"enrollment_id"`
+	LessonID     string           `json:"lesson_id"`
+	Answers      []byte           `json:"answers"`
+	Score        pgtype.Numeric   `json:"score"`
+	SubmittedAt  pgtype.Timestamp `json:"submitted_at"`
 }
 
 type LessonProgress struct {
@@ -72,15 +134,19 @@ type LessonProgress struct {
 	ExerciseScore      pgtype.Numeric   `json:"exercise_score"`
 	CreatedAt          pgtype.Timestamp `json:"created_at"`
 	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	Attempts           int32            `json:"attempts"`
 }
 
 type Module struct {
-	ID         string           `json:"id"`
-	CourseID   string           `json:"course_id"`
-	Title      string           `json:"title"`
-	OrderIndex int32            `json:"order_index"`
-	CreatedAt  pgtype.Timestamp `json:"created_at"`
-	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	ID               string           `json:"id"`
+	CourseID         string           `json:"course_id"`
+	Title            string           `json:"title"`
+	OrderIndex       int32            `json:"order_index"`
+	Duration         int32            `json:"duration"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+	ReleaseAfterDays pgtype.Int4      `json:"release_after_days"`
+	ReleaseAt        pgtype.Timestamp `json:"release_at"`
 }
 
 type ModuleProgress struct {
@@ -92,6 +158,44 @@ type ModuleProgress struct {
 	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
 }
 
+type PrerequisiteWaiver struct {
+	CourseID  string           `json:"course_id"`
+	UserID    string           `json:"user_id"`
+	GrantedBy string           `json:"granted_by"`
+	GrantedAt pgtype.Timestamp `json:"granted_at"`
+}
+
+type QuestionBank struct {
+	ID        string           `json:"id"`
+	TeacherID string           `json:"teacher_id"`
+	Title     string           `json:"title"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+type QuizSession struct {
+	ID             string           `json:"id"`
+	EnrollmentID   string           `json:"enrollment_id"`
+	LessonID       string           `json:"lesson_id"`
+	ContentVersion int32            `json:"content_version"`
+	Seed           int64            `json:"seed"`
+	ExerciseIds    []string         `json:"exercise_ids"`
+	StartedAt      pgtype.Timestamp `json:"started_at"`
+	AttemptID      pgtype.Text      `json:"attempt_id"`
+	SubmittedAt    pgtype.Timestamp `json:"submitted_at"`
+	Deadline       pgtype.Timestamp `json:"deadline"`
+}
+
+type Review struct {
+	ID        string           `json:"id"`
+	CourseID  string           `json:"course_id"`
+	UserID    string           `json:"user_id"`
+	Rating    int16            `json:"rating"`
+	Text      string           `json:"text"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
 type User struct {
 	ID        string           `json:"id"`
 	Username  string           `json:"username"`
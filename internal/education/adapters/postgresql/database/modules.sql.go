@@ -7,19 +7,23 @@ package database
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createModule = `-- name: CreateModule :exec
 
-INSERT INTO modules (id, course_id, title, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW(), NOW())
+INSERT INTO modules (id, course_id, title, order_index, release_after_days, release_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
 `
 
 type CreateModuleParams struct {
-	ID         string `json:"id"`
-	CourseID   string `json:"course_id"`
-	Title      string `json:"title"`
-	OrderIndex int32  `json:"order_index"`
+	ID               string           `json:"id"`
+	CourseID         string           `json:"course_id"`
+	Title            string           `json:"title"`
+	OrderIndex       int32            `json:"order_index"`
+	ReleaseAfterDays pgtype.Int4      `json:"release_after_days"`
+	ReleaseAt        pgtype.Timestamp `json:"release_at"`
 }
 
 // Module queries
@@ -29,6 +33,8 @@ func (q *Queries) CreateModule(ctx context.Context, arg CreateModuleParams) erro
 		arg.CourseID,
 		arg.Title,
 		arg.OrderIndex,
+		arg.ReleaseAfterDays,
+		arg.ReleaseAt,
 	)
 	return err
 }
@@ -43,7 +49,7 @@ func (q *Queries) DeleteModule(ctx context.Context, id string) error {
 }
 
 const getModuleByID = `-- name: GetModuleByID :one
-SELECT id, course_id, title, order_index, created_at, updated_at
+SELECT id, course_id, title, order_index, duration, created_at, updated_at, release_after_days, release_at
 FROM modules
 WHERE id = $1
 `
@@ -56,14 +62,17 @@ func (q *Queries) GetModuleByID(ctx context.Context, id string) (Module, error)
 		&i.CourseID,
 		&i.Title,
 		&i.OrderIndex,
+		&i.Duration,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReleaseAfterDays,
+		&i.ReleaseAt,
 	)
 	return i, err
 }
 
 const getModulesByCourseID = `-- name: GetModulesByCourseID :many
-SELECT id, course_id, title, order_index, created_at, updated_at
+SELECT id, course_id, title, order_index, duration, created_at, updated_at, release_after_days, release_at
 FROM modules
 WHERE course_id = $1
 ORDER BY order_index ASC
@@ -83,8 +92,11 @@ func (q *Queries) GetModulesByCourseID(ctx context.Context, courseID string) ([]
 			&i.CourseID,
 			&i.Title,
 			&i.OrderIndex,
+			&i.Duration,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReleaseAfterDays,
+			&i.ReleaseAt,
 		); err != nil {
 			return nil, err
 		}
@@ -111,18 +123,28 @@ const updateModule = `-- name: UpdateModule :exec
 UPDATE modules
 SET title = $2,
     order_index = $3,
+    release_after_days = $4,
+    release_at = $5,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateModuleParams struct {
-	ID         string `json:"id"`
-	Title      string `json:"title"`
-	OrderIndex int32  `json:"order_index"`
+	ID               string           `json:"id"`
+	Title            string           `json:"title"`
+	OrderIndex       int32            `json:"order_index"`
+	ReleaseAfterDays pgtype.Int4      `json:"release_after_days"`
+	ReleaseAt        pgtype.Timestamp `json:"release_at"`
 }
 
 func (q *Queries) UpdateModule(ctx context.Context, arg UpdateModuleParams) error {
-	_, err := q.db.Exec(ctx, updateModule, arg.ID, arg.Title, arg.OrderIndex)
+	_, err := q.db.Exec(ctx, updateModule,
+		arg.ID,
+		arg.Title,
+		arg.OrderIndex,
+		arg.ReleaseAfterDays,
+		arg.ReleaseAt,
+	)
 	return err
 }
 
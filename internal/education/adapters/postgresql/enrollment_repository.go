@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -60,7 +61,7 @@ func (r *EnrollmentRepository) Create(ctx context.Context, e *enrollment.Enrollm
 }
 
 // Update implements enrollment.EnrollmentRepository
-func (r *EnrollmentRepository) Update(ctx context.Context, e *enrollment.Enrollment) error {
+func (r *EnrollmentRepository) Update(ctx context.Context, id string, updateFn func(e *enrollment.Enrollment) error) error {
 	// Start a transaction
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
@@ -68,36 +69,10 @@ func (r *EnrollmentRepository) Update(ctx context.Context, e *enrollment.Enrollm
 	}
 	defer tx.Rollback(ctx)
 
-	qtx := r.queries.WithTx(tx)
-
-	// Update enrollment
-	if err := r.updateEnrollment(ctx, qtx, e); err != nil {
+	if err := r.updateLocked(ctx, r.queries.WithTx(tx), id, updateFn); err != nil {
 		return err
 	}
 
-	// Delete existing progress records
-	if err := qtx.DeleteModuleProgressByEnrollmentID(ctx, e.ID()); err != nil {
-		return errors.Wrap(err, "failed to delete module progress")
-	}
-
-	if err := qtx.DeleteLessonProgressByEnrollmentID(ctx, e.ID()); err != nil {
-		return errors.Wrap(err, "failed to delete lesson progress")
-	}
-
-	// Recreate module progress
-	for _, mp := range e.ModuleProgress() {
-		if err := r.createModuleProgress(ctx, qtx, e.ID(), mp); err != nil {
-			return err
-		}
-	}
-
-	// Recreate lesson progress
-	for _, lp := range e.LessonProgress() {
-		if err := r.createLessonProgress(ctx, qtx, e.ID(), lp); err != nil {
-			return err
-		}
-	}
-
 	if err := tx.Commit(ctx); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -118,10 +93,10 @@ func (r *EnrollmentRepository) Delete(ctx context.Context, id string) error {
 func (r *EnrollmentRepository) Get(ctx context.Context, id string) (*enrollment.Enrollment, error) {
 	dbEnrollment, err := r.queries.GetEnrollmentByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get enrollment")
+		return nil, errors.Wrap(translateError(err, "enrollment"), "failed to get enrollment")
 	}
 
-	return r.toDomainEnrollment(ctx, dbEnrollment)
+	return r.toDomainEnrollment(ctx, r.queries, dbEnrollment)
 }
 
 // GetAll implements enrollment.EnrollmentRepository
@@ -133,7 +108,7 @@ func (r *EnrollmentRepository) GetAll(ctx context.Context) ([]*enrollment.Enroll
 
 	enrollments := make([]*enrollment.Enrollment, 0, len(dbEnrollments))
 	for _, dbEnrollment := range dbEnrollments {
-		domainEnrollment, err := r.toDomainEnrollment(ctx, dbEnrollment)
+		domainEnrollment, err := r.toDomainEnrollment(ctx, r.queries, dbEnrollment)
 		if err != nil {
 			return nil, err
 		}
@@ -150,10 +125,10 @@ func (r *EnrollmentRepository) GetByUserAndCourse(ctx context.Context, userID, c
 		CourseID: courseID,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get enrollment by user and course")
+		return nil, errors.Wrap(translateError(err, "enrollment"), "failed to get enrollment by user and course")
 	}
 
-	return r.toDomainEnrollment(ctx, dbEnrollment)
+	return r.toDomainEnrollment(ctx, r.queries, dbEnrollment)
 }
 
 // GetAllByUserID implements enrollment.EnrollmentRepository
@@ -165,7 +140,7 @@ func (r *EnrollmentRepository) GetAllByUserID(ctx context.Context, userID string
 
 	enrollments := make([]*enrollment.Enrollment, 0, len(dbEnrollments))
 	for _, dbEnrollment := range dbEnrollments {
-		domainEnrollment, err := r.toDomainEnrollment(ctx, dbEnrollment)
+		domainEnrollment, err := r.toDomainEnrollment(ctx, r.queries, dbEnrollment)
 		if err != nil {
 			return nil, err
 		}
@@ -191,7 +166,7 @@ func (r *EnrollmentRepository) createEnrollment(ctx context.Context, q *database
 	}
 
 	var courseProgressPercentage pgtype.Numeric
-	if err := courseProgressPercentage.Scan(e.CourseProgress().Progress().ProgressPercentage()); err != nil {
+	if err := courseProgressPercentage.Scan(fmt.Sprintf("%.2f", e.CourseProgress().Progress().ProgressPercentage())); err != nil {
 		return errors.Wrap(err, "failed to convert course progress percentage")
 	}
 
@@ -199,6 +174,7 @@ func (r *EnrollmentRepository) createEnrollment(ctx context.Context, q *database
 		ID:                       e.ID(),
 		UserID:                   e.UserID(),
 		CourseID:                 e.CourseID(),
+		ContentVersion:           int32(e.ContentVersion()),
 		EnrolledAt:               enrolledAt,
 		StartedAt:                startedAt,
 		CompletedAt:              completedAt,
@@ -207,7 +183,60 @@ func (r *EnrollmentRepository) createEnrollment(ctx context.Context, q *database
 	}
 
 	if err := q.CreateEnrollment(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create enrollment")
+		return errors.Wrap(translateError(err, "enrollment"), "failed to create enrollment")
+	}
+
+	return nil
+}
+
+// updateLocked loads the enrollment locked for the rest of the transaction q
+// runs in, applies updateFn to it and saves the result. Concurrent updates of
+// the same enrollment wait for each other, so none of them works on stale progress.
+func (r *EnrollmentRepository) updateLocked(ctx context.Context, q *database.Queries, id string, updateFn func(e *enrollment.Enrollment) error) error {
+	dbEnrollment, err := q.GetEnrollmentByIDForUpdate(ctx, id)
+	if err != nil {
+		return errors.Wrap(translateError(err, "enrollment"), "failed to lock enrollment")
+	}
+
+	e, err := r.toDomainEnrollment(ctx, q, dbEnrollment)
+	if err != nil {
+		return err
+	}
+	if err := updateFn(e); err != nil {
+		return err
+	}
+
+	return r.saveEnrollment(ctx, q, e)
+}
+
+// saveEnrollment updates the enrollment and replaces its progress records
+func (r *EnrollmentRepository) saveEnrollment(ctx context.Context, q *database.Queries, e *enrollment.Enrollment) error {
+	// Update enrollment
+	if err := r.updateEnrollment(ctx, q, e); err != nil {
+		return err
+	}
+
+	// Delete existing progress records
+	if err := q.DeleteModuleProgressByEnrollmentID(ctx, e.ID()); err != nil {
+		return errors.Wrap(err, "failed to delete module progress")
+	}
+
+	if err := q.DeleteLessonProgressByEnrollmentID(ctx, e.ID()); err != nil {
+		return errors.Wrap(err, "failed to delete lesson progress")
+	}
+
+	// Recreate module progress
+	for _, mp := range e.ModuleProgress() {
+		if err := r.createModuleProgress(ctx, q, e.ID(), mp); err != nil {
+			return err
+		}
+	}
+
+	// Recreate lesson progress
+	for _, lp := range e.LessonProgress() {
+		if err := r.createLessonProgress(ctx, q, e.ID(), lp); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -227,7 +256,7 @@ func (r *EnrollmentRepository) updateEnrollment(ctx context.Context, q *database
 	}
 
 	var courseProgressPercentage pgtype.Numeric
-	if err := courseProgressPercentage.Scan(e.CourseProgress().Progress().ProgressPercentage()); err != nil {
+	if err := courseProgressPercentage.Scan(fmt.Sprintf("%.2f", e.CourseProgress().Progress().ProgressPercentage())); err != nil {
 		return errors.Wrap(err, "failed to convert course progress percentage")
 	}
 
@@ -235,6 +264,7 @@ func (r *EnrollmentRepository) updateEnrollment(ctx context.Context, q *database
 		ID:                       e.ID(),
 		UserID:                   e.UserID(),
 		CourseID:                 e.CourseID(),
+		ContentVersion:           int32(e.ContentVersion()),
 		EnrolledAt:               enrolledAt,
 		StartedAt:                startedAt,
 		CompletedAt:              completedAt,
@@ -251,7 +281,7 @@ func (r *EnrollmentRepository) updateEnrollment(ctx context.Context, q *database
 
 func (r *EnrollmentRepository) createModuleProgress(ctx context.Context, q *database.Queries, enrollmentID string, mp enrollment.ModuleProgress) error {
 	var progressPercentage pgtype.Numeric
-	if err := progressPercentage.Scan(mp.Progress().ProgressPercentage()); err != nil {
+	if err := progressPercentage.Scan(fmt.Sprintf("%.2f", mp.Progress().ProgressPercentage())); err != nil {
 		return errors.Wrap(err, "failed to convert module progress percentage")
 	}
 
@@ -271,12 +301,12 @@ func (r *EnrollmentRepository) createModuleProgress(ctx context.Context, q *data
 
 func (r *EnrollmentRepository) createLessonProgress(ctx context.Context, q *database.Queries, enrollmentID string, lp enrollment.LessonProgress) error {
 	var progressPercentage pgtype.Numeric
-	if err := progressPercentage.Scan(lp.Progress().ProgressPercentage()); err != nil {
+	if err := progressPercentage.Scan(fmt.Sprintf("%.2f", lp.Progress().ProgressPercentage())); err != nil {
 		return errors.Wrap(err, "failed to convert lesson progress percentage")
 	}
 
 	var exerciseScore pgtype.Numeric
-	if err := exerciseScore.Scan(lp.ExerciseScore()); err != nil {
+	if err := exerciseScore.Scan(fmt.Sprintf("%.2f", lp.ExerciseScore())); err != nil {
 		return errors.Wrap(err, "failed to convert exercise score")
 	}
 
@@ -286,6 +316,7 @@ func (r *EnrollmentRepository) createLessonProgress(ctx context.Context, q *data
 		ProgressPercentage: progressPercentage,
 		ProgressStatus:     lp.Progress().Status().String(),
 		ExerciseScore:      exerciseScore,
+		Attempts:           int32(lp.Attempts()),
 	}
 
 	if err := q.CreateLessonProgress(ctx, params); err != nil {
@@ -295,42 +326,71 @@ func (r *EnrollmentRepository) createLessonProgress(ctx context.Context, q *data
 	return nil
 }
 
-func (r *EnrollmentRepository) toDomainEnrollment(ctx context.Context, dbEnrollment database.Enrollment) (*enrollment.Enrollment, error) {
-	// Create basic enrollment
-	domainEnrollment, err := enrollment.NewEnrollment(
-		dbEnrollment.ID,
-		dbEnrollment.UserID,
-		dbEnrollment.CourseID,
-	)
+func (r *EnrollmentRepository) toDomainEnrollment(ctx context.Context, q *database.Queries, dbEnrollment database.Enrollment) (*enrollment.Enrollment, error) {
+	courseStatus, err := enrollment.NewStatusFromString(dbEnrollment.CourseProgressStatus)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create domain enrollment")
+		return nil, errors.Wrap(err, "failed to convert course progress status")
 	}
 
-	// Note: We need to reconstruct the enrollment state from database
-	// This is a simplified version - you may need to add setters to your domain model
-	// or create a factory method that accepts all fields
-
-	// Get module progress (for future use if needed)
-	_, err = r.queries.GetModuleProgressByEnrollmentID(ctx, dbEnrollment.ID)
+	dbModuleProgress, err := q.GetModuleProgressByEnrollmentID(ctx, dbEnrollment.ID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get module progress")
 	}
 
-	// Get lesson progress
-	dbLessonProgress, err := r.queries.GetLessonProgressByEnrollmentID(ctx, dbEnrollment.ID)
+	moduleProgress := make([]enrollment.ModuleProgress, 0, len(dbModuleProgress))
+	for _, dbMP := range dbModuleProgress {
+		status, err := enrollment.NewStatusFromString(dbMP.ProgressStatus)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert module progress status")
+		}
+		moduleProgress = append(moduleProgress, enrollment.UnmarshalModuleProgressFromDatabase(
+			dbMP.ModuleID,
+			numericToFloat64(dbMP.ProgressPercentage),
+			status,
+		))
+	}
+
+	dbLessonProgress, err := q.GetLessonProgressByEnrollmentID(ctx, dbEnrollment.ID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get lesson progress")
 	}
 
-	// Convert lesson progress and complete lessons
+	lessonProgress := make([]enrollment.LessonProgress, 0, len(dbLessonProgress))
 	for _, dbLP := range dbLessonProgress {
-		// If lesson is completed, mark it in the enrollment
-		if dbLP.ProgressStatus == "completed" {
-			if err := domainEnrollment.CompleteLesson(dbLP.LessonID); err != nil {
-				return nil, errors.Wrap(err, "failed to complete lesson in domain")
-			}
+		status, err := enrollment.NewStatusFromString(dbLP.ProgressStatus)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert lesson progress status")
 		}
+		lessonProgress = append(lessonProgress, enrollment.UnmarshalLessonProgressFromDatabase(
+			dbLP.LessonID,
+			numericToFloat64(dbLP.ProgressPercentage),
+			status,
+			numericToFloat64(dbLP.ExerciseScore),
+			int(dbLP.Attempts),
+		))
 	}
 
-	return domainEnrollment, nil
+	return enrollment.UnmarshalEnrollmentFromDatabase(
+		dbEnrollment.ID,
+		dbEnrollment.UserID,
+		dbEnrollment.CourseID,
+		int(dbEnrollment.ContentVersion),
+		dbEnrollment.EnrolledAt.Time,
+		dbEnrollment.StartedAt.Time,
+		dbEnrollment.CompletedAt.Time,
+		enrollment.UnmarshalCourseProgressFromDatabase(numericToFloat64(dbEnrollment.CourseProgressPercentage), courseStatus),
+		moduleProgress,
+		lessonProgress,
+	)
+}
+
+func numericToFloat64(n pgtype.Numeric) float64 {
+	if !n.Valid {
+		return 0
+	}
+	f, err := n.Float64Value()
+	if err != nil {
+		return 0
+	}
+	return f.Float64
 }
@@ -0,0 +1,154 @@
+package postgresql
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+type EnrollmentRepositoryTest struct {
+	Name       string
+	Repository *EnrollmentRepository
+}
+
+func TestEnrollmentRepository(t *testing.T) {
+	t.Parallel()
+	rand.Seed(time.Now().UTC().UnixNano())
+
+	repositories := createEnrollmentRepositories(t)
+
+	for i := range repositories {
+		r := repositories[i]
+
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("ConcurrentAttemptsAndCompletion", func(t *testing.T) {
+				t.Parallel()
+				testEnrollmentConcurrentAttemptsAndCompletion(t, r.Repository)
+			})
+		})
+	}
+}
+
+func createEnrollmentRepositories(t *testing.T) []EnrollmentRepositoryTest {
+	return []EnrollmentRepositoryTest{
+		{
+			Name:       "PostgreSQL",
+			Repository: newPostgreSQLEnrollmentRepository(t),
+		},
+	}
+}
+
+func testEnrollmentConcurrentAttemptsAndCompletion(t *testing.T, repository *EnrollmentRepository) {
+	ctx := context.Background()
+	attempts := NewAttemptRepository(repository.db)
+
+	courseID := createTestCourse(t, ctx, repository.db)
+	m, _ := module.NewModule(generateModuleID(), courseID, "Basics", 0)
+	l, _ := lesson.NewLesson(generateLessonID(), m.ID(), "Intro", "", "", "", 15, 0)
+	ex, _ := exercise.NewExercise(generateID(), l.ID(), "2 + 2?", []string{"3", "4"}, "4", 0)
+	v, err := version.NewVersion(courseID, 1, []version.ModuleContent{
+		version.NewModuleContent(m, []version.LessonContent{
+			version.NewLessonContent(l, []*exercise.Exercise{ex}),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create version domain model: %v", err)
+	}
+	if err := NewVersionRepository(repository.db).Create(ctx, v); err != nil {
+		t.Fatalf("failed to create version: %v", err)
+	}
+
+	enroll, _ := enrollment.NewEnrollment(generateID(), createTestStudent(t, ctx, repository.db), courseID, 1)
+	if err := repository.Create(ctx, enroll); err != nil {
+		t.Fatalf("failed to create enrollment: %v", err)
+	}
+
+	outline := enrollment.NewOutline([]enrollment.OutlineModule{
+		enrollment.NewOutlineModule(m.ID(), []enrollment.OutlineLesson{enrollment.NewOutlineLesson(l.ID(), 15)}),
+	}, false)
+
+	// Attempts and a completion of the same lesson race each other; each
+	// writer has to see what the others saved before it
+	const attemptCount = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, attemptCount+1)
+	for i := 0; i < attemptCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a, err := attempt.NewAttempt(generateID(), enroll.ID(), l.ID(), []*exercise.Exercise{ex}, map[string][]string{ex.ID(): {"4"}})
+			if err != nil {
+				errs <- err
+				return
+			}
+			errs <- attempts.Create(ctx, a, func(e *enrollment.Enrollment) error {
+				if err := e.StartAttempt(l.ID(), outline); err != nil {
+					return err
+				}
+				return e.RecordExerciseScore(l.ID(), a.Score())
+			})
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		errs <- repository.Update(ctx, enroll.ID(), func(e *enrollment.Enrollment) error {
+			return e.CompleteLesson(l.ID(), outline)
+		})
+	}()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent update failed: %v", err)
+		}
+	}
+
+	saved, err := repository.Get(ctx, enroll.ID())
+	if err != nil {
+		t.Fatalf("failed to get enrollment: %v", err)
+	}
+	if len(saved.LessonProgress()) != 1 {
+		t.Fatalf("expected 1 lesson progress, got %d", len(saved.LessonProgress()))
+	}
+	lp := saved.LessonProgress()[0]
+	if lp.Attempts() != attemptCount {
+		t.Errorf("expected %d attempts, got %d", attemptCount, lp.Attempts())
+	}
+	if lp.ExerciseScore() != 100 {
+		t.Errorf("expected best score 100, got %f", lp.ExerciseScore())
+	}
+	if lp.Progress().Status() != enrollment.Completed {
+		t.Errorf("expected lesson to be completed, got %s", lp.Progress().Status())
+	}
+}
+
+func newPostgreSQLEnrollmentRepository(t *testing.T) *EnrollmentRepository {
+	// Setup testcontainer for PostgreSQL
+	container, cleanup := SetupTestDatabase(t)
+	t.Cleanup(cleanup)
+
+	pool, err := pgxpool.New(context.Background(), container.ConnectionString)
+	if err != nil {
+		t.Fatalf("unable to create connection pool: %v", err)
+	}
+
+	if err := pool.Ping(context.Background()); err != nil {
+		t.Fatalf("unable to ping database: %v", err)
+	}
+
+	return NewEnrollmentRepository(pool)
+}
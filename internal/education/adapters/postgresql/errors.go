@@ -0,0 +1,40 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/pkg/errors"
+)
+
+// uniqueViolationCode is the PostgreSQL SQLSTATE raised when a unique constraint is violated
+const uniqueViolationCode = "23505"
+
+// constraintConflicts gives human messages to unique constraints a client can trip over
+var constraintConflicts = map[string]commonerrors.SlugError{
+	"users_email_key":                   commonerrors.NewConflictError("email is already registered", "email-already-registered"),
+	"users_username_key":                commonerrors.NewConflictError("username is already taken", "username-already-taken"),
+	"enrollments_user_id_course_id_key": commonerrors.NewConflictError("user is already enrolled in the course", "already-enrolled"),
+	"reviews_course_id_user_id_key":     commonerrors.NewConflictError("user has already reviewed the course", "already-reviewed"),
+	"course_versions_pkey":              commonerrors.NewConflictError("the course version was already published", "version-already-published"),
+}
+
+// translateError maps driver errors onto slug errors, so the ports can answer
+// with 404 and 409 instead of 500. Other errors are returned unchanged.
+func translateError(err error, entity string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return commonerrors.NewNotFoundError(fmt.Sprintf("%s not found", entity), fmt.Sprintf("%s-not-found", entity))
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		if conflict, ok := constraintConflicts[pgErr.ConstraintName]; ok {
+			return conflict
+		}
+		return commonerrors.NewConflictError(fmt.Sprintf("%s already exists", entity), fmt.Sprintf("%s-already-exists", entity))
+	}
+
+	return err
+}
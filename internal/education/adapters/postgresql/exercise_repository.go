@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
@@ -23,30 +24,22 @@ func NewExerciseRepository(db *pgxpool.Pool) *ExerciseRepository {
 
 // Create implements exercise.ExerciseRepository
 func (r *ExerciseRepository) Create(ctx context.Context, e *exercise.Exercise) error {
-	params := database.CreateExerciseParams{
-		ID:            e.ID(),
-		LessonID:      e.LessonID(),
-		Question:      e.Question(),
-		Answers:       e.Answers(),
-		CorrectAnswer: e.CorrectAnswerForStorage(), // Special method to get correct answer (only for storage)
-		OrderIndex:    int32(e.Order()),
-	}
-
-	if err := r.queries.CreateExercise(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create exercise")
-	}
-
-	return nil
+	return r.createExercise(ctx, r.queries, e)
 }
 
 // Update implements exercise.ExerciseRepository
 func (r *ExerciseRepository) Update(ctx context.Context, e *exercise.Exercise) error {
+	key := e.AnswerKeyForStorage() // Special method to get correct answers (only for storage)
 	params := database.UpdateExerciseParams{
-		ID:            e.ID(),
-		Question:      e.Question(),
-		Answers:       e.Answers(),
-		CorrectAnswer: e.CorrectAnswerForStorage(), // Special method to get correct answer (only for storage)
-		OrderIndex:    int32(e.Order()),
+		ID:             e.ID(),
+		Question:       e.Question(),
+		Kind:           key.Kind().String(),
+		Answers:        key.Options(),
+		CorrectAnswers: key.Correct(),
+		Tolerance:      key.Tolerance(),
+		TextMatch:      textMatchColumn(key),
+		OrderIndex:     int32(e.Order()),
+		Points:         int32(e.Points()),
 	}
 
 	if err := r.queries.UpdateExercise(ctx, params); err != nil {
@@ -69,7 +62,7 @@ func (r *ExerciseRepository) Delete(ctx context.Context, id string) error {
 func (r *ExerciseRepository) Get(ctx context.Context, id string) (*exercise.Exercise, error) {
 	dbExercise, err := r.queries.GetExerciseByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get exercise")
+		return nil, errors.Wrap(translateError(err, "exercise"), "failed to get exercise")
 	}
 
 	return r.toDomainExercise(dbExercise)
@@ -148,13 +141,59 @@ func (r *ExerciseRepository) ReorderExercises(ctx context.Context, exerciseOrder
 
 // Helper methods
 
+func (r *ExerciseRepository) createExercise(ctx context.Context, q *database.Queries, e *exercise.Exercise) error {
+	key := e.AnswerKeyForStorage() // Special method to get correct answers (only for storage)
+	params := database.CreateExerciseParams{
+		ID:             e.ID(),
+		LessonID:       e.LessonID(),
+		Question:       e.Question(),
+		Kind:           key.Kind().String(),
+		Answers:        key.Options(),
+		CorrectAnswers: key.Correct(),
+		Tolerance:      key.Tolerance(),
+		TextMatch:      textMatchColumn(key),
+		OrderIndex:     int32(e.Order()),
+		Points:         int32(e.Points()),
+	}
+
+	if err := q.CreateExercise(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "exercise"), "failed to create exercise")
+	}
+
+	return nil
+}
+
 func (r *ExerciseRepository) toDomainExercise(dbExercise database.Exercise) (*exercise.Exercise, error) {
-	return exercise.NewExercise(
+	key, err := exercise.ParseAnswerKey(
+		dbExercise.Kind,
+		dbExercise.Answers,
+		dbExercise.CorrectAnswers,
+		dbExercise.Tolerance,
+		dbExercise.TextMatch.String,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := exercise.NewExerciseWithKey(
 		dbExercise.ID,
 		dbExercise.LessonID,
 		dbExercise.Question,
-		dbExercise.Answers,
-		dbExercise.CorrectAnswer,
+		key,
 		int(dbExercise.OrderIndex),
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.SetPoints(int(dbExercise.Points)); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// textMatchColumn is NULL for every kind but short text
+func textMatchColumn(key exercise.AnswerKey) pgtype.Text {
+	match := key.TextMatch().String()
+	return pgtype.Text{String: match, Valid: match != ""}
 }
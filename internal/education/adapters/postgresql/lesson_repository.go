@@ -2,10 +2,13 @@ package postgresql
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
 	"github.com/pkg/errors"
 )
@@ -24,26 +27,7 @@ func NewLessonRepository(db *pgxpool.Pool) *LessonRepository {
 
 // Create implements lesson.LessonRepository
 func (r *LessonRepository) Create(ctx context.Context, l *lesson.Lesson) error {
-	overview := pgtype.Text{String: l.Overview(), Valid: l.Overview() != ""}
-	content := pgtype.Text{String: l.Content(), Valid: l.Content() != ""}
-	videoID := pgtype.Text{String: l.VideoID(), Valid: l.VideoID() != ""}
-
-	params := database.CreateLessonParams{
-		ID:         l.ID(),
-		ModuleID:   l.ModuleID(),
-		Title:      l.Title(),
-		Overview:   overview,
-		Content:    content,
-		VideoID:    videoID,
-		Duration:   int32(l.Duration()),
-		OrderIndex: int32(l.Order()),
-	}
-
-	if err := r.queries.CreateLesson(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create lesson")
-	}
-
-	return nil
+	return r.createLesson(ctx, r.queries, l)
 }
 
 // Update implements lesson.LessonRepository
@@ -52,14 +36,36 @@ func (r *LessonRepository) Update(ctx context.Context, l *lesson.Lesson) error {
 	content := pgtype.Text{String: l.Content(), Valid: l.Content() != ""}
 	videoID := pgtype.Text{String: l.VideoID(), Valid: l.VideoID() != ""}
 
+	scoreLessonID, minScore, err := gateColumns(l.Gate())
+	if err != nil {
+		return err
+	}
+	passScore, err := passScoreColumn(l.PassScore())
+	if err != nil {
+		return err
+	}
+	bankQuestionIDs, bankPools, err := bankColumns(l)
+	if err != nil {
+		return err
+	}
+
 	params := database.UpdateLessonParams{
-		ID:         l.ID(),
-		Title:      l.Title(),
-		Overview:   overview,
-		Content:    content,
-		VideoID:    videoID,
-		Duration:   int32(l.Duration()),
-		OrderIndex: int32(l.Order()),
+		ID:               l.ID(),
+		Title:            l.Title(),
+		Overview:         overview,
+		Content:          content,
+		VideoID:          videoID,
+		Duration:         int32(l.Duration()),
+		OrderIndex:       int32(l.Order()),
+		RequiresPrevious: l.Gate().RequiresPrevious(),
+		MinScoreLessonID: scoreLessonID,
+		MinScore:         minScore,
+		PassScore:        passScore,
+		DrawCount:        pgtype.Int4{Int32: int32(l.DrawCount()), Valid: l.DrawCount() != 0},
+		TimeLimit:        pgtype.Int4{Int32: int32(l.TimeLimit()), Valid: l.TimeLimit() != 0},
+		MaxAttempts:      pgtype.Int4{Int32: int32(l.MaxAttempts()), Valid: l.MaxAttempts() != 0},
+		BankQuestionIds:  bankQuestionIDs,
+		BankPools:        bankPools,
 	}
 
 	if err := r.queries.UpdateLesson(ctx, params); err != nil {
@@ -82,7 +88,7 @@ func (r *LessonRepository) Delete(ctx context.Context, id string) error {
 func (r *LessonRepository) Get(ctx context.Context, id string) (*lesson.Lesson, error) {
 	dbLesson, err := r.queries.GetLessonByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get lesson")
+		return nil, errors.Wrap(translateError(err, "lesson"), "failed to get lesson")
 	}
 
 	return r.toDomainLesson(dbLesson)
@@ -161,6 +167,51 @@ func (r *LessonRepository) ReorderLessons(ctx context.Context, lessonOrders map[
 
 // Helper methods
 
+func (r *LessonRepository) createLesson(ctx context.Context, q *database.Queries, l *lesson.Lesson) error {
+	overview := pgtype.Text{String: l.Overview(), Valid: l.Overview() != ""}
+	content := pgtype.Text{String: l.Content(), Valid: l.Content() != ""}
+	videoID := pgtype.Text{String: l.VideoID(), Valid: l.VideoID() != ""}
+
+	scoreLessonID, minScore, err := gateColumns(l.Gate())
+	if err != nil {
+		return err
+	}
+	passScore, err := passScoreColumn(l.PassScore())
+	if err != nil {
+		return err
+	}
+	bankQuestionIDs, bankPools, err := bankColumns(l)
+	if err != nil {
+		return err
+	}
+
+	params := database.CreateLessonParams{
+		ID:               l.ID(),
+		ModuleID:         l.ModuleID(),
+		Title:            l.Title(),
+		Overview:         overview,
+		Content:          content,
+		VideoID:          videoID,
+		Duration:         int32(l.Duration()),
+		OrderIndex:       int32(l.Order()),
+		RequiresPrevious: l.Gate().RequiresPrevious(),
+		MinScoreLessonID: scoreLessonID,
+		MinScore:         minScore,
+		PassScore:        passScore,
+		DrawCount:        pgtype.Int4{Int32: int32(l.DrawCount()), Valid: l.DrawCount() != 0},
+		TimeLimit:        pgtype.Int4{Int32: int32(l.TimeLimit()), Valid: l.TimeLimit() != 0},
+		MaxAttempts:      pgtype.Int4{Int32: int32(l.MaxAttempts()), Valid: l.MaxAttempts() != 0},
+		BankQuestionIds:  bankQuestionIDs,
+		BankPools:        bankPools,
+	}
+
+	if err := q.CreateLesson(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "lesson"), "failed to create lesson")
+	}
+
+	return nil
+}
+
 func (r *LessonRepository) toDomainLesson(dbLesson database.Lesson) (*lesson.Lesson, error) {
 	overview := ""
 	if dbLesson.Overview.Valid {
@@ -177,7 +228,7 @@ func (r *LessonRepository) toDomainLesson(dbLesson database.Lesson) (*lesson.Les
 		videoID = dbLesson.VideoID.String
 	}
 
-	return lesson.NewLesson(
+	l, err := lesson.NewLesson(
 		dbLesson.ID,
 		dbLesson.ModuleID,
 		dbLesson.Title,
@@ -187,4 +238,126 @@ func (r *LessonRepository) toDomainLesson(dbLesson database.Lesson) (*lesson.Les
 		int(dbLesson.Duration),
 		int(dbLesson.OrderIndex),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	gate, err := lesson.NewGate(dbLesson.RequiresPrevious, dbLesson.MinScoreLessonID.String, numericToFloat64(dbLesson.MinScore))
+	if err != nil {
+		return nil, err
+	}
+	if err := l.SetGate(gate); err != nil {
+		return nil, err
+	}
+	if err := l.SetPassScore(numericToFloat64(dbLesson.PassScore)); err != nil {
+		return nil, err
+	}
+	if err := l.SetDrawCount(int(dbLesson.DrawCount.Int32)); err != nil {
+		return nil, err
+	}
+	if err := l.SetTimeLimit(int(dbLesson.TimeLimit.Int32)); err != nil {
+		return nil, err
+	}
+	if err := l.SetMaxAttempts(int(dbLesson.MaxAttempts.Int32)); err != nil {
+		return nil, err
+	}
+
+	var dbPools []lessonPool
+	if err := json.Unmarshal(dbLesson.BankPools, &dbPools); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal lesson bank pools")
+	}
+	pools, err := toDomainPools(dbPools)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.SetBankQuestions(dbLesson.BankQuestionIds, pools); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// gateColumns maps the score rule of a gate to its nullable columns
+func gateColumns(gate lesson.Gate) (pgtype.Text, pgtype.Numeric, error) {
+	if !gate.RequiresScore() {
+		return pgtype.Text{}, pgtype.Numeric{}, nil
+	}
+
+	var minScore pgtype.Numeric
+	if err := minScore.Scan(fmt.Sprintf("%.2f", gate.MinScore())); err != nil {
+		return pgtype.Text{}, pgtype.Numeric{}, errors.Wrap(err, "failed to convert minimum score")
+	}
+
+	return pgtype.Text{String: gate.ScoreLessonID(), Valid: true}, minScore, nil
+}
+
+// lessonPool is the JSON representation of a bank pool in lessons.bank_pools
+// and in the lessons of course_versions.content
+type lessonPool struct {
+	BankID     string `json:"bank_id"`
+	Topic      string `json:"topic,omitempty"`
+	Difficulty string `json:"difficulty,omitempty"`
+	Count      int    `json:"count"`
+}
+
+func toLessonPools(pools []bank.Pool) []lessonPool {
+	dbPools := make([]lessonPool, 0, len(pools))
+	for _, p := range pools {
+		dbPools = append(dbPools, lessonPool{
+			BankID:     p.BankID(),
+			Topic:      p.Filter().Topic(),
+			Difficulty: p.Filter().Difficulty().String(),
+			Count:      p.Count(),
+		})
+	}
+	return dbPools
+}
+
+func toDomainPools(dbPools []lessonPool) ([]bank.Pool, error) {
+	pools := make([]bank.Pool, 0, len(dbPools))
+	for _, dbPool := range dbPools {
+		var difficulty bank.Difficulty
+		if dbPool.Difficulty != "" {
+			var err error
+			if difficulty, err = bank.NewDifficultyFromString(dbPool.Difficulty); err != nil {
+				return nil, err
+			}
+		}
+		p, err := bank.NewPool(dbPool.BankID, bank.NewFilter(dbPool.Topic, difficulty), dbPool.Count)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, p)
+	}
+	return pools, nil
+}
+
+// bankColumns maps the bank questions of a lesson to their columns, which
+// hold empty arrays rather than NULL
+func bankColumns(l *lesson.Lesson) ([]string, []byte, error) {
+	questionIDs := l.BankQuestionIDs()
+	if questionIDs == nil {
+		questionIDs = []string{}
+	}
+
+	pools, err := json.Marshal(toLessonPools(l.BankPools()))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal lesson bank pools")
+	}
+
+	return questionIDs, pools, nil
+}
+
+// passScoreColumn maps a pass score to its nullable column, NULL when the
+// lesson has none
+func passScoreColumn(passScore float64) (pgtype.Numeric, error) {
+	if passScore == 0 {
+		return pgtype.Numeric{}, nil
+	}
+
+	var column pgtype.Numeric
+	if err := column.Scan(fmt.Sprintf("%.2f", passScore)); err != nil {
+		return pgtype.Numeric{}, errors.Wrap(err, "failed to convert pass score")
+	}
+	return column, nil
 }
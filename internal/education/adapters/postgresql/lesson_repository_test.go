@@ -64,6 +64,14 @@ func TestLessonRepository(t *testing.T) {
 				t.Parallel()
 				testLessonUpdateContent(t, r.Repository)
 			})
+			t.Run("Gate", func(t *testing.T) {
+				t.Parallel()
+				testLessonGate(t, r.Repository)
+			})
+			t.Run("PassScore", func(t *testing.T) {
+				t.Parallel()
+				testLessonPassScore(t, r.Repository)
+			})
 		})
 	}
 }
@@ -429,6 +437,87 @@ func testLessonUpdateContent(t *testing.T, repository *LessonRepository) {
 	}
 }
 
+func testLessonGate(t *testing.T, repository *LessonRepository) {
+	ctx := context.Background()
+	moduleID := createTestModule(t, ctx, repository.db)
+
+	first, _ := lesson.NewLesson(generateLessonID(), moduleID, "First", "", "", "", 0, 1)
+	second, _ := lesson.NewLesson(generateLessonID(), moduleID, "Second", "", "", "", 0, 2)
+	gate, err := lesson.NewGate(true, first.ID(), 75.5)
+	if err != nil {
+		t.Fatalf("failed to create gate: %v", err)
+	}
+	if err := second.SetGate(gate); err != nil {
+		t.Fatalf("failed to set gate: %v", err)
+	}
+
+	if err := repository.Create(ctx, first); err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+	if err := repository.Create(ctx, second); err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, second.ID())
+	if err != nil {
+		t.Fatalf("failed to get lesson: %v", err)
+	}
+	if retrieved.Gate() != gate {
+		t.Errorf("expected gate %+v, got %+v", gate, retrieved.Gate())
+	}
+
+	if err := retrieved.SetGate(lesson.Gate{}); err != nil {
+		t.Fatalf("failed to clear gate: %v", err)
+	}
+	if err := repository.Update(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update lesson: %v", err)
+	}
+
+	retrieved, err = repository.Get(ctx, second.ID())
+	if err != nil {
+		t.Fatalf("failed to get lesson: %v", err)
+	}
+	if !retrieved.Gate().IsOpen() {
+		t.Errorf("expected gate to be cleared, got %+v", retrieved.Gate())
+	}
+}
+
+func testLessonPassScore(t *testing.T, repository *LessonRepository) {
+	ctx := context.Background()
+	moduleID := createTestModule(t, ctx, repository.db)
+
+	l, _ := lesson.NewLesson(generateLessonID(), moduleID, "Quiz", "", "", "", 0, 0)
+	if err := l.SetPassScore(70); err != nil {
+		t.Fatalf("failed to set pass score: %v", err)
+	}
+	if err := repository.Create(ctx, l); err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, l.ID())
+	if err != nil {
+		t.Fatalf("failed to get lesson: %v", err)
+	}
+	if retrieved.PassScore() != 70 {
+		t.Errorf("expected pass score 70, got %v", retrieved.PassScore())
+	}
+
+	if err := retrieved.SetPassScore(0); err != nil {
+		t.Fatalf("failed to clear pass score: %v", err)
+	}
+	if err := repository.Update(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update lesson: %v", err)
+	}
+
+	retrieved, err = repository.Get(ctx, l.ID())
+	if err != nil {
+		t.Fatalf("failed to get lesson: %v", err)
+	}
+	if retrieved.PassScore() != 0 {
+		t.Errorf("expected pass score to be cleared, got %v", retrieved.PassScore())
+	}
+}
+
 func assertLessonEqual(t *testing.T, expected, actual *lesson.Lesson) {
 	if actual.ID() != expected.ID() {
 		t.Errorf("expected ID '%s', got '%s'", expected.ID(), actual.ID())
@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
@@ -23,26 +24,18 @@ func NewModuleRepository(db *pgxpool.Pool) *ModuleRepository {
 
 // Create implements course.ModuleRepository
 func (r *ModuleRepository) Create(ctx context.Context, m *module.Module) error {
-	params := database.CreateModuleParams{
-		ID:         m.ID(),
-		CourseID:   m.CourseID(),
-		Title:      m.Title(),
-		OrderIndex: int32(m.Order()),
-	}
-
-	if err := r.queries.CreateModule(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create module")
-	}
-
-	return nil
+	return r.createModule(ctx, r.queries, m)
 }
 
 // Update implements course.ModuleRepository
 func (r *ModuleRepository) Update(ctx context.Context, m *module.Module) error {
+	releaseAfterDays, releaseAt := releaseColumns(m.Release())
 	params := database.UpdateModuleParams{
-		ID:         m.ID(),
-		Title:      m.Title(),
-		OrderIndex: int32(m.Order()),
+		ID:               m.ID(),
+		Title:            m.Title(),
+		OrderIndex:       int32(m.Order()),
+		ReleaseAfterDays: releaseAfterDays,
+		ReleaseAt:        releaseAt,
 	}
 
 	if err := r.queries.UpdateModule(ctx, params); err != nil {
@@ -65,7 +58,7 @@ func (r *ModuleRepository) Delete(ctx context.Context, id string) error {
 func (r *ModuleRepository) Get(ctx context.Context, id string) (*module.Module, error) {
 	dbModule, err := r.queries.GetModuleByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get module")
+		return nil, errors.Wrap(translateError(err, "module"), "failed to get module")
 	}
 
 	return r.toDomainModule(dbModule)
@@ -144,11 +137,56 @@ func (r *ModuleRepository) ReorderModules(ctx context.Context, moduleOrders map[
 
 // Helper methods
 
+func (r *ModuleRepository) createModule(ctx context.Context, q *database.Queries, m *module.Module) error {
+	releaseAfterDays, releaseAt := releaseColumns(m.Release())
+	params := database.CreateModuleParams{
+		ID:               m.ID(),
+		CourseID:         m.CourseID(),
+		Title:            m.Title(),
+		OrderIndex:       int32(m.Order()),
+		ReleaseAfterDays: releaseAfterDays,
+		ReleaseAt:        releaseAt,
+	}
+
+	if err := q.CreateModule(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "module"), "failed to create module")
+	}
+
+	return nil
+}
+
 func (r *ModuleRepository) toDomainModule(dbModule database.Module) (*module.Module, error) {
-	return module.NewModule(
+	m, err := module.UnmarshalModuleFromDatabase(
 		dbModule.ID,
 		dbModule.CourseID,
 		dbModule.Title,
 		int(dbModule.OrderIndex),
+		int(dbModule.Duration),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := module.NewRelease(int(dbModule.ReleaseAfterDays.Int32), dbModule.ReleaseAt.Time)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid module release")
+	}
+	m.SetRelease(release)
+
+	return m, nil
+}
+
+// releaseColumns maps the release rule of a module to its nullable columns
+func releaseColumns(release module.Release) (pgtype.Int4, pgtype.Timestamp) {
+	var releaseAfterDays pgtype.Int4
+	if days := release.DaysAfterEnrollment(); days > 0 {
+		releaseAfterDays = pgtype.Int4{Int32: int32(days), Valid: true}
+	}
+
+	var releaseAt pgtype.Timestamp
+	if date := release.Date(); !date.IsZero() {
+		releaseAt = pgtype.Timestamp{Time: date.UTC(), Valid: true}
+	}
+
+	return releaseAfterDays, releaseAt
 }
@@ -56,6 +56,10 @@ func TestModuleRepository(t *testing.T) {
 				t.Parallel()
 				testModuleReorder(t, r.Repository)
 			})
+			t.Run("Release", func(t *testing.T) {
+				t.Parallel()
+				testModuleRelease(t, r.Repository)
+			})
 		})
 	}
 }
@@ -320,6 +324,48 @@ func testModuleReorder(t *testing.T, repository *ModuleRepository) {
 	}
 }
 
+func testModuleRelease(t *testing.T, repository *ModuleRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	m, _ := module.NewModule(generateModuleID(), courseID, "Week 2", 1)
+	release, err := module.NewRelease(7, time.Time{})
+	if err != nil {
+		t.Fatalf("failed to create release: %v", err)
+	}
+	m.SetRelease(release)
+
+	if err := repository.Create(ctx, m); err != nil {
+		t.Fatalf("failed to create module: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, m.ID())
+	if err != nil {
+		t.Fatalf("failed to get module: %v", err)
+	}
+	if retrieved.Release() != release {
+		t.Errorf("expected release %+v, got %+v", release, retrieved.Release())
+	}
+
+	date := time.Date(2030, 1, 6, 9, 0, 0, 0, time.UTC)
+	release, err = module.NewRelease(0, date)
+	if err != nil {
+		t.Fatalf("failed to create release: %v", err)
+	}
+	retrieved.SetRelease(release)
+	if err := repository.Update(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update module: %v", err)
+	}
+
+	retrieved, err = repository.Get(ctx, m.ID())
+	if err != nil {
+		t.Fatalf("failed to get module: %v", err)
+	}
+	if retrieved.Release().DaysAfterEnrollment() != 0 || !retrieved.Release().Date().Equal(date) {
+		t.Errorf("expected release on %v, got %+v", date, retrieved.Release())
+	}
+}
+
 func assertModuleEqual(t *testing.T, expected, actual *module.Module) {
 	if actual.ID() != expected.ID() {
 		t.Errorf("expected ID '%s', got '%s'", expected.ID(), actual.ID())
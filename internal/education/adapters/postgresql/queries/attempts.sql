@@ -0,0 +1,16 @@
+-- Lesson attempt queries
+
+-- name: CreateLessonAttempt :exec
+INSERT INTO lesson_attempts (id, enrollment_id, lesson_id, answers, score, submitted_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetLessonAttemptByID :one
+SELECT id, enrollment_id, lesson_id, answers, score, submitted_at
+FROM lesson_attempts
+WHERE id = $1;
+
+-- name: GetLessonAttemptsByEnrollmentAndLesson :many
+SELECT id, enrollment_id, lesson_id, answers, score, submitted_at
+FROM lesson_attempts
+WHERE enrollment_id = $1 AND lesson_id = $2
+ORDER BY submitted_at DESC;
@@ -0,0 +1,22 @@
+-- Course version queries
+
+-- name: CreateCourseVersion :exec
+INSERT INTO course_versions (course_id, version, content, published_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetCourseVersion :one
+SELECT course_id, version, content, published_at
+FROM course_versions
+WHERE course_id = $1 AND version = $2;
+
+-- name: GetLatestCourseVersionNumber :one
+SELECT COALESCE(MAX(version), 0)::int AS version
+FROM course_versions
+WHERE course_id = $1;
+
+-- name: GetLatestCourseVersion :one
+SELECT course_id, version, content, published_at
+FROM course_versions
+WHERE course_id = $1
+ORDER BY version DESC
+LIMIT 1;
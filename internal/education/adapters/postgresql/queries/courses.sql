@@ -1,7 +1,7 @@
 -- Course queries
 
 -- name: CreateCourse :exec
-INSERT INTO courses (id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at)
+INSERT INTO courses (id, teacher_id, title, description, thumbnail, domain, rating, level, status, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW());
 
 -- name: UpdateCourse :exec
@@ -10,10 +10,14 @@ SET teacher_id = $2,
     title = $3,
     description = $4,
     thumbnail = $5,
-    duration = $6,
-    domain = $7,
-    rating = $8,
-    level = $9,
+    domain = $6,
+    level = $7,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCourseStatus :exec
+UPDATE courses
+SET status = $2,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -21,17 +25,23 @@ WHERE id = $1;
 DELETE FROM courses WHERE id = $1;
 
 -- name: GetCourseByID :one
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 WHERE id = $1;
 
+-- name: GetCourseByIDForUpdate :one
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
+FROM courses
+WHERE id = $1
+FOR UPDATE;
+
 -- name: GetAllCourses :many
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 ORDER BY created_at DESC;
 
 -- name: GetCoursesByTeacherID :many
-SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, level, created_at, updated_at
+SELECT id, teacher_id, title, description, thumbnail, duration, domain, rating, review_count, level, status, created_at, updated_at
 FROM courses
 WHERE teacher_id = $1
 ORDER BY created_at DESC;
@@ -1,24 +1,25 @@
 -- name: CreateEnrollment :exec
-INSERT INTO enrollments (id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW());
+INSERT INTO enrollments (id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW());
 
 -- name: CreateModuleProgress :exec
 INSERT INTO module_progress (enrollment_id, module_id, progress_percentage, progress_status, created_at, updated_at)
 VALUES ($1, $2, $3, $4, NOW(), NOW());
 
 -- name: CreateLessonProgress :exec
-INSERT INTO lesson_progress (enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, NOW(), NOW());
+INSERT INTO lesson_progress (enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, attempts, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW());
 
 -- name: UpdateEnrollment :exec
 UPDATE enrollments
 SET user_id = $2,
     course_id = $3,
-    enrolled_at = $4,
-    started_at = $5,
-    completed_at = $6,
-    course_progress_percentage = $7,
-    course_progress_status = $8,
+    content_version = $4,
+    enrolled_at = $5,
+    started_at = $6,
+    completed_at = $7,
+    course_progress_percentage = $8,
+    course_progress_status = $9,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -34,6 +35,7 @@ UPDATE lesson_progress
 SET progress_percentage = $3,
     progress_status = $4,
     exercise_score = $5,
+    attempts = $6,
     updated_at = NOW()
 WHERE enrollment_id = $1 AND lesson_id = $2;
 
@@ -47,12 +49,18 @@ DELETE FROM module_progress WHERE enrollment_id = $1;
 DELETE FROM lesson_progress WHERE enrollment_id = $1;
 
 -- name: GetEnrollmentByID :one
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE id = $1;
 
+-- name: GetEnrollmentByIDForUpdate :one
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+FROM enrollments
+WHERE id = $1
+FOR UPDATE;
+
 -- name: GetEnrollmentByUserAndCourse :one
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE user_id = $1 AND course_id = $2;
 
@@ -63,18 +71,18 @@ WHERE enrollment_id = $1
 ORDER BY created_at ASC;
 
 -- name: GetLessonProgressByEnrollmentID :many
-SELECT enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at
+SELECT enrollment_id, lesson_id, progress_percentage, progress_status, exercise_score, created_at, updated_at, attempts
 FROM lesson_progress
 WHERE enrollment_id = $1
 ORDER BY created_at ASC;
 
 -- name: GetAllEnrollments :many
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 ORDER BY enrolled_at DESC;
 
 -- name: GetEnrollmentsByUserID :many
-SELECT id, user_id, course_id, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
+SELECT id, user_id, course_id, content_version, enrolled_at, started_at, completed_at, course_progress_percentage, course_progress_status, created_at, updated_at
 FROM enrollments
 WHERE user_id = $1
 ORDER BY enrolled_at DESC;
@@ -1,15 +1,19 @@
 -- Exercise queries
 
 -- name: CreateExercise :exec
-INSERT INTO exercises (id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW());
+INSERT INTO exercises (id, lesson_id, question, kind, answers, correct_answers, tolerance, text_match, order_index, points, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW());
 
 -- name: UpdateExercise :exec
 UPDATE exercises
 SET question = $2,
-    answers = $3,
-    correct_answer = $4,
-    order_index = $5,
+    kind = $3,
+    answers = $4,
+    correct_answers = $5,
+    tolerance = $6,
+    text_match = $7,
+    order_index = $8,
+    points = $9,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -17,12 +21,12 @@ WHERE id = $1;
 DELETE FROM exercises WHERE id = $1;
 
 -- name: GetExerciseByID :one
-SELECT id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at
+SELECT id, lesson_id, question, answers, order_index, created_at, updated_at, kind, correct_answers, tolerance, text_match, points
 FROM exercises
 WHERE id = $1;
 
 -- name: GetExercisesByLessonID :many
-SELECT id, lesson_id, question, answers, correct_answer, order_index, created_at, updated_at
+SELECT id, lesson_id, question, answers, order_index, created_at, updated_at, kind, correct_answers, tolerance, text_match, points
 FROM exercises
 WHERE lesson_id = $1
 ORDER BY order_index ASC;
@@ -1,8 +1,8 @@
 -- Lesson queries
 
 -- name: CreateLesson :exec
-INSERT INTO lessons (id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW());
+INSERT INTO lessons (id, module_id, title, overview, content, video_id, duration, order_index, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, NOW(), NOW());
 
 -- name: UpdateLesson :exec
 UPDATE lessons
@@ -12,6 +12,15 @@ SET title = $2,
     video_id = $5,
     duration = $6,
     order_index = $7,
+    requires_previous = $8,
+    min_score_lesson_id = $9,
+    min_score = $10,
+    pass_score = $11,
+    draw_count = $12,
+    time_limit = $13,
+    max_attempts = $14,
+    bank_question_ids = $15,
+    bank_pools = $16,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -19,12 +28,12 @@ WHERE id = $1;
 DELETE FROM lessons WHERE id = $1;
 
 -- name: GetLessonByID :one
-SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at
+SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools
 FROM lessons
 WHERE id = $1;
 
 -- name: GetLessonsByModuleID :many
-SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at
+SELECT id, module_id, title, overview, content, video_id, duration, order_index, created_at, updated_at, requires_previous, min_score_lesson_id, min_score, pass_score, draw_count, time_limit, max_attempts, bank_question_ids, bank_pools
 FROM lessons
 WHERE module_id = $1
 ORDER BY order_index ASC;
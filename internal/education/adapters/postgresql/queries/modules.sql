@@ -1,13 +1,15 @@
 -- Module queries
 
 -- name: CreateModule :exec
-INSERT INTO modules (id, course_id, title, order_index, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW(), NOW());
+INSERT INTO modules (id, course_id, title, order_index, release_after_days, release_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW());
 
 -- name: UpdateModule :exec
 UPDATE modules
 SET title = $2,
     order_index = $3,
+    release_after_days = $4,
+    release_at = $5,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -15,12 +17,12 @@ WHERE id = $1;
 DELETE FROM modules WHERE id = $1;
 
 -- name: GetModuleByID :one
-SELECT id, course_id, title, order_index, created_at, updated_at
+SELECT id, course_id, title, order_index, duration, created_at, updated_at, release_after_days, release_at
 FROM modules
 WHERE id = $1;
 
 -- name: GetModulesByCourseID :many
-SELECT id, course_id, title, order_index, created_at, updated_at
+SELECT id, course_id, title, order_index, duration, created_at, updated_at, release_after_days, release_at
 FROM modules
 WHERE course_id = $1
 ORDER BY order_index ASC;
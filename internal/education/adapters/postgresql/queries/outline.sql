@@ -0,0 +1,25 @@
+-- Course outline queries
+
+-- name: GetEnrollmentOutline :many
+SELECT (m.module->>'id')::text AS module_id,
+    (m.module->>'title')::text AS module_title,
+    (m.module->>'order')::int AS module_order,
+    (SELECT COALESCE(SUM((x.lesson->>'duration')::int), 0) FROM jsonb_array_elements(m.module->'lessons') AS x(lesson))::int AS module_duration,
+    COALESCE((m.module->'release'->>'days_after_enrollment')::int, 0)::int AS release_after_days,
+    ((m.module->'release'->>'date')::timestamptz AT TIME ZONE 'UTC')::timestamp AS release_at,
+    COALESCE(l.lesson->>'id', '')::text AS lesson_id,
+    COALESCE(l.lesson->>'title', '')::text AS lesson_title,
+    COALESCE((l.lesson->>'duration')::int, 0)::int AS lesson_duration,
+    COALESCE((l.lesson->>'order')::int, 0)::int AS lesson_order,
+    (COALESCE(l.lesson->>'video_id', '') <> '')::bool AS has_video,
+    COALESCE((l.lesson->'gate'->>'require_previous')::bool, false)::bool AS requires_previous,
+    COALESCE(l.lesson->'gate'->>'score_lesson_id', '')::text AS min_score_lesson_id,
+    COALESCE((l.lesson->'gate'->>'min_score')::float8, 0)::float8 AS min_score,
+    COALESCE(lp.progress_status, '')::text AS lesson_status
+FROM enrollments en
+JOIN course_versions v ON v.course_id = en.course_id AND v.version = en.content_version
+CROSS JOIN LATERAL jsonb_array_elements(v.content->'modules') AS m(module)
+LEFT JOIN LATERAL jsonb_array_elements(m.module->'lessons') AS l(lesson) ON true
+LEFT JOIN lesson_progress lp ON lp.enrollment_id = en.id AND lp.lesson_id = l.lesson->>'id'
+WHERE en.id = $1
+ORDER BY module_order, lesson_order;
@@ -0,0 +1,39 @@
+-- Course prerequisite queries
+
+-- name: CreateCoursePrerequisite :exec
+INSERT INTO course_prerequisites (course_id, prerequisite_id, required)
+VALUES ($1, $2, $3);
+
+-- name: DeleteCoursePrerequisites :exec
+DELETE FROM course_prerequisites WHERE course_id = $1;
+
+-- name: GetCoursePrerequisites :many
+SELECT prerequisite_id, required
+FROM course_prerequisites
+WHERE course_id = $1
+ORDER BY prerequisite_id;
+
+-- name: GetTransitivePrerequisites :many
+WITH RECURSIVE reachable(course_id) AS (
+    SELECT cp.prerequisite_id
+    FROM course_prerequisites cp
+    WHERE cp.course_id = ANY(sqlc.arg(course_ids)::text[])
+    UNION
+    SELECT cp.prerequisite_id
+    FROM course_prerequisites cp
+    JOIN reachable r ON cp.course_id = r.course_id
+)
+SELECT course_id::text FROM reachable;
+
+-- name: UpsertPrerequisiteWaiver :exec
+INSERT INTO prerequisite_waivers (course_id, user_id, granted_by, granted_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (course_id, user_id) DO UPDATE
+SET granted_by = EXCLUDED.granted_by,
+    granted_at = EXCLUDED.granted_at;
+
+-- name: DeletePrerequisiteWaiver :exec
+DELETE FROM prerequisite_waivers WHERE course_id = $1 AND user_id = $2;
+
+-- name: PrerequisiteWaiverExists :one
+SELECT EXISTS(SELECT 1 FROM prerequisite_waivers WHERE course_id = $1 AND user_id = $2);
@@ -0,0 +1,58 @@
+-- Question bank queries
+
+-- name: CreateQuestionBank :exec
+INSERT INTO question_banks (id, teacher_id, title, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW());
+
+-- name: GetQuestionBankByID :one
+SELECT id, teacher_id, title, created_at, updated_at
+FROM question_banks
+WHERE id = $1;
+
+-- name: GetQuestionBanksByTeacherID :many
+SELECT id, teacher_id, title, created_at, updated_at
+FROM question_banks
+WHERE teacher_id = $1
+ORDER BY title ASC, id ASC;
+
+-- name: CreateBankQuestion :exec
+INSERT INTO bank_questions (id, bank_id, question, kind, answers, correct_answers, tolerance, text_match, points, topics, difficulty, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW());
+
+-- name: UpdateBankQuestion :exec
+UPDATE bank_questions
+SET question = $2,
+    kind = $3,
+    answers = $4,
+    correct_answers = $5,
+    tolerance = $6,
+    text_match = $7,
+    points = $8,
+    topics = $9,
+    difficulty = $10,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteUnusedBankQuestion :execrows
+DELETE FROM bank_questions
+WHERE id = $1
+  AND NOT EXISTS (SELECT 1 FROM lessons WHERE lessons.bank_question_ids @> ARRAY[bank_questions.id]::text[]);
+
+-- name: GetBankQuestionByID :one
+SELECT id, bank_id, question, kind, answers, correct_answers, tolerance, text_match, points, topics, difficulty, created_at, updated_at
+FROM bank_questions
+WHERE id = $1;
+
+-- name: GetBankQuestionsByBankID :many
+SELECT id, bank_id, question, kind, answers, correct_answers, tolerance, text_match, points, topics, difficulty, created_at, updated_at
+FROM bank_questions
+WHERE bank_id = sqlc.arg(bank_id)
+  AND (sqlc.arg(topic)::text = '' OR topics @> ARRAY[sqlc.arg(topic)::text])
+  AND (sqlc.arg(difficulty)::text = '' OR difficulty = sqlc.arg(difficulty)::text)
+ORDER BY id ASC;
+
+-- name: GetBankQuestionsByIDs :many
+SELECT id, bank_id, question, kind, answers, correct_answers, tolerance, text_match, points, topics, difficulty, created_at, updated_at
+FROM bank_questions
+WHERE id = ANY(sqlc.arg(ids)::text[])
+ORDER BY id ASC;
@@ -0,0 +1,16 @@
+-- Quiz session queries
+
+-- name: CreateQuizSession :exec
+INSERT INTO quiz_sessions (id, enrollment_id, lesson_id, content_version, seed, exercise_ids, started_at, deadline)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetQuizSessionByID :one
+SELECT id, enrollment_id, lesson_id, content_version, seed, exercise_ids, started_at, attempt_id, submitted_at, deadline
+FROM quiz_sessions
+WHERE id = $1;
+
+-- name: MarkQuizSessionSubmitted :execrows
+UPDATE quiz_sessions
+SET attempt_id = $2,
+    submitted_at = $3
+WHERE id = $1 AND attempt_id IS NULL;
@@ -0,0 +1,34 @@
+-- Review queries
+
+-- name: CreateReview :exec
+INSERT INTO reviews (id, course_id, user_id, rating, text, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateReview :exec
+UPDATE reviews
+SET rating = $2,
+    text = $3,
+    updated_at = $4
+WHERE id = $1;
+
+-- name: GetReviewByID :one
+SELECT id, course_id, user_id, rating, text, created_at, updated_at
+FROM reviews
+WHERE id = $1;
+
+-- name: GetReviewsByCourseID :many
+SELECT id, course_id, user_id, rating, text, created_at, updated_at
+FROM reviews
+WHERE course_id = $1
+ORDER BY created_at DESC, id;
+
+-- Course rating aggregate queries
+
+-- name: LockCourseForReview :one
+SELECT id FROM courses WHERE id = $1 FOR UPDATE;
+
+-- name: RefreshCourseRating :exec
+UPDATE courses
+SET rating = COALESCE((SELECT ROUND(AVG(r.rating), 2) FROM reviews r WHERE r.course_id = courses.id), 0),
+    review_count = (SELECT COUNT(*) FROM reviews r WHERE r.course_id = courses.id)
+WHERE id = $1;
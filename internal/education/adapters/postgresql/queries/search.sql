@@ -0,0 +1,13 @@
+-- Course search queries
+
+-- name: SearchCourses :many
+SELECT c.id, c.teacher_id, c.title, c.description, c.thumbnail, c.duration, c.domain, c.rating, c.review_count, c.level, c.status, c.created_at, c.updated_at,
+    ts_rank(s.document, q.query)::real AS rank,
+    ts_headline('english', c.title, q.query, 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true')::text AS title_highlight,
+    ts_headline('english', concat_ws(' ', s.description, s.lesson_text), q.query, 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=20, MinWords=5')::text AS snippet
+FROM course_search s
+JOIN courses c ON c.id = s.course_id
+CROSS JOIN websearch_to_tsquery('english', sqlc.arg(query)::text) AS q(query)
+WHERE s.document @@ q.query AND c.status = 'published'
+ORDER BY rank DESC, c.id
+LIMIT sqlc.arg(max_results);
@@ -0,0 +1,126 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/quiz"
+	"github.com/pkg/errors"
+)
+
+type QuizSessionRepository struct {
+	db          *pgxpool.Pool
+	queries     *database.Queries
+	enrollments *EnrollmentRepository
+	attempts    *AttemptRepository
+}
+
+func NewQuizSessionRepository(db *pgxpool.Pool) *QuizSessionRepository {
+	return &QuizSessionRepository{
+		db:          db,
+		queries:     database.New(db),
+		enrollments: NewEnrollmentRepository(db),
+		attempts:    NewAttemptRepository(db),
+	}
+}
+
+// Create implements quiz.SessionRepository
+func (r *QuizSessionRepository) Create(ctx context.Context, s *quiz.Session, updateEnrollment func(e *enrollment.Enrollment) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	// The enrollment is locked while the attempt is counted, so concurrent
+	// sessions cannot all pass the attempt cap
+	if err := r.enrollments.updateLocked(ctx, qtx, s.EnrollmentID(), updateEnrollment); err != nil {
+		return err
+	}
+
+	params := database.CreateQuizSessionParams{
+		ID:             s.ID(),
+		EnrollmentID:   s.EnrollmentID(),
+		LessonID:       s.LessonID(),
+		ContentVersion: int32(s.ContentVersion()),
+		Seed:           s.SeedForStorage(),
+		ExerciseIds:    s.ExerciseIDs(),
+		StartedAt:      pgtype.Timestamp{Time: s.StartedAt(), Valid: true},
+		Deadline:       pgtype.Timestamp{Time: s.Deadline(), Valid: s.IsTimed()},
+	}
+
+	if err := qtx.CreateQuizSession(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "quiz session"), "failed to create quiz session")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Get implements quiz.SessionRepository
+func (r *QuizSessionRepository) Get(ctx context.Context, id string) (*quiz.Session, error) {
+	dbSession, err := r.queries.GetQuizSessionByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "quiz session"), "failed to get quiz session")
+	}
+
+	return quiz.UnmarshalSessionFromDatabase(
+		dbSession.ID,
+		dbSession.EnrollmentID,
+		dbSession.LessonID,
+		int(dbSession.ContentVersion),
+		dbSession.Seed,
+		dbSession.ExerciseIds,
+		dbSession.StartedAt.Time,
+		dbSession.Deadline.Time,
+		dbSession.AttemptID.String,
+		dbSession.SubmittedAt.Time,
+	), nil
+}
+
+// MarkSubmitted implements quiz.SessionRepository. Only an open session is
+// updated, so two concurrent submissions cannot both be graded.
+func (r *QuizSessionRepository) MarkSubmitted(ctx context.Context, s *quiz.Session, a *attempt.Attempt, updateEnrollment func(e *enrollment.Enrollment) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	updated, err := qtx.MarkQuizSessionSubmitted(ctx, database.MarkQuizSessionSubmittedParams{
+		ID:          s.ID(),
+		AttemptID:   pgtype.Text{String: s.AttemptID(), Valid: s.AttemptID() != ""},
+		SubmittedAt: pgtype.Timestamp{Time: s.SubmittedAt(), Valid: !s.SubmittedAt().IsZero()},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to mark quiz session submitted")
+	}
+	if updated == 0 {
+		return commonerrors.NewConflictError("quiz session was already submitted", "quiz-session-submitted")
+	}
+
+	if err := r.attempts.createAttempt(ctx, qtx, a); err != nil {
+		return err
+	}
+	if err := r.enrollments.updateLocked(ctx, qtx, s.EnrollmentID(), updateEnrollment); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/pkg/errors"
+)
+
+type ReviewRepository struct {
+	db      *pgxpool.Pool
+	queries *database.Queries
+}
+
+func NewReviewRepository(db *pgxpool.Pool) *ReviewRepository {
+	return &ReviewRepository{
+		db:      db,
+		queries: database.New(db),
+	}
+}
+
+// Create implements review.ReviewRepository
+func (r *ReviewRepository) Create(ctx context.Context, rv *review.Review) error {
+	return r.saveAndRefreshRating(ctx, rv.CourseID(), func(q *database.Queries) error {
+		params := database.CreateReviewParams{
+			ID:        rv.ID(),
+			CourseID:  rv.CourseID(),
+			UserID:    rv.UserID(),
+			Rating:    int16(rv.Rating()),
+			Text:      rv.Text(),
+			CreatedAt: pgtype.Timestamp{Time: rv.CreatedAt(), Valid: true},
+			UpdatedAt: pgtype.Timestamp{Time: rv.UpdatedAt(), Valid: true},
+		}
+
+		if err := q.CreateReview(ctx, params); err != nil {
+			return errors.Wrap(translateError(err, "review"), "failed to create review")
+		}
+		return nil
+	})
+}
+
+// Update implements review.ReviewRepository
+func (r *ReviewRepository) Update(ctx context.Context, rv *review.Review) error {
+	return r.saveAndRefreshRating(ctx, rv.CourseID(), func(q *database.Queries) error {
+		params := database.UpdateReviewParams{
+			ID:        rv.ID(),
+			Rating:    int16(rv.Rating()),
+			Text:      rv.Text(),
+			UpdatedAt: pgtype.Timestamp{Time: rv.UpdatedAt(), Valid: true},
+		}
+
+		if err := q.UpdateReview(ctx, params); err != nil {
+			return errors.Wrap(err, "failed to update review")
+		}
+		return nil
+	})
+}
+
+// Get implements review.ReviewRepository
+func (r *ReviewRepository) Get(ctx context.Context, id string) (*review.Review, error) {
+	dbReview, err := r.queries.GetReviewByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "review"), "failed to get review")
+	}
+
+	return r.toDomainReview(dbReview), nil
+}
+
+// GetAllByCourseID implements review.ReviewRepository
+func (r *ReviewRepository) GetAllByCourseID(ctx context.Context, courseID string) ([]*review.Review, error) {
+	dbReviews, err := r.queries.GetReviewsByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reviews by course")
+	}
+
+	reviews := make([]*review.Review, 0, len(dbReviews))
+	for _, dbReview := range dbReviews {
+		reviews = append(reviews, r.toDomainReview(dbReview))
+	}
+
+	return reviews, nil
+}
+
+// Helper methods
+
+// saveAndRefreshRating runs save and recomputes the course rating and review count
+// in one transaction. The course row is locked first, so concurrent reviews of the
+// same course are aggregated one after another and none of them is missed.
+func (r *ReviewRepository) saveAndRefreshRating(ctx context.Context, courseID string, save func(q *database.Queries) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	if _, err := qtx.LockCourseForReview(ctx, courseID); err != nil {
+		return errors.Wrap(translateError(err, "course"), "failed to lock course")
+	}
+
+	if err := save(qtx); err != nil {
+		return err
+	}
+
+	if err := qtx.RefreshCourseRating(ctx, courseID); err != nil {
+		return errors.Wrap(err, "failed to refresh course rating")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) toDomainReview(dbReview database.Review) *review.Review {
+	return review.UnmarshalReviewFromDatabase(
+		dbReview.ID,
+		dbReview.CourseID,
+		dbReview.UserID,
+		int(dbReview.Rating),
+		dbReview.Text,
+		dbReview.CreatedAt.Time,
+		dbReview.UpdatedAt.Time,
+	)
+}
@@ -0,0 +1,240 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+)
+
+type ReviewRepositoryTest struct {
+	Name       string
+	Repository *ReviewRepository
+}
+
+func TestReviewRepository(t *testing.T) {
+	t.Parallel()
+	rand.Seed(time.Now().UTC().UnixNano())
+
+	repositories := createReviewRepositories(t)
+
+	for i := range repositories {
+		r := repositories[i]
+
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("CreateAndGet", func(t *testing.T) {
+				t.Parallel()
+				testReviewCreateAndGet(t, r.Repository)
+			})
+			t.Run("RatingAggregate", func(t *testing.T) {
+				t.Parallel()
+				testReviewRatingAggregate(t, r.Repository)
+			})
+			t.Run("OnePerStudent", func(t *testing.T) {
+				t.Parallel()
+				testReviewOnePerStudent(t, r.Repository)
+			})
+			t.Run("GetAllByCourseID", func(t *testing.T) {
+				t.Parallel()
+				testReviewGetAllByCourseID(t, r.Repository)
+			})
+		})
+	}
+}
+
+func createReviewRepositories(t *testing.T) []ReviewRepositoryTest {
+	return []ReviewRepositoryTest{
+		{
+			Name:       "PostgreSQL",
+			Repository: newPostgreSQLReviewRepository(t),
+		},
+	}
+}
+
+func testReviewCreateAndGet(t *testing.T, repository *ReviewRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+	userID := createTestStudent(t, ctx, repository.db)
+
+	rv, err := review.NewReview(generateReviewID(), courseID, userID, 4, "Well structured")
+	if err != nil {
+		t.Fatalf("failed to create review domain model: %v", err)
+	}
+
+	if err := repository.Create(ctx, rv); err != nil {
+		t.Fatalf("failed to create review: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, rv.ID())
+	if err != nil {
+		t.Fatalf("failed to get review: %v", err)
+	}
+
+	if retrieved.CourseID() != courseID || retrieved.UserID() != userID {
+		t.Errorf("expected review of %s by %s, got %s by %s", courseID, userID, retrieved.CourseID(), retrieved.UserID())
+	}
+	if retrieved.Rating() != 4 {
+		t.Errorf("expected rating 4, got %d", retrieved.Rating())
+	}
+	if retrieved.Text() != "Well structured" {
+		t.Errorf("expected text 'Well structured', got '%s'", retrieved.Text())
+	}
+}
+
+func testReviewRatingAggregate(t *testing.T, repository *ReviewRepository) {
+	ctx := context.Background()
+	courseRepository := NewCourseRepository(repository.db)
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	// The course rating is the average of its reviews
+	var first *review.Review
+	for _, rating := range []int{5, 4, 2} {
+		rv, _ := review.NewReview(generateReviewID(), courseID, createTestStudent(t, ctx, repository.db), rating, "")
+		if err := repository.Create(ctx, rv); err != nil {
+			t.Fatalf("failed to create review: %v", err)
+		}
+		if first == nil {
+			first = rv
+		}
+	}
+
+	c, err := courseRepository.Get(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if c.Rating() != 3.67 {
+		t.Errorf("expected rating 3.67, got %f", c.Rating())
+	}
+	if c.ReviewCount() != 3 {
+		t.Errorf("expected 3 reviews, got %d", c.ReviewCount())
+	}
+
+	// Editing a review recomputes the rating without changing the count
+	if err := first.Edit(2, "Got worse"); err != nil {
+		t.Fatalf("failed to edit review: %v", err)
+	}
+	if err := repository.Update(ctx, first); err != nil {
+		t.Fatalf("failed to update review: %v", err)
+	}
+
+	c, err = courseRepository.Get(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if c.Rating() != 2.67 {
+		t.Errorf("expected rating 2.67, got %f", c.Rating())
+	}
+	if c.ReviewCount() != 3 {
+		t.Errorf("expected 3 reviews, got %d", c.ReviewCount())
+	}
+
+	// Deleting the author deletes the review and recomputes the rating
+	if _, err := repository.db.Exec(ctx, `DELETE FROM users WHERE id = $1`, first.UserID()); err != nil {
+		t.Fatalf("failed to delete student: %v", err)
+	}
+
+	c, err = courseRepository.Get(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get course: %v", err)
+	}
+	if c.Rating() != 3 {
+		t.Errorf("expected rating 3, got %f", c.Rating())
+	}
+	if c.ReviewCount() != 2 {
+		t.Errorf("expected 2 reviews, got %d", c.ReviewCount())
+	}
+}
+
+func testReviewOnePerStudent(t *testing.T, repository *ReviewRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+	userID := createTestStudent(t, ctx, repository.db)
+
+	first, _ := review.NewReview(generateReviewID(), courseID, userID, 3, "")
+	if err := repository.Create(ctx, first); err != nil {
+		t.Fatalf("failed to create review: %v", err)
+	}
+
+	second, _ := review.NewReview(generateReviewID(), courseID, userID, 5, "")
+	err := repository.Create(ctx, second)
+	if err == nil {
+		t.Fatal("expected error for a second review, got nil")
+	}
+
+	var slugErr commonerrors.SlugError
+	if !errors.As(err, &slugErr) || slugErr.Slug() != "already-reviewed" {
+		t.Errorf("expected 'already-reviewed' error, got %v", err)
+	}
+}
+
+func testReviewGetAllByCourseID(t *testing.T, repository *ReviewRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	for i := 0; i < 3; i++ {
+		rv, _ := review.NewReview(generateReviewID(), courseID, createTestStudent(t, ctx, repository.db), i+1, "")
+		if err := repository.Create(ctx, rv); err != nil {
+			t.Fatalf("failed to create review: %v", err)
+		}
+	}
+
+	reviews, err := repository.GetAllByCourseID(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get reviews: %v", err)
+	}
+
+	if len(reviews) != 3 {
+		t.Fatalf("expected 3 reviews, got %d", len(reviews))
+	}
+	for i := 1; i < len(reviews); i++ {
+		if reviews[i].CreatedAt().After(reviews[i-1].CreatedAt()) {
+			t.Errorf("expected reviews newest first")
+		}
+	}
+}
+
+func generateReviewID() string {
+	return fmt.Sprintf("test-review-%d-%d", time.Now().UnixNano(), rand.Intn(10000))
+}
+
+func newPostgreSQLReviewRepository(t *testing.T) *ReviewRepository {
+	// Setup testcontainer for PostgreSQL
+	container, cleanup := SetupTestDatabase(t)
+	t.Cleanup(cleanup)
+
+	pool, err := pgxpool.New(context.Background(), container.ConnectionString)
+	if err != nil {
+		t.Fatalf("unable to create connection pool: %v", err)
+	}
+
+	if err := pool.Ping(context.Background()); err != nil {
+		t.Fatalf("unable to ping database: %v", err)
+	}
+
+	return NewReviewRepository(pool)
+}
+
+// createTestStudent creates a test student in the database and returns its ID
+func createTestStudent(t *testing.T, ctx context.Context, pool *pgxpool.Pool) string {
+	userID := fmt.Sprintf("test-student-%d-%d", time.Now().UnixNano(), rand.Intn(10000))
+
+	query := `
+		INSERT INTO users (id, username, email, role)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := pool.Exec(ctx, query, userID, userID, userID+"@example.com", "student")
+	if err != nil {
+		t.Fatalf("failed to create test student: %v", err)
+	}
+
+	return userID
+}
@@ -12,7 +12,7 @@ CREATE TABLE IF NOT EXISTS users (
 CREATE INDEX idx_users_email ON users(email);
 CREATE INDEX idx_users_role ON users(role);
 
--- Courses table
+-- Courses table (duration is the sum of its module durations, kept current by triggers)
 CREATE TABLE IF NOT EXISTS courses (
     id VARCHAR(255) PRIMARY KEY,
     teacher_id VARCHAR(255) NOT NULL,
@@ -22,7 +22,9 @@ CREATE TABLE IF NOT EXISTS courses (
     duration INT NOT NULL DEFAULT 0 CHECK (duration >= 0),
     domain VARCHAR(100) NOT NULL,
     rating DECIMAL(3, 2) DEFAULT 0.0 CHECK (rating >= 0 AND rating <= 5),
+    review_count INT NOT NULL DEFAULT 0 CHECK (review_count >= 0),
     level VARCHAR(50) NOT NULL CHECK (level IN ('beginner', 'intermediate', 'advanced')),
+    status VARCHAR(50) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     FOREIGN KEY (teacher_id) REFERENCES users(id) ON DELETE CASCADE
@@ -31,6 +33,11 @@ CREATE TABLE IF NOT EXISTS courses (
 CREATE INDEX idx_courses_teacher_id ON courses(teacher_id);
 CREATE INDEX idx_courses_domain ON courses(domain);
 CREATE INDEX idx_courses_level ON courses(level);
+CREATE INDEX idx_courses_status ON courses(status);
+CREATE INDEX idx_courses_created_at_id ON courses(created_at, id);
+CREATE INDEX idx_courses_title_id ON courses(title, id);
+CREATE INDEX idx_courses_rating_id ON courses((COALESCE(rating, 0)), id);
+CREATE INDEX idx_courses_duration_id ON courses(duration, id);
 
 -- Course tags (many-to-many through junction table)
 CREATE TABLE IF NOT EXISTS course_tags (
@@ -42,16 +49,44 @@ CREATE TABLE IF NOT EXISTS course_tags (
 
 CREATE INDEX idx_course_tags_tag ON course_tags(tag);
 
--- Modules table
+-- Course prerequisites (required ones gate enrollment, recommended ones are informational)
+CREATE TABLE IF NOT EXISTS course_prerequisites (
+    course_id VARCHAR(255) NOT NULL,
+    prerequisite_id VARCHAR(255) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (course_id, prerequisite_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (prerequisite_id) REFERENCES courses(id) ON DELETE CASCADE,
+    CHECK (course_id <> prerequisite_id)
+);
+
+CREATE INDEX idx_course_prerequisites_prerequisite_id ON course_prerequisites(prerequisite_id);
+
+-- Prerequisite waivers (students let in without the required prerequisites)
+CREATE TABLE IF NOT EXISTS prerequisite_waivers (
+    course_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    granted_by VARCHAR(255) NOT NULL,
+    granted_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, user_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Modules table (duration is the sum of its lesson durations, kept current by triggers)
 CREATE TABLE IF NOT EXISTS modules (
     id VARCHAR(255) PRIMARY KEY,
     course_id VARCHAR(255) NOT NULL,
     title VARCHAR(500) NOT NULL,
     order_index INT NOT NULL CHECK (order_index >= 0),
+    duration INT NOT NULL DEFAULT 0 CHECK (duration >= 0),
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    release_after_days INT CHECK (release_after_days > 0),
+    release_at TIMESTAMP,
     FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
-    UNIQUE (course_id, order_index)
+    UNIQUE (course_id, order_index),
+    CONSTRAINT modules_single_release CHECK (release_after_days IS NULL OR release_at IS NULL)
 );
 
 CREATE INDEX idx_modules_course_id ON modules(course_id);
@@ -69,12 +104,23 @@ CREATE TABLE IF NOT EXISTS lessons (
     order_index INT NOT NULL CHECK (order_index >= 0),
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    requires_previous BOOLEAN NOT NULL DEFAULT FALSE,
+    min_score_lesson_id VARCHAR(255),
+    min_score DECIMAL(5,2) CHECK (min_score > 0 AND min_score <= 100),
+    pass_score DECIMAL(5,2) CHECK (pass_score > 0 AND pass_score <= 100),
+    draw_count INT CHECK (draw_count > 0),
+    time_limit INT CHECK (time_limit > 0),
+    max_attempts INT CHECK (max_attempts > 0),
+    bank_question_ids TEXT[] NOT NULL DEFAULT '{}',
+    bank_pools JSONB NOT NULL DEFAULT '[]',
     FOREIGN KEY (module_id) REFERENCES modules(id) ON DELETE CASCADE,
-    UNIQUE (module_id, order_index)
+    UNIQUE (module_id, order_index),
+    CONSTRAINT lessons_min_score_pair CHECK ((min_score_lesson_id IS NULL) = (min_score IS NULL))
 );
 
 CREATE INDEX idx_lessons_module_id ON lessons(module_id);
 CREATE INDEX idx_lessons_order ON lessons(module_id, order_index);
+CREATE INDEX idx_lessons_bank_question_ids ON lessons USING GIN (bank_question_ids);
 
 -- Exercises table
 CREATE TABLE IF NOT EXISTS exercises (
@@ -82,10 +128,14 @@ CREATE TABLE IF NOT EXISTS exercises (
     lesson_id VARCHAR(255) NOT NULL,
     question TEXT NOT NULL,
     answers TEXT[] NOT NULL,
-    correct_answer TEXT NOT NULL,
     order_index INT NOT NULL CHECK (order_index >= 0),
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    kind VARCHAR(32) NOT NULL DEFAULT 'single_choice' CHECK (kind IN ('single_choice', 'multiple_choice', 'true_false', 'numeric', 'short_text', 'ordering')),
+    correct_answers TEXT[] NOT NULL DEFAULT '{}',
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    text_match VARCHAR(32) CHECK (text_match IN ('normalized', 'regex')),
+    points INT NOT NULL DEFAULT 1 CHECK (points > 0),
     FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE,
     UNIQUE (lesson_id, order_index)
 );
@@ -93,11 +143,55 @@ CREATE TABLE IF NOT EXISTS exercises (
 CREATE INDEX idx_exercises_lesson_id ON exercises(lesson_id);
 CREATE INDEX idx_exercises_order ON exercises(lesson_id, order_index);
 
+-- Question banks table (questions a teacher reuses across lessons and courses)
+CREATE TABLE IF NOT EXISTS question_banks (
+    id VARCHAR(255) PRIMARY KEY,
+    teacher_id VARCHAR(255) NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (teacher_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_question_banks_teacher_id ON question_banks(teacher_id);
+
+-- Bank questions table (tagged by topic and difficulty)
+CREATE TABLE IF NOT EXISTS bank_questions (
+    id VARCHAR(255) PRIMARY KEY,
+    bank_id VARCHAR(255) NOT NULL,
+    question TEXT NOT NULL,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('single_choice', 'multiple_choice', 'true_false', 'numeric', 'short_text', 'ordering')),
+    answers TEXT[] NOT NULL,
+    correct_answers TEXT[] NOT NULL DEFAULT '{}',
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    text_match VARCHAR(32) CHECK (text_match IN ('normalized', 'regex')),
+    points INT NOT NULL DEFAULT 1 CHECK (points > 0),
+    topics TEXT[] NOT NULL DEFAULT '{}',
+    difficulty VARCHAR(32) NOT NULL CHECK (difficulty IN ('easy', 'medium', 'hard')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (bank_id) REFERENCES question_banks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_bank_questions_bank_id ON bank_questions(bank_id);
+CREATE INDEX idx_bank_questions_topics ON bank_questions USING GIN (topics);
+
+-- Course versions table (immutable snapshots of the content, frozen on publish)
+CREATE TABLE IF NOT EXISTS course_versions (
+    course_id VARCHAR(255) NOT NULL,
+    version INT NOT NULL CHECK (version >= 1),
+    content JSONB NOT NULL,
+    published_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, version),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE
+);
+
 -- Enrollments table
 CREATE TABLE IF NOT EXISTS enrollments (
     id VARCHAR(255) PRIMARY KEY,
     user_id VARCHAR(255) NOT NULL,
     course_id VARCHAR(255) NOT NULL,
+    content_version INT NOT NULL,
     enrolled_at TIMESTAMP NOT NULL DEFAULT NOW(),
     started_at TIMESTAMP,
     completed_at TIMESTAMP,
@@ -107,7 +201,8 @@ CREATE TABLE IF NOT EXISTS enrollments (
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     UNIQUE (user_id, course_id),
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
-    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    CONSTRAINT enrollments_course_version_fkey FOREIGN KEY (course_id, content_version) REFERENCES course_versions(course_id, version) ON DELETE CASCADE
 );
 
 CREATE INDEX idx_enrollments_user_id ON enrollments(user_id);
@@ -123,8 +218,7 @@ CREATE TABLE IF NOT EXISTS module_progress (
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     PRIMARY KEY (enrollment_id, module_id),
-    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE,
-    FOREIGN KEY (module_id) REFERENCES modules(id) ON DELETE CASCADE
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE
 );
 
 CREATE INDEX idx_module_progress_enrollment ON module_progress(enrollment_id);
@@ -138,9 +232,72 @@ CREATE TABLE IF NOT EXISTS lesson_progress (
     exercise_score DECIMAL(5, 2) DEFAULT 0.0,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    attempts INT NOT NULL DEFAULT 0 CHECK (attempts >= 0),
     PRIMARY KEY (enrollment_id, lesson_id),
     FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE,
-    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE
+    CONSTRAINT lesson_progress_exercise_score_range CHECK (exercise_score >= 0 AND exercise_score <= 100)
 );
 
 CREATE INDEX idx_lesson_progress_enrollment ON lesson_progress(enrollment_id);
+
+-- Lesson attempts table
+CREATE TABLE IF NOT EXISTS lesson_attempts (
+    id VARCHAR(255) PRIMARY KEY,
+    enrollment_id VARCHAR(255) NOT NULL,
+    lesson_id VARCHAR(255) NOT NULL,
+    answers JSONB NOT NULL,
+    score DECIMAL(5, 2) NOT NULL DEFAULT 0.0 CHECK (score >= 0 AND score <= 100),
+    submitted_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_lesson_attempts_enrollment_lesson ON lesson_attempts(enrollment_id, lesson_id);
+
+-- Quiz sessions table (exercises of a lesson drawn and shuffled from a server-side seed)
+CREATE TABLE IF NOT EXISTS quiz_sessions (
+    id VARCHAR(255) PRIMARY KEY,
+    enrollment_id VARCHAR(255) NOT NULL,
+    lesson_id VARCHAR(255) NOT NULL,
+    content_version INT NOT NULL,
+    seed BIGINT NOT NULL,
+    exercise_ids TEXT[] NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    attempt_id VARCHAR(255),
+    submitted_at TIMESTAMP,
+    deadline TIMESTAMP,
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_quiz_sessions_enrollment_lesson ON quiz_sessions(enrollment_id, lesson_id);
+
+-- Course search documents (kept current by triggers on courses, modules and lessons)
+CREATE TABLE IF NOT EXISTS course_search (
+    course_id VARCHAR(255) PRIMARY KEY,
+    title VARCHAR(500) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    lesson_text TEXT NOT NULL DEFAULT '',
+    document TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', description), 'B') ||
+        setweight(to_tsvector('english', lesson_text), 'C')
+    ) STORED,
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_course_search_document ON course_search USING GIN (document);
+
+-- Reviews table (one per student and course; courses.rating and review_count aggregate it)
+CREATE TABLE IF NOT EXISTS reviews (
+    id VARCHAR(255) PRIMARY KEY,
+    course_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating >= 1 AND rating <= 5),
+    text TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (course_id, user_id)
+);
+
+CREATE INDEX idx_reviews_course_created_at ON reviews(course_id, created_at DESC);
@@ -38,7 +38,7 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 	}
 
 	if err := r.queries.CreateUser(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to create user")
+		return errors.Wrap(translateError(err, "user"), "failed to create user")
 	}
 
 	return nil
@@ -60,7 +60,7 @@ func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 	}
 
 	if err := r.queries.UpdateUser(ctx, params); err != nil {
-		return errors.Wrap(err, "failed to update user")
+		return errors.Wrap(translateError(err, "user"), "failed to update user")
 	}
 
 	return nil
@@ -79,7 +79,7 @@ func (r *UserRepository) Delete(ctx context.Context, id string) error {
 func (r *UserRepository) Get(ctx context.Context, id string) (*user.User, error) {
 	dbUser, err := r.queries.GetUserByID(ctx, id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user")
+		return nil, errors.Wrap(translateError(err, "user"), "failed to get user")
 	}
 
 	return r.toDomainUser(dbUser)
@@ -0,0 +1,412 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql/database"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+)
+
+// VersionRepository saves and loads course versions, reusing the
+// repositories of the module, lesson, exercise and bank tables to freeze the
+// working copy
+type VersionRepository struct {
+	db        *pgxpool.Pool
+	queries   *database.Queries
+	modules   *ModuleRepository
+	lessons   *LessonRepository
+	exercises *ExerciseRepository
+	banks     *BankRepository
+}
+
+func NewVersionRepository(db *pgxpool.Pool) *VersionRepository {
+	return &VersionRepository{
+		db:        db,
+		queries:   database.New(db),
+		modules:   NewModuleRepository(db),
+		lessons:   NewLessonRepository(db),
+		exercises: NewExerciseRepository(db),
+		banks:     NewBankRepository(db),
+	}
+}
+
+// versionContent is the JSON representation of the frozen content in course_versions.content
+type versionContent struct {
+	Modules []versionModule `json:"modules"`
+}
+
+type versionModule struct {
+	ID      string          `json:"id"`
+	Title   string          `json:"title"`
+	Order   int             `json:"order"`
+	Release *versionRelease `json:"release,omitempty"`
+	Lessons []versionLesson `json:"lessons"`
+}
+
+// versionRelease is absent for modules open on enrollment and in versions
+// frozen before releases existed
+type versionRelease struct {
+	DaysAfterEnrollment int        `json:"days_after_enrollment,omitempty"`
+	Date                *time.Time `json:"date,omitempty"`
+}
+
+type versionLesson struct {
+	ID              string            `json:"id"`
+	Title           string            `json:"title"`
+	Overview        string            `json:"overview"`
+	Content         string            `json:"content"`
+	VideoID         string            `json:"video_id"`
+	Duration        int               `json:"duration"`
+	Order           int               `json:"order"`
+	Gate            *versionGate      `json:"gate,omitempty"`
+	PassScore       float64           `json:"pass_score,omitempty"`
+	DrawCount       int               `json:"draw_count,omitempty"`
+	TimeLimit       int               `json:"time_limit,omitempty"`
+	MaxAttempts     int               `json:"max_attempts,omitempty"`
+	BankQuestionIDs []string          `json:"bank_question_ids,omitempty"`
+	BankPools       []lessonPool      `json:"bank_pools,omitempty"`
+	Exercises       []versionExercise `json:"exercises"`
+}
+
+// versionGate is absent for open lessons and in versions frozen before gates existed
+type versionGate struct {
+	RequirePrevious bool    `json:"require_previous"`
+	ScoreLessonID   string  `json:"score_lesson_id,omitempty"`
+	MinScore        float64 `json:"min_score,omitempty"`
+}
+
+// versionExercise has no kind in versions frozen before other question types
+// existed, which are single choice with their answer in CorrectAnswer
+type versionExercise struct {
+	ID             string   `json:"id"`
+	Question       string   `json:"question"`
+	Kind           string   `json:"kind,omitempty"`
+	Answers        []string `json:"answers"`
+	CorrectAnswer  string   `json:"correct_answer,omitempty"`
+	CorrectAnswers []string `json:"correct_answers,omitempty"`
+	Tolerance      float64  `json:"tolerance,omitempty"`
+	TextMatch      string   `json:"text_match,omitempty"`
+	Order          int      `json:"order"`
+	// Points is absent in versions frozen before exercises were weighted
+	Points int `json:"points,omitempty"`
+	// Pool is the bank pool of the lesson the exercise was drawn into
+	Pool string `json:"pool,omitempty"`
+}
+
+// Create implements version.VersionRepository
+func (r *VersionRepository) Create(ctx context.Context, v *version.Version) error {
+	return r.createVersion(ctx, r.queries, v)
+}
+
+// Get implements version.VersionRepository
+func (r *VersionRepository) Get(ctx context.Context, courseID string, number int) (*version.Version, error) {
+	dbVersion, err := r.queries.GetCourseVersion(ctx, database.GetCourseVersionParams{
+		CourseID: courseID,
+		Version:  int32(number),
+	})
+	if err != nil {
+		return nil, errors.Wrap(translateError(err, "version"), "failed to get course version")
+	}
+
+	return toDomainVersion(dbVersion)
+}
+
+// LatestNumber implements version.VersionRepository
+func (r *VersionRepository) LatestNumber(ctx context.Context, courseID string) (int, error) {
+	number, err := r.queries.GetLatestCourseVersionNumber(ctx, courseID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get latest course version")
+	}
+
+	return int(number), nil
+}
+
+// Helper methods
+
+func (r *VersionRepository) createVersion(ctx context.Context, q *database.Queries, v *version.Version) error {
+	content, err := json.Marshal(toVersionContent(v))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal version content")
+	}
+
+	params := database.CreateCourseVersionParams{
+		CourseID:    v.CourseID(),
+		Version:     int32(v.Number()),
+		Content:     content,
+		PublishedAt: pgtype.Timestamp{Time: v.PublishedAt(), Valid: true},
+	}
+
+	if err := q.CreateCourseVersion(ctx, params); err != nil {
+		return errors.Wrap(translateError(err, "version"), "failed to create course version")
+	}
+
+	return nil
+}
+
+// freeze snapshots the working copy of the course content as the next
+// version of the course, reading and writing through q so the snapshot and
+// the version are taken in the transaction of the caller
+func (r *VersionRepository) freeze(ctx context.Context, q *database.Queries, courseID string) error {
+	latest, err := q.GetLatestCourseVersionNumber(ctx, courseID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest course version")
+	}
+
+	dbModules, err := q.GetModulesByCourseID(ctx, courseID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get course modules")
+	}
+
+	moduleContents := make([]version.ModuleContent, 0, len(dbModules))
+	for _, dbModule := range dbModules {
+		m, err := r.modules.toDomainModule(dbModule)
+		if err != nil {
+			return err
+		}
+
+		dbLessons, err := q.GetLessonsByModuleID(ctx, m.ID())
+		if err != nil {
+			return errors.Wrap(err, "failed to get module lessons")
+		}
+
+		lessonContents := make([]version.LessonContent, 0, len(dbLessons))
+		for _, dbLesson := range dbLessons {
+			l, err := r.lessons.toDomainLesson(dbLesson)
+			if err != nil {
+				return err
+			}
+			lc, err := r.freezeLesson(ctx, q, l)
+			if err != nil {
+				return err
+			}
+			lessonContents = append(lessonContents, lc)
+		}
+		moduleContents = append(moduleContents, version.NewModuleContent(m, lessonContents))
+	}
+
+	v, err := version.NewVersion(courseID, int(latest)+1, moduleContents)
+	if err != nil {
+		return errors.Wrap(err, "failed to create course version")
+	}
+
+	return r.createVersion(ctx, q, v)
+}
+
+// freezeLesson reads the exercises of a lesson and the bank questions it
+// refers to as they are now
+func (r *VersionRepository) freezeLesson(ctx context.Context, q *database.Queries, l *lesson.Lesson) (version.LessonContent, error) {
+	dbExercises, err := q.GetExercisesByLessonID(ctx, l.ID())
+	if err != nil {
+		return version.LessonContent{}, errors.Wrap(err, "failed to get lesson exercises")
+	}
+	exercises := make([]*exercise.Exercise, 0, len(dbExercises))
+	for _, dbExercise := range dbExercises {
+		e, err := r.exercises.toDomainExercise(dbExercise)
+		if err != nil {
+			return version.LessonContent{}, err
+		}
+		exercises = append(exercises, e)
+	}
+
+	questions := make(map[string]*bank.Question)
+	if ids := l.BankQuestionIDs(); len(ids) > 0 {
+		dbQuestions, err := q.GetBankQuestionsByIDs(ctx, ids)
+		if err != nil {
+			return version.LessonContent{}, errors.Wrap(err, "failed to get bank questions by IDs")
+		}
+		found, err := r.banks.toDomainQuestions(dbQuestions)
+		if err != nil {
+			return version.LessonContent{}, err
+		}
+		for _, question := range found {
+			questions[question.ID()] = question
+		}
+	}
+
+	pools := make(map[string][]*bank.Question, len(l.BankPools()))
+	for _, p := range l.BankPools() {
+		dbQuestions, err := q.GetBankQuestionsByBankID(ctx, database.GetBankQuestionsByBankIDParams{
+			BankID:     p.BankID(),
+			Topic:      p.Filter().Topic(),
+			Difficulty: p.Filter().Difficulty().String(),
+		})
+		if err != nil {
+			return version.LessonContent{}, errors.Wrap(err, "failed to get bank questions")
+		}
+		if pools[p.Key()], err = r.banks.toDomainQuestions(dbQuestions); err != nil {
+			return version.LessonContent{}, err
+		}
+	}
+
+	return version.FreezeLessonContent(l, exercises, questions, pools)
+}
+
+func toVersionContent(v *version.Version) versionContent {
+	content := versionContent{Modules: make([]versionModule, 0, len(v.Modules()))}
+	for _, mc := range v.Modules() {
+		m := mc.Module()
+		dbModule := versionModule{
+			ID:      m.ID(),
+			Title:   m.Title(),
+			Order:   m.Order(),
+			Lessons: make([]versionLesson, 0, len(mc.Lessons())),
+		}
+		if release := m.Release(); !release.IsImmediate() {
+			dbModule.Release = &versionRelease{DaysAfterEnrollment: release.DaysAfterEnrollment()}
+			if date := release.Date().UTC(); !date.IsZero() {
+				dbModule.Release.Date = &date
+			}
+		}
+
+		for _, lc := range mc.Lessons() {
+			l := lc.Lesson()
+			dbLesson := versionLesson{
+				ID:              l.ID(),
+				Title:           l.Title(),
+				Overview:        l.Overview(),
+				Content:         l.Content(),
+				VideoID:         l.VideoID(),
+				Duration:        l.Duration(),
+				Order:           l.Order(),
+				PassScore:       l.PassScore(),
+				DrawCount:       l.DrawCount(),
+				TimeLimit:       l.TimeLimit(),
+				MaxAttempts:     l.MaxAttempts(),
+				BankQuestionIDs: l.BankQuestionIDs(),
+				BankPools:       toLessonPools(l.BankPools()),
+				Exercises:       make([]versionExercise, 0, len(lc.Exercises())),
+			}
+			if gate := l.Gate(); !gate.IsOpen() {
+				dbLesson.Gate = &versionGate{
+					RequirePrevious: gate.RequiresPrevious(),
+					ScoreLessonID:   gate.ScoreLessonID(),
+					MinScore:        gate.MinScore(),
+				}
+			}
+
+			for _, e := range lc.Exercises() {
+				key := e.AnswerKeyForStorage()
+				dbLesson.Exercises = append(dbLesson.Exercises, versionExercise{
+					ID:             e.ID(),
+					Question:       e.Question(),
+					Kind:           key.Kind().String(),
+					Answers:        key.Options(),
+					CorrectAnswers: key.Correct(),
+					Tolerance:      key.Tolerance(),
+					TextMatch:      key.TextMatch().String(),
+					Order:          e.Order(),
+					Points:         e.Points(),
+					Pool:           e.Pool(),
+				})
+			}
+			dbModule.Lessons = append(dbModule.Lessons, dbLesson)
+		}
+		content.Modules = append(content.Modules, dbModule)
+	}
+
+	return content
+}
+
+func toDomainVersion(dbVersion database.CourseVersion) (*version.Version, error) {
+	var content versionContent
+	if err := json.Unmarshal(dbVersion.Content, &content); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal version content")
+	}
+
+	modules := make([]version.ModuleContent, 0, len(content.Modules))
+	for _, dbModule := range content.Modules {
+		// Module durations are not frozen, they are the sum of the frozen lesson durations
+		duration := 0
+		for _, dbLesson := range dbModule.Lessons {
+			duration += dbLesson.Duration
+		}
+
+		m, err := module.UnmarshalModuleFromDatabase(dbModule.ID, dbVersion.CourseID, dbModule.Title, dbModule.Order, duration)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid module in version content")
+		}
+		if dbModule.Release != nil {
+			var date time.Time
+			if dbModule.Release.Date != nil {
+				date = *dbModule.Release.Date
+			}
+			release, err := module.NewRelease(dbModule.Release.DaysAfterEnrollment, date)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid module release in version content")
+			}
+			m.SetRelease(release)
+		}
+
+		lessons := make([]version.LessonContent, 0, len(dbModule.Lessons))
+		for _, dbLesson := range dbModule.Lessons {
+			l, err := lesson.NewLesson(dbLesson.ID, dbModule.ID, dbLesson.Title, dbLesson.Overview, dbLesson.Content, dbLesson.VideoID, dbLesson.Duration, dbLesson.Order)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid lesson in version content")
+			}
+			if dbLesson.Gate != nil {
+				gate, err := lesson.NewGate(dbLesson.Gate.RequirePrevious, dbLesson.Gate.ScoreLessonID, dbLesson.Gate.MinScore)
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid lesson gate in version content")
+				}
+				if err := l.SetGate(gate); err != nil {
+					return nil, errors.Wrap(err, "invalid lesson gate in version content")
+				}
+			}
+			if err := l.SetPassScore(dbLesson.PassScore); err != nil {
+				return nil, errors.Wrap(err, "invalid lesson pass score in version content")
+			}
+			if err := l.SetDrawCount(dbLesson.DrawCount); err != nil {
+				return nil, errors.Wrap(err, "invalid lesson draw count in version content")
+			}
+			if err := l.SetTimeLimit(dbLesson.TimeLimit); err != nil {
+				return nil, errors.Wrap(err, "invalid lesson time limit in version content")
+			}
+			if err := l.SetMaxAttempts(dbLesson.MaxAttempts); err != nil {
+				return nil, errors.Wrap(err, "invalid lesson max attempts in version content")
+			}
+			pools, err := toDomainPools(dbLesson.BankPools)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid lesson bank pool in version content")
+			}
+			if err := l.SetBankQuestions(dbLesson.BankQuestionIDs, pools); err != nil {
+				return nil, errors.Wrap(err, "invalid lesson bank questions in version content")
+			}
+
+			exercises := make([]*exercise.Exercise, 0, len(dbLesson.Exercises))
+			for _, dbExercise := range dbLesson.Exercises {
+				correct := dbExercise.CorrectAnswers
+				if len(correct) == 0 && dbExercise.CorrectAnswer != "" {
+					correct = []string{dbExercise.CorrectAnswer}
+				}
+				key, err := exercise.ParseAnswerKey(dbExercise.Kind, dbExercise.Answers, correct, dbExercise.Tolerance, dbExercise.TextMatch)
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid exercise in version content")
+				}
+				e, err := exercise.NewExerciseWithKey(dbExercise.ID, dbLesson.ID, dbExercise.Question, key, dbExercise.Order)
+				if err != nil {
+					return nil, errors.Wrap(err, "invalid exercise in version content")
+				}
+				if dbExercise.Points > 0 {
+					if err := e.SetPoints(dbExercise.Points); err != nil {
+						return nil, errors.Wrap(err, "invalid exercise in version content")
+					}
+				}
+				e.SetPool(dbExercise.Pool)
+				exercises = append(exercises, e)
+			}
+			lessons = append(lessons, version.NewLessonContent(l, exercises))
+		}
+		modules = append(modules, version.NewModuleContent(m, lessons))
+	}
+
+	return version.UnmarshalVersionFromDatabase(dbVersion.CourseID, int(dbVersion.Version), modules, dbVersion.PublishedAt.Time)
+}
@@ -0,0 +1,173 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+type VersionRepositoryTest struct {
+	Name       string
+	Repository *VersionRepository
+}
+
+func TestVersionRepository(t *testing.T) {
+	t.Parallel()
+	rand.Seed(time.Now().UTC().UnixNano())
+
+	repositories := createVersionRepositories(t)
+
+	for i := range repositories {
+		r := repositories[i]
+
+		t.Run(r.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("CreateAndGet", func(t *testing.T) {
+				t.Parallel()
+				testVersionCreateAndGet(t, r.Repository)
+			})
+			t.Run("LatestNumber", func(t *testing.T) {
+				t.Parallel()
+				testVersionLatestNumber(t, r.Repository)
+			})
+			t.Run("Immutable", func(t *testing.T) {
+				t.Parallel()
+				testVersionImmutable(t, r.Repository)
+			})
+		})
+	}
+}
+
+func createVersionRepositories(t *testing.T) []VersionRepositoryTest {
+	return []VersionRepositoryTest{
+		{
+			Name:       "PostgreSQL",
+			Repository: newPostgreSQLVersionRepository(t),
+		},
+	}
+}
+
+func testVersionCreateAndGet(t *testing.T, repository *VersionRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	m, _ := module.NewModule(generateModuleID(), courseID, "Basics", 0)
+	l, _ := lesson.NewLesson(generateLessonID(), m.ID(), "Intro", "Overview", "Content", "video-1", 15, 0)
+	e, _ := exercise.NewExercise(generateID(), l.ID(), "2 + 2?", []string{"3", "4"}, "4", 0)
+	key, _ := exercise.NewAnswerKey(exercise.MultipleChoice, []string{"2", "3", "4"}, []string{"2", "3"}, 0, exercise.TextMatch{})
+	multi, _ := exercise.NewExerciseWithKey(generateID(), l.ID(), "Which are prime?", key, 1)
+
+	v, err := version.NewVersion(courseID, 1, []version.ModuleContent{
+		version.NewModuleContent(m, []version.LessonContent{
+			version.NewLessonContent(l, []*exercise.Exercise{e, multi}),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create version domain model: %v", err)
+	}
+
+	if err := repository.Create(ctx, v); err != nil {
+		t.Fatalf("failed to create version: %v", err)
+	}
+
+	retrieved, err := repository.Get(ctx, courseID, 1)
+	if err != nil {
+		t.Fatalf("failed to get version: %v", err)
+	}
+
+	if len(retrieved.Modules()) != 1 || len(retrieved.Modules()[0].Lessons()) != 1 {
+		t.Fatalf("expected 1 module with 1 lesson, got %+v", retrieved.Modules())
+	}
+	assertModuleEqual(t, m, retrieved.Modules()[0].Module())
+
+	lessonContent, err := retrieved.Lesson(l.ID())
+	if err != nil {
+		t.Fatalf("failed to find lesson in version: %v", err)
+	}
+	assertLessonEqual(t, l, lessonContent.Lesson())
+
+	if len(lessonContent.Exercises()) != 2 {
+		t.Fatalf("expected 2 exercises, got %d", len(lessonContent.Exercises()))
+	}
+	if !lessonContent.Exercises()[0].CheckAnswer("4") {
+		t.Error("expected the correct answer to survive the snapshot")
+	}
+	if lessonContent.Exercises()[1].Kind() != exercise.MultipleChoice || !lessonContent.Exercises()[1].CheckAnswer("3", "2") {
+		t.Error("expected the multiple-choice answer key to survive the snapshot")
+	}
+}
+
+func testVersionLatestNumber(t *testing.T, repository *VersionRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	latest, err := repository.LatestNumber(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get latest version: %v", err)
+	}
+	if latest != 0 {
+		t.Errorf("expected 0 for an unpublished course, got %d", latest)
+	}
+
+	for number := 1; number <= 2; number++ {
+		v, _ := version.NewVersion(courseID, number, nil)
+		if err := repository.Create(ctx, v); err != nil {
+			t.Fatalf("failed to create version: %v", err)
+		}
+	}
+
+	latest, err = repository.LatestNumber(ctx, courseID)
+	if err != nil {
+		t.Fatalf("failed to get latest version: %v", err)
+	}
+	if latest != 2 {
+		t.Errorf("expected latest version 2, got %d", latest)
+	}
+}
+
+func testVersionImmutable(t *testing.T, repository *VersionRepository) {
+	ctx := context.Background()
+	courseID := createTestCourse(t, ctx, repository.db)
+
+	v, _ := version.NewVersion(courseID, 1, nil)
+	if err := repository.Create(ctx, v); err != nil {
+		t.Fatalf("failed to create version: %v", err)
+	}
+
+	err := repository.Create(ctx, v)
+	if err == nil {
+		t.Fatal("expected error when publishing the same version twice, got nil")
+	}
+
+	var slugErr commonerrors.SlugError
+	if !errors.As(err, &slugErr) || slugErr.Slug() != "version-already-published" {
+		t.Errorf("expected 'version-already-published' error, got %v", err)
+	}
+}
+
+func newPostgreSQLVersionRepository(t *testing.T) *VersionRepository {
+	// Setup testcontainer for PostgreSQL
+	container, cleanup := SetupTestDatabase(t)
+	t.Cleanup(cleanup)
+
+	pool, err := pgxpool.New(context.Background(), container.ConnectionString)
+	if err != nil {
+		t.Fatalf("unable to create connection pool: %v", err)
+	}
+
+	if err := pool.Ping(context.Background()); err != nil {
+		t.Fatalf("unable to ping database: %v", err)
+	}
+
+	return NewVersionRepository(pool)
+}
@@ -2,8 +2,17 @@ package app
 
 import (
 	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/bank_command"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/exercise_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/lesson_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/module_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/bank_query"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/exercise_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/lesson_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/module_query"
 )
 
 type Application struct {
@@ -16,10 +25,85 @@ type Commands struct {
 	CreateCourse course_command.CreateCourseHandler
 	DeleteCourse course_command.DeleteCourseHandler
 	UpdateCourse course_command.UpdateCourseHandler
+
+	ChangeCourseStatus   course_command.ChangeCourseStatusHandler
+	PublishCourseVersion course_command.PublishCourseVersionHandler
+
+	ImportCourse course_command.ImportCourseHandler
+	CloneCourse  course_command.CloneCourseHandler
+
+	SetCoursePrerequisites   course_command.SetCoursePrerequisitesHandler
+	GrantPrerequisiteWaiver  course_command.GrantPrerequisiteWaiverHandler
+	RevokePrerequisiteWaiver course_command.RevokePrerequisiteWaiverHandler
+
+	CreateModule     module_command.CreateModuleHandler
+	UpdateModule     module_command.UpdateModuleHandler
+	DeleteModule     module_command.DeleteModuleHandler
+	ReorderModules   module_command.ReorderModulesHandler
+	SetModuleRelease module_command.SetModuleReleaseHandler
+
+	CreateLesson           lesson_command.CreateLessonHandler
+	UpdateLesson           lesson_command.UpdateLessonHandler
+	DeleteLesson           lesson_command.DeleteLessonHandler
+	ReorderLessons         lesson_command.ReorderLessonsHandler
+	SetLessonGate          lesson_command.SetLessonGateHandler
+	SetLessonBankQuestions lesson_command.SetLessonBankQuestionsHandler
+
+	CreateExercise   exercise_command.CreateExerciseHandler
+	UpdateExercise   exercise_command.UpdateExerciseHandler
+	DeleteExercise   exercise_command.DeleteExerciseHandler
+	ReorderExercises exercise_command.ReorderExercisesHandler
+
+	CreateQuestionBank bank_command.CreateQuestionBankHandler
+	CreateBankQuestion bank_command.CreateBankQuestionHandler
+	UpdateBankQuestion bank_command.UpdateBankQuestionHandler
+	DeleteBankQuestion bank_command.DeleteBankQuestionHandler
+
+	EnrollInCourse     command.EnrollInCourseHandler
+	UnenrollFromCourse command.UnenrollFromCourseHandler
+	CompleteLesson     command.CompleteLessonHandler
+
+	UpgradeEnrollmentContent command.UpgradeEnrollmentContentHandler
+
+	SubmitLessonAnswers command.SubmitLessonAnswersHandler
+
+	StartQuizSession  command.StartQuizSessionHandler
+	SubmitQuizSession command.SubmitQuizSessionHandler
+
+	PostReview command.PostReviewHandler
+	EditReview command.EditReviewHandler
 }
 
 type Queries struct {
 	GetAllCourses    course_query.GetAllCoursesHandler
 	GetCourseDetails course_query.GetCourseDetailsHandler
 	CoursesByTeacher course_query.CourseByTeacherHandler
+	SearchCourses    course_query.SearchCoursesHandler
+	GetCourseVersion course_query.GetCourseVersionHandler
+	ExportCourse     course_query.ExportCourseHandler
+	GetCourseOutline course_query.GetCourseOutlineHandler
+
+	GetModule       module_query.GetModuleHandler
+	ModulesByCourse module_query.ModulesByCourseHandler
+
+	GetLesson       lesson_query.GetLessonHandler
+	LessonsByModule lesson_query.LessonsByModuleHandler
+
+	ExercisesByLesson exercise_query.ExercisesByLessonHandler
+
+	BanksByTeacher  bank_query.BanksByTeacherHandler
+	GetQuestionBank bank_query.GetQuestionBankHandler
+	BankQuestions   bank_query.BankQuestionsHandler
+
+	GetMyEnrollments     query.GetMyEnrollmentsHandler
+	GetEnrollmentContent query.GetEnrollmentContentHandler
+	GetMyCourseOutline   query.GetMyCourseOutlineHandler
+
+	GetAttempt        query.GetAttemptHandler
+	GetLessonAttempts query.GetLessonAttemptsHandler
+
+	GetQuizSession query.GetQuizSessionHandler
+
+	GetReview        query.GetReviewHandler
+	GetCourseReviews query.GetCourseReviewsHandler
 }
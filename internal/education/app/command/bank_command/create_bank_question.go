@@ -0,0 +1,102 @@
+package bank_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type CreateBankQuestion struct {
+	Actor      policy.Actor
+	QuestionID string
+	BankID     string
+	Question   string
+	// Kind defaults to single choice
+	Kind    string
+	Answers []string
+	// CorrectAnswers holds the one correct answer of single choice,
+	// true/false and numeric questions, and every correct answer, accepted
+	// answer or item in order for the other kinds
+	CorrectAnswers []string
+	Tolerance      float64
+	TextMatch      string
+	// Points weighs the question in the score of the lessons asking it, zero
+	// for the default weight
+	Points     int
+	Topics     []string
+	Difficulty string
+}
+
+type CreateBankQuestionHandler decorator.CommandHandler[CreateBankQuestion]
+
+type createBankQuestionHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewCreateBankQuestionHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CreateBankQuestionHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		createBankQuestionHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h createBankQuestionHandler) Handle(ctx context.Context, cmd CreateBankQuestion) error {
+	// Validate input
+	if cmd.BankID == "" {
+		return commonerrors.NewIncorrectInputError("bank ID is required", "bank-id-required")
+	}
+	key, err := exercise.ParseAnswerKey(cmd.Kind, cmd.Answers, cmd.CorrectAnswers, cmd.Tolerance, cmd.TextMatch)
+	if err != nil {
+		return err
+	}
+	difficulty, err := bank.NewDifficultyFromString(cmd.Difficulty)
+	if err != nil {
+		return commonerrors.NewValidationError("invalid-bank-question", commonerrors.NewFieldError("difficulty", err.Error()))
+	}
+
+	// Get existing bank
+	existingBank, err := h.bankRepository.Get(ctx, cmd.BankID)
+	if err != nil {
+		return errors.Wrap(err, "question bank not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageBank(cmd.Actor, existingBank); err != nil {
+		return err
+	}
+
+	// Create question entity
+	newQuestion, err := bank.NewQuestion(cmd.QuestionID, cmd.BankID, cmd.Question, key, cmd.Topics, difficulty)
+	if err != nil {
+		return errors.Wrap(err, "failed to create bank question entity")
+	}
+	if cmd.Points != 0 {
+		if err := newQuestion.SetPoints(cmd.Points); err != nil {
+			return err
+		}
+	}
+
+	// Persist to repository
+	if err := h.bankRepository.CreateQuestion(ctx, newQuestion); err != nil {
+		return errors.Wrap(err, "failed to save bank question")
+	}
+
+	return nil
+}
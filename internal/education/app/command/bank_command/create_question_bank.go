@@ -0,0 +1,73 @@
+package bank_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateQuestionBank creates a bank the teacher collects questions in, to
+// reuse them across the lessons of all their courses.
+type CreateQuestionBank struct {
+	Actor     policy.Actor
+	BankID    string
+	TeacherID string
+	Title     string
+}
+
+type CreateQuestionBankHandler decorator.CommandHandler[CreateQuestionBank]
+
+type createQuestionBankHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewCreateQuestionBankHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CreateQuestionBankHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		createQuestionBankHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h createQuestionBankHandler) Handle(ctx context.Context, cmd CreateQuestionBank) error {
+	// Validate input
+	if cmd.TeacherID == "" {
+		return commonerrors.NewIncorrectInputError("teacher ID is required", "teacher-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanCreateQuestionBank(cmd.Actor); err != nil {
+		return err
+	}
+	if err := policy.CanActFor(cmd.Actor, cmd.TeacherID); err != nil {
+		return err
+	}
+
+	// Create bank entity
+	newBank, err := bank.NewBank(cmd.BankID, cmd.TeacherID, cmd.Title)
+	if err != nil {
+		return errors.Wrap(err, "failed to create question bank entity")
+	}
+
+	// Persist to repository
+	if err := h.bankRepository.Create(ctx, newBank); err != nil {
+		return errors.Wrap(err, "failed to save question bank")
+	}
+
+	return nil
+}
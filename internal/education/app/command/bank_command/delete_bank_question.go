@@ -0,0 +1,72 @@
+package bank_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteBankQuestion removes a question from its bank. Questions a lesson
+// refers to directly have to be removed from the lesson first.
+type DeleteBankQuestion struct {
+	Actor      policy.Actor
+	QuestionID string
+}
+
+type DeleteBankQuestionHandler decorator.CommandHandler[DeleteBankQuestion]
+
+type deleteBankQuestionHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewDeleteBankQuestionHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) DeleteBankQuestionHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		deleteBankQuestionHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h deleteBankQuestionHandler) Handle(ctx context.Context, cmd DeleteBankQuestion) error {
+	// Validate input
+	if cmd.QuestionID == "" {
+		return commonerrors.NewIncorrectInputError("question ID is required", "question-id-required")
+	}
+
+	// Get existing question and its bank
+	q, err := h.bankRepository.GetQuestion(ctx, cmd.QuestionID)
+	if err != nil {
+		return errors.Wrap(err, "bank question not found")
+	}
+	existingBank, err := h.bankRepository.Get(ctx, q.BankID())
+	if err != nil {
+		return errors.Wrap(err, "question bank not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageBank(cmd.Actor, existingBank); err != nil {
+		return err
+	}
+
+	// Delete question
+	if err := h.bankRepository.DeleteQuestion(ctx, cmd.QuestionID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package bank_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateBankQuestion edits a question of a bank. Lessons refer to the
+// question, so the edit shows in every lesson asking it from the next
+// published version on; versions students are enrolled on keep their copy.
+type UpdateBankQuestion struct {
+	Actor      policy.Actor
+	QuestionID string
+	Question   string
+	// Kind defaults to single choice
+	Kind    string
+	Answers []string
+	// CorrectAnswers holds the one correct answer of single choice,
+	// true/false and numeric questions, and every correct answer, accepted
+	// answer or item in order for the other kinds
+	CorrectAnswers []string
+	Tolerance      float64
+	TextMatch      string
+	// Points weighs the question in the score of the lessons asking it, zero
+	// for the default weight
+	Points     int
+	Topics     []string
+	Difficulty string
+}
+
+type UpdateBankQuestionHandler decorator.CommandHandler[UpdateBankQuestion]
+
+type updateBankQuestionHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewUpdateBankQuestionHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UpdateBankQuestionHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		updateBankQuestionHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateBankQuestionHandler) Handle(ctx context.Context, cmd UpdateBankQuestion) error {
+	// Validate input
+	if cmd.QuestionID == "" {
+		return commonerrors.NewIncorrectInputError("question ID is required", "question-id-required")
+	}
+	key, err := exercise.ParseAnswerKey(cmd.Kind, cmd.Answers, cmd.CorrectAnswers, cmd.Tolerance, cmd.TextMatch)
+	if err != nil {
+		return err
+	}
+	difficulty, err := bank.NewDifficultyFromString(cmd.Difficulty)
+	if err != nil {
+		return commonerrors.NewValidationError("invalid-bank-question", commonerrors.NewFieldError("difficulty", err.Error()))
+	}
+
+	// Get existing question and its bank
+	q, err := h.bankRepository.GetQuestion(ctx, cmd.QuestionID)
+	if err != nil {
+		return errors.Wrap(err, "bank question not found")
+	}
+	existingBank, err := h.bankRepository.Get(ctx, q.BankID())
+	if err != nil {
+		return errors.Wrap(err, "question bank not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageBank(cmd.Actor, existingBank); err != nil {
+		return err
+	}
+
+	// Apply changes
+	if err := q.Update(cmd.Question, key); err != nil {
+		return errors.Wrap(err, "invalid question")
+	}
+	if err := q.Tag(cmd.Topics, difficulty); err != nil {
+		return errors.Wrap(err, "invalid tags")
+	}
+	points := cmd.Points
+	if points == 0 {
+		points = exercise.DefaultPoints
+	}
+	if err := q.SetPoints(points); err != nil {
+		return errors.Wrap(err, "invalid points")
+	}
+
+	// Persist to repository
+	if err := h.bankRepository.UpdateQuestion(ctx, q); err != nil {
+		return errors.Wrap(err, "failed to update bank question")
+	}
+
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type CompleteLesson struct {
+	Actor    policy.Actor
 	UserID   string
 	CourseID string
 	LessonID string
@@ -19,20 +23,31 @@ type CompleteLessonHandler decorator.CommandHandler[CompleteLesson]
 
 type completeLessonHandler struct {
 	enrollmentRepository enrollment.EnrollmentRepository
+	versionRepository    version.VersionRepository
+	weightByDuration     bool
 }
 
+// NewCompleteLessonHandler creates the handler. When weightByDuration is set,
+// progress is weighted by lesson duration instead of lesson count.
 func NewCompleteLessonHandler(
 	enrollmentRepository enrollment.EnrollmentRepository,
+	versionRepository version.VersionRepository,
+	weightByDuration bool,
 	logger *logrus.Entry,
 	metricsClient decorator.MetricsClient,
 ) CompleteLessonHandler {
 	if enrollmentRepository == nil {
 		panic("enrollment repository is required")
 	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
 
 	return decorator.ApplyCommandDecorators(
 		completeLessonHandler{
 			enrollmentRepository: enrollmentRepository,
+			versionRepository:    versionRepository,
+			weightByDuration:     weightByDuration,
 		},
 		logger,
 		metricsClient,
@@ -42,13 +57,18 @@ func NewCompleteLessonHandler(
 func (h completeLessonHandler) Handle(ctx context.Context, cmd CompleteLesson) error {
 	// Validate input
 	if cmd.UserID == "" {
-		return errors.New("user ID is required")
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
 	}
 	if cmd.CourseID == "" {
-		return errors.New("course ID is required")
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
 	}
 	if cmd.LessonID == "" {
-		return errors.New("lesson ID is required")
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
 	}
 
 	// Get enrollment
@@ -57,13 +77,36 @@ func (h completeLessonHandler) Handle(ctx context.Context, cmd CompleteLesson) e
 		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
 	}
 
-	// Mark lesson as completed
-	if err := enroll.CompleteLesson(cmd.LessonID); err != nil {
-		return errors.Wrap(err, "failed to complete lesson")
+	// Progress follows the content version the student is on
+	pinned, err := h.versionRepository.Get(ctx, enroll.CourseID(), enroll.ContentVersion())
+	if err != nil {
+		return errors.Wrap(err, "failed to get course content")
 	}
+	outline := courseOutline(pinned, h.weightByDuration)
+
+	// Persist to repository. The checks run on the locked enrollment, so a
+	// concurrent attempt cannot be overwritten by the completion.
+	if err := h.enrollmentRepository.Update(ctx, enroll.ID(), func(e *enrollment.Enrollment) error {
+		if e.ContentVersion() != enroll.ContentVersion() {
+			return commonerrors.NewConflictError("enrollment moved to another content version", "enrollment-content-changed")
+		}
+
+		// Locked lessons cannot be completed until their gate is met
+		if err := e.CheckLessonUnlocked(cmd.LessonID, outline); err != nil {
+			return err
+		}
+
+		// Lessons with a pass score only complete once it is reached
+		if err := e.CheckLessonPassed(cmd.LessonID, outline); err != nil {
+			return err
+		}
 
-	// Update enrollment
-	if err := h.enrollmentRepository.Update(ctx, enroll); err != nil {
+		// Mark lesson as completed and roll progress up to module and course
+		if err := e.CompleteLesson(cmd.LessonID, outline); err != nil {
+			return commonerrors.NewIncorrectInputError(err.Error(), "lesson-not-completable")
+		}
+		return nil
+	}); err != nil {
 		return errors.Wrap(err, "failed to update enrollment")
 	}
 
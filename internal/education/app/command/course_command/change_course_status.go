@@ -0,0 +1,70 @@
+package course_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type ChangeCourseStatus struct {
+	Actor    policy.Actor
+	CourseID string
+	Status   string
+}
+
+type ChangeCourseStatusHandler decorator.CommandHandler[ChangeCourseStatus]
+
+type changeCourseStatusHandler struct {
+	courseRepository course.CourseRepository
+}
+
+// NewChangeCourseStatusHandler creates the handler. Publishing a course
+// freezes its content as the first version students enroll on.
+func NewChangeCourseStatusHandler(
+	courseRepository course.CourseRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ChangeCourseStatusHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		changeCourseStatusHandler{
+			courseRepository: courseRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h changeCourseStatusHandler) Handle(ctx context.Context, cmd ChangeCourseStatus) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	status, err := course.NewCourseStatusFromString(cmd.Status)
+	if err != nil {
+		return commonerrors.NewValidationError("invalid-course-status", commonerrors.NewFieldError("status", err.Error()))
+	}
+
+	// Persist to repository. The content students will enroll on is frozen
+	// in the same transaction when the course gets published.
+	if err := h.courseRepository.UpdateStatus(ctx, cmd.CourseID, func(c *course.Course) error {
+		// Authorize actor
+		if err := policy.CanChangeCourseStatus(cmd.Actor, c, status); err != nil {
+			return err
+		}
+
+		return c.ChangeStatus(status)
+	}); err != nil {
+		return errors.Wrap(err, "failed to update course status")
+	}
+
+	return nil
+}
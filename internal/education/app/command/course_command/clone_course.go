@@ -0,0 +1,82 @@
+package course_command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CloneCourse copies a course with all its modules, lessons and exercises
+// into a new draft owned by the acting teacher.
+type CloneCourse struct {
+	Actor          policy.Actor
+	SourceCourseID string
+	CourseID       string
+}
+
+type CloneCourseHandler decorator.CommandHandler[CloneCourse]
+
+type cloneCourseHandler struct {
+	bundleRepository bundle.BundleRepository
+}
+
+func NewCloneCourseHandler(
+	bundleRepository bundle.BundleRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CloneCourseHandler {
+	if bundleRepository == nil {
+		panic("bundle repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		cloneCourseHandler{
+			bundleRepository: bundleRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h cloneCourseHandler) Handle(ctx context.Context, cmd CloneCourse) error {
+	// Validate input
+	if cmd.SourceCourseID == "" {
+		return commonerrors.NewIncorrectInputError("source course ID is required", "course-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanCreateCourse(cmd.Actor); err != nil {
+		return err
+	}
+
+	source, err := h.bundleRepository.Get(ctx, cmd.SourceCourseID)
+	if err != nil {
+		return errors.Wrap(err, "course not found")
+	}
+
+	// The copy carries the correct answers, so only the owner may clone a course
+	if err := policy.CanManageCourse(cmd.Actor, source.Course()); err != nil {
+		return err
+	}
+
+	clone, err := source.Clone(cmd.CourseID, cmd.Actor.UserID, func() string { return uuid.New().String() })
+	if err != nil {
+		return errors.Wrap(err, "failed to clone course")
+	}
+
+	// Persist to repository, all or nothing
+	if err := h.bundleRepository.Create(ctx, clone); err != nil {
+		return errors.Wrap(err, "failed to save cloned course")
+	}
+
+	return nil
+}
@@ -5,20 +5,21 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type CreateCourse struct {
+	Actor       policy.Actor
 	TeacherID   string
 	Title       string
 	Description string
 	Thumbnail   string
-	Duration    int
 	Domain      string
 	Tags        []string
-	Rating      float64
 	Level       string
 }
 
@@ -49,33 +50,42 @@ func NewCreateCourseHandler(
 func (h createCourseHandler) Handle(ctx context.Context, cmd CreateCourse) error {
 	// Validate input
 	if cmd.TeacherID == "" {
-		return errors.New("teacher ID is required")
+		return commonerrors.NewIncorrectInputError("teacher ID is required", "teacher-id-required")
 	}
 	if cmd.Title == "" {
-		return errors.New("course title is required")
+		return commonerrors.NewIncorrectInputError("course title is required", "course-title-required")
 	}
 
-	// Parse domain
+	// Authorize actor
+	if err := policy.CanCreateCourse(cmd.Actor); err != nil {
+		return err
+	}
+	if err := policy.CanActFor(cmd.Actor, cmd.TeacherID); err != nil {
+		return err
+	}
+
+	// Parse domain, level and tags, reporting every invalid value at once
+	var v commonerrors.Validation
 	domain, err := course.NewDomainFromString(cmd.Domain)
 	if err != nil {
-		return errors.Wrap(err, "invalid domain")
+		v.Add("domain", err.Error())
 	}
-
-	// Parse level
 	level, err := course.NewCourseLevelFromString(cmd.Level)
 	if err != nil {
-		return errors.Wrap(err, "invalid level")
+		v.Add("level", err.Error())
 	}
-
-	// Parse tags
 	tags := make([]course.Tag, 0, len(cmd.Tags))
 	for _, tagStr := range cmd.Tags {
 		tag, err := course.NewTagFromString(tagStr)
 		if err != nil {
-			return errors.Wrapf(err, "invalid tag: %s", tagStr)
+			v.Add("tags", err.Error())
+			continue
 		}
 		tags = append(tags, tag)
 	}
+	if err := v.Err("invalid-course"); err != nil {
+		return err
+	}
 
 	// Create course entity
 	newCourse, err := course.NewCourse(
@@ -84,10 +94,9 @@ func (h createCourseHandler) Handle(ctx context.Context, cmd CreateCourse) error
 		cmd.Title,
 		cmd.Description,
 		cmd.Thumbnail,
-		cmd.Duration,
 		domain,
 		tags,
-		cmd.Rating,
+		0, // rated by students through reviews
 		level,
 	)
 	if err != nil {
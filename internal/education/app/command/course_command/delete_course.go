@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type DeleteCourse struct {
+	Actor    policy.Actor
 	CourseID string
 }
 
@@ -40,16 +43,18 @@ func NewDeleteCourseHandler(
 func (h deleteCourseHandler) Handle(ctx context.Context, cmd DeleteCourse) error {
 	// Validate input
 	if cmd.CourseID == "" {
-		return errors.New("course ID is required")
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
 	}
 
-	// Check if course exists
-	exists, err := h.courseRepository.Exists(ctx, cmd.CourseID)
+	// Get existing course
+	existingCourse, err := h.courseRepository.Get(ctx, cmd.CourseID)
 	if err != nil {
-		return errors.Wrap(err, "failed to check course existence")
+		return errors.Wrap(err, "course not found")
 	}
-	if !exists {
-		return errors.New("course not found")
+
+	// Authorize actor
+	if err := policy.CanManageCourse(cmd.Actor, existingCourse); err != nil {
+		return err
 	}
 
 	// Delete course (CASCADE will delete all modules, lessons, and exercises)
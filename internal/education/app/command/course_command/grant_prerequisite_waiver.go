@@ -0,0 +1,93 @@
+package course_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/user"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// GrantPrerequisiteWaiver lets a student enroll in a course without having
+// completed its required prerequisites
+type GrantPrerequisiteWaiver struct {
+	Actor    policy.Actor
+	CourseID string
+	UserID   string
+}
+
+type GrantPrerequisiteWaiverHandler decorator.CommandHandler[GrantPrerequisiteWaiver]
+
+type grantPrerequisiteWaiverHandler struct {
+	courseRepository course.CourseRepository
+	waiverRepository course.PrerequisiteWaiverRepository
+	userRepository   user.UserRepository
+}
+
+func NewGrantPrerequisiteWaiverHandler(
+	courseRepository course.CourseRepository,
+	waiverRepository course.PrerequisiteWaiverRepository,
+	userRepository user.UserRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GrantPrerequisiteWaiverHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if waiverRepository == nil {
+		panic("prerequisite waiver repository is required")
+	}
+	if userRepository == nil {
+		panic("user repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		grantPrerequisiteWaiverHandler{
+			courseRepository: courseRepository,
+			waiverRepository: waiverRepository,
+			userRepository:   userRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h grantPrerequisiteWaiverHandler) Handle(ctx context.Context, cmd GrantPrerequisiteWaiver) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+
+	c, err := h.courseRepository.Get(ctx, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "course not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageCourse(cmd.Actor, c); err != nil {
+		return err
+	}
+
+	if _, err := h.userRepository.Get(ctx, cmd.UserID); err != nil {
+		return errors.Wrap(err, "user not found")
+	}
+
+	waiver, err := course.NewPrerequisiteWaiver(cmd.CourseID, cmd.UserID, cmd.Actor.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Persist to repository
+	if err := h.waiverRepository.GrantWaiver(ctx, waiver); err != nil {
+		return errors.Wrap(err, "failed to grant prerequisite waiver")
+	}
+
+	return nil
+}
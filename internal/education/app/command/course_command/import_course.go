@@ -0,0 +1,299 @@
+package course_command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportCourse creates a new draft course from an exported bundle. Every
+// module, lesson and exercise gets a fresh ID, so a bundle can be imported
+// any number of times.
+type ImportCourse struct {
+	Actor         policy.Actor
+	CourseID      string
+	TeacherID     string
+	FormatVersion int
+	Title         string
+	Description   string
+	Thumbnail     string
+	Domain        string
+	Level         string
+	Tags          []string
+	Modules       []ImportedModule
+}
+
+type ImportedModule struct {
+	Title string
+	Order int
+	// DaysAfterEnrollment and Date schedule the release of the module, both
+	// zero open it on enrollment
+	DaysAfterEnrollment int
+	Date                time.Time
+	Lessons             []ImportedLesson
+}
+
+type ImportedLesson struct {
+	// ID identifies the lesson within the bundle, score gates point at it
+	ID          string
+	Title       string
+	Overview    string
+	Content     string
+	VideoID     string
+	Duration    int
+	Order       int
+	PassScore   float64
+	DrawCount   int
+	TimeLimit   int
+	MaxAttempts int
+	// RequirePrevious, ScoreLessonID and MinScore make up the gate of the lesson
+	RequirePrevious bool
+	ScoreLessonID   string
+	MinScore        float64
+	Exercises       []ImportedExercise
+}
+
+type ImportedExercise struct {
+	Question       string
+	Kind           string
+	Answers        []string
+	CorrectAnswers []string
+	Tolerance      float64
+	TextMatch      string
+	Order          int
+	Points         int
+}
+
+type ImportCourseHandler decorator.CommandHandler[ImportCourse]
+
+type importCourseHandler struct {
+	bundleRepository bundle.BundleRepository
+}
+
+func NewImportCourseHandler(
+	bundleRepository bundle.BundleRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ImportCourseHandler {
+	if bundleRepository == nil {
+		panic("bundle repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		importCourseHandler{
+			bundleRepository: bundleRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h importCourseHandler) Handle(ctx context.Context, cmd ImportCourse) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.FormatVersion != bundle.FormatVersion {
+		return commonerrors.NewValidationError("unsupported-bundle-format", commonerrors.NewFieldError(
+			"formatVersion",
+			fmt.Sprintf("bundle format %d is not supported, expected %d", cmd.FormatVersion, bundle.FormatVersion),
+		))
+	}
+
+	// Authorize actor
+	if err := policy.CanCreateCourse(cmd.Actor); err != nil {
+		return err
+	}
+	if err := policy.CanActFor(cmd.Actor, cmd.TeacherID); err != nil {
+		return err
+	}
+
+	// Build the whole course through the domain constructors, reporting every
+	// invalid field of the bundle at once
+	var v commonerrors.Validation
+	newCourse := buildImportedCourse(&v, cmd)
+
+	// Score gates point at bundle lessons, they are remapped once every
+	// lesson has its new ID
+	importedIDs := make(map[string]string)
+	var gates []importedGate
+
+	moduleOrders := make(map[int]bool, len(cmd.Modules))
+	modules := make([]version.ModuleContent, 0, len(cmd.Modules))
+	for i, im := range cmd.Modules {
+		modulePath := fmt.Sprintf("modules[%d]", i)
+		checkImportedOrder(&v, modulePath, im.Order, moduleOrders)
+
+		newModule, err := module.NewModule(uuid.New().String(), cmd.CourseID, im.Title, im.Order)
+		addFieldErrors(&v, modulePath, err)
+		release, err := module.NewRelease(im.DaysAfterEnrollment, im.Date)
+		addFieldErrors(&v, modulePath+".release", err)
+		if newModule != nil {
+			newModule.SetRelease(release)
+		}
+
+		lessonOrders := make(map[int]bool, len(im.Lessons))
+		lessons := make([]version.LessonContent, 0, len(im.Lessons))
+		for j, il := range im.Lessons {
+			lessonPath := fmt.Sprintf("%s.lessons[%d]", modulePath, j)
+			checkImportedOrder(&v, lessonPath, il.Order, lessonOrders)
+
+			lessonID := uuid.New().String()
+			moduleID := ""
+			if newModule != nil {
+				moduleID = newModule.ID()
+			}
+			newLesson, err := lesson.NewLesson(lessonID, moduleID, il.Title, il.Overview, il.Content, il.VideoID, il.Duration, il.Order)
+			addFieldErrors(&v, lessonPath, err)
+			if newLesson != nil {
+				addFieldErrors(&v, lessonPath, newLesson.SetPassScore(il.PassScore))
+				addFieldErrors(&v, lessonPath, newLesson.SetDrawCount(il.DrawCount))
+				addFieldErrors(&v, lessonPath, newLesson.SetTimeLimit(il.TimeLimit))
+				addFieldErrors(&v, lessonPath, newLesson.SetMaxAttempts(il.MaxAttempts))
+				if il.RequirePrevious || il.ScoreLessonID != "" || il.MinScore != 0 {
+					gates = append(gates, importedGate{path: lessonPath + ".gate", lesson: newLesson, imported: il})
+				}
+			}
+			if il.ID != "" {
+				if _, ok := importedIDs[il.ID]; ok {
+					v.Add(lessonPath+".id", fmt.Sprintf("more than one lesson has ID '%s'", il.ID))
+				}
+				importedIDs[il.ID] = lessonID
+			}
+
+			exerciseOrders := make(map[int]bool, len(il.Exercises))
+			exercises := make([]*exercise.Exercise, 0, len(il.Exercises))
+			for k, ie := range il.Exercises {
+				exercisePath := fmt.Sprintf("%s.exercises[%d]", lessonPath, k)
+				checkImportedOrder(&v, exercisePath, ie.Order, exerciseOrders)
+
+				key, err := exercise.ParseAnswerKey(ie.Kind, ie.Answers, ie.CorrectAnswers, ie.Tolerance, ie.TextMatch)
+				if err != nil {
+					addFieldErrors(&v, exercisePath, err)
+					continue
+				}
+				newExercise, err := exercise.NewExerciseWithKey(uuid.New().String(), lessonID, ie.Question, key, ie.Order)
+				addFieldErrors(&v, exercisePath, err)
+				if newExercise != nil && ie.Points != 0 {
+					addFieldErrors(&v, exercisePath, newExercise.SetPoints(ie.Points))
+				}
+				exercises = append(exercises, newExercise)
+			}
+			lessons = append(lessons, version.NewLessonContent(newLesson, exercises))
+		}
+		modules = append(modules, version.NewModuleContent(newModule, lessons))
+	}
+	for _, g := range gates {
+		// A score rule on a lesson outside the bundle has nothing to point at
+		gate, err := lesson.NewGate(g.imported.RequirePrevious, "", 0)
+		if scoreLessonID, ok := importedIDs[g.imported.ScoreLessonID]; ok {
+			gate, err = lesson.NewGate(g.imported.RequirePrevious, scoreLessonID, g.imported.MinScore)
+		}
+		if err != nil {
+			addFieldErrors(&v, g.path, err)
+			continue
+		}
+		addFieldErrors(&v, g.path, g.lesson.SetGate(gate))
+	}
+	if err := v.Err("invalid-bundle"); err != nil {
+		return err
+	}
+
+	b, err := bundle.NewBundle(newCourse, modules)
+	if err != nil {
+		return errors.Wrap(err, "failed to create course bundle")
+	}
+
+	// Persist to repository, all or nothing
+	if err := h.bundleRepository.Create(ctx, b); err != nil {
+		return errors.Wrap(err, "failed to save imported course")
+	}
+
+	return nil
+}
+
+// importedGate is the gate of an imported lesson waiting for the lessons of
+// the bundle to get their new IDs
+type importedGate struct {
+	path     string
+	lesson   *lesson.Lesson
+	imported ImportedLesson
+}
+
+func buildImportedCourse(v *commonerrors.Validation, cmd ImportCourse) *course.Course {
+	domain, err := course.NewDomainFromString(cmd.Domain)
+	if err != nil {
+		v.Add("course.domain", err.Error())
+	}
+	level, err := course.NewCourseLevelFromString(cmd.Level)
+	if err != nil {
+		v.Add("course.level", err.Error())
+	}
+	tags := make([]course.Tag, 0, len(cmd.Tags))
+	for _, tagStr := range cmd.Tags {
+		tag, err := course.NewTagFromString(tagStr)
+		if err != nil {
+			v.Add("course.tags", err.Error())
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	newCourse, err := course.NewCourse(
+		cmd.CourseID,
+		cmd.TeacherID,
+		cmd.Title,
+		cmd.Description,
+		cmd.Thumbnail,
+		domain,
+		tags,
+		0, // rated by students through reviews
+		level,
+	)
+	addFieldErrors(v, "course", err)
+
+	return newCourse
+}
+
+// checkImportedOrder rejects negative orders and orders used twice among siblings
+func checkImportedOrder(v *commonerrors.Validation, path string, order int, used map[int]bool) {
+	if order < 0 {
+		v.Add(path+".order", "order cannot be negative")
+		return
+	}
+	if used[order] {
+		v.Add(path+".order", fmt.Sprintf("more than one item has order %d", order))
+		return
+	}
+	used[order] = true
+}
+
+// addFieldErrors records the fields a domain constructor rejected under the
+// path of the bundled entity
+func addFieldErrors(v *commonerrors.Validation, path string, err error) {
+	if err == nil {
+		return
+	}
+
+	var slugErr commonerrors.SlugError
+	if errors.As(err, &slugErr) && len(slugErr.Fields()) > 0 {
+		for _, f := range slugErr.Fields() {
+			v.Add(path+"."+f.Field(), f.Message())
+		}
+		return
+	}
+	v.Add(path, err.Error())
+}
@@ -0,0 +1,70 @@
+package course_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// PublishCourseVersion freezes the edits made to the working copy of a live
+// course into a new version. Students already enrolled stay on their version
+// until they upgrade, new enrollments start on the new one.
+type PublishCourseVersion struct {
+	Actor    policy.Actor
+	CourseID string
+}
+
+type PublishCourseVersionHandler decorator.CommandHandler[PublishCourseVersion]
+
+type publishCourseVersionHandler struct {
+	courseRepository course.CourseRepository
+}
+
+func NewPublishCourseVersionHandler(
+	courseRepository course.CourseRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) PublishCourseVersionHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		publishCourseVersionHandler{
+			courseRepository: courseRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h publishCourseVersionHandler) Handle(ctx context.Context, cmd PublishCourseVersion) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Persist to repository. The status of a published course is left as it
+	// is, and saving it freezes the working copy as the next version.
+	if err := h.courseRepository.UpdateStatus(ctx, cmd.CourseID, func(c *course.Course) error {
+		// Authorize actor
+		if err := policy.CanManageCourse(cmd.Actor, c); err != nil {
+			return err
+		}
+
+		// The first version is frozen when an admin publishes the course
+		if !c.IsPublished() {
+			return commonerrors.NewConflictError("only published courses can publish new versions", "course-not-published")
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to publish course version")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package course_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// RevokePrerequisiteWaiver removes the waiver of a student. Enrollments
+// made while the waiver was in place are kept.
+type RevokePrerequisiteWaiver struct {
+	Actor    policy.Actor
+	CourseID string
+	UserID   string
+}
+
+type RevokePrerequisiteWaiverHandler decorator.CommandHandler[RevokePrerequisiteWaiver]
+
+type revokePrerequisiteWaiverHandler struct {
+	courseRepository course.CourseRepository
+	waiverRepository course.PrerequisiteWaiverRepository
+}
+
+func NewRevokePrerequisiteWaiverHandler(
+	courseRepository course.CourseRepository,
+	waiverRepository course.PrerequisiteWaiverRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) RevokePrerequisiteWaiverHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if waiverRepository == nil {
+		panic("prerequisite waiver repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		revokePrerequisiteWaiverHandler{
+			courseRepository: courseRepository,
+			waiverRepository: waiverRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h revokePrerequisiteWaiverHandler) Handle(ctx context.Context, cmd RevokePrerequisiteWaiver) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+
+	c, err := h.courseRepository.Get(ctx, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "course not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageCourse(cmd.Actor, c); err != nil {
+		return err
+	}
+
+	// Persist to repository
+	if err := h.waiverRepository.RevokeWaiver(ctx, cmd.CourseID, cmd.UserID); err != nil {
+		return errors.Wrap(err, "failed to revoke prerequisite waiver")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package course_command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SetCoursePrerequisites replaces the courses students should take before
+// the course. An empty list removes every prerequisite.
+type SetCoursePrerequisites struct {
+	Actor         policy.Actor
+	CourseID      string
+	Prerequisites []CoursePrerequisite
+}
+
+type CoursePrerequisite struct {
+	CourseID string
+	Required bool
+}
+
+type SetCoursePrerequisitesHandler decorator.CommandHandler[SetCoursePrerequisites]
+
+type setCoursePrerequisitesHandler struct {
+	courseRepository course.CourseRepository
+}
+
+func NewSetCoursePrerequisitesHandler(
+	courseRepository course.CourseRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SetCoursePrerequisitesHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		setCoursePrerequisitesHandler{courseRepository: courseRepository},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h setCoursePrerequisitesHandler) Handle(ctx context.Context, cmd SetCoursePrerequisites) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	c, err := h.courseRepository.Get(ctx, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "course not found")
+	}
+
+	// Authorize actor
+	if err := policy.CanManageCourse(cmd.Actor, c); err != nil {
+		return err
+	}
+
+	// Every prerequisite has to be an existing course
+	var v commonerrors.Validation
+	prerequisites := make([]course.Prerequisite, 0, len(cmd.Prerequisites))
+	ids := make([]string, 0, len(cmd.Prerequisites))
+	for i, cp := range cmd.Prerequisites {
+		field := fmt.Sprintf("prerequisites[%d].courseId", i)
+		p, err := course.NewPrerequisite(cp.CourseID, cp.Required)
+		if err != nil {
+			v.Add(field, "prerequisite course id is required")
+			continue
+		}
+		exists, err := h.courseRepository.Exists(ctx, cp.CourseID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			v.Add(field, fmt.Sprintf("course '%s' does not exist", cp.CourseID))
+			continue
+		}
+		prerequisites = append(prerequisites, p)
+		ids = append(ids, cp.CourseID)
+	}
+	if err := v.Err("invalid-prerequisites"); err != nil {
+		return err
+	}
+
+	if err := c.SetPrerequisites(prerequisites); err != nil {
+		return err
+	}
+
+	// The new prerequisites must not depend on the course themselves
+	transitive, err := h.courseRepository.TransitivePrerequisites(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if err := c.CheckPrerequisiteCycle(transitive); err != nil {
+		return err
+	}
+
+	// Persist to repository
+	if err := h.courseRepository.UpdatePrerequisites(ctx, c); err != nil {
+		return errors.Wrap(err, "failed to update course prerequisites")
+	}
+
+	return nil
+}
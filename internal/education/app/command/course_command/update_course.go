@@ -4,21 +4,22 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type UpdateCourse struct {
+	Actor       policy.Actor
 	CourseID    string
 	TeacherID   string
 	Title       string
 	Description string
 	Thumbnail   string
-	Duration    int
 	Domain      string
 	Tags        []string
-	Rating      float64
 	Level       string
 }
 
@@ -49,45 +50,54 @@ func NewUpdateCourseHandler(
 func (h updateCourseHandler) Handle(ctx context.Context, cmd UpdateCourse) error {
 	// Validate input
 	if cmd.CourseID == "" {
-		return errors.New("course ID is required")
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
 	}
 	if cmd.TeacherID == "" {
-		return errors.New("teacher ID is required")
+		return commonerrors.NewIncorrectInputError("teacher ID is required", "teacher-id-required")
 	}
 	if cmd.Title == "" {
-		return errors.New("course title is required")
+		return commonerrors.NewIncorrectInputError("course title is required", "course-title-required")
 	}
 
-	// Check if course exists
-	exists, err := h.courseRepository.Exists(ctx, cmd.CourseID)
+	// Get existing course
+	existingCourse, err := h.courseRepository.Get(ctx, cmd.CourseID)
 	if err != nil {
-		return errors.Wrap(err, "failed to check course existence")
+		return errors.Wrap(err, "course not found")
 	}
-	if !exists {
-		return errors.New("course not found")
+
+	// Authorize actor
+	if err := policy.CanManageCourse(cmd.Actor, existingCourse); err != nil {
+		return err
+	}
+	if !existingCourse.IsOwnedBy(cmd.TeacherID) {
+		return commonerrors.NewIncorrectInputError("course ownership cannot be changed", "course-ownership-immutable")
 	}
 
-	// Parse domain
+	// Parse domain, level and tags, reporting every invalid value at once
+	var v commonerrors.Validation
 	domain, err := course.NewDomainFromString(cmd.Domain)
 	if err != nil {
-		return errors.Wrap(err, "invalid domain")
+		v.Add("domain", err.Error())
 	}
-
-	// Parse level
 	level, err := course.NewCourseLevelFromString(cmd.Level)
 	if err != nil {
-		return errors.Wrap(err, "invalid level")
+		v.Add("level", err.Error())
 	}
-
-	// Parse tags
 	tags := make([]course.Tag, 0, len(cmd.Tags))
 	for _, tagStr := range cmd.Tags {
 		tag, err := course.NewTagFromString(tagStr)
 		if err != nil {
-			return errors.Wrapf(err, "invalid tag: %s", tagStr)
+			v.Add("tags", err.Error())
+			continue
 		}
 		tags = append(tags, tag)
 	}
+	if err := v.Err("invalid-course"); err != nil {
+		return err
+	}
+	if err := existingCourse.CanChangeLevelTo(level); err != nil {
+		return err
+	}
 
 	// Create course entity with updated data
 	updatedCourse, err := course.NewCourse(
@@ -96,10 +106,9 @@ func (h updateCourseHandler) Handle(ctx context.Context, cmd UpdateCourse) error
 		cmd.Title,
 		cmd.Description,
 		cmd.Thumbnail,
-		cmd.Duration,
 		domain,
 		tags,
-		cmd.Rating,
+		existingCourse.Rating(),
 		level,
 	)
 	if err != nil {
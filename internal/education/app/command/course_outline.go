@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+// courseOutline builds the outline used to compute enrollment progress and
+// lesson locks from the content version the enrollment is pinned to.
+func courseOutline(v *version.Version, weightByDuration bool) enrollment.Outline {
+	outlineModules := make([]enrollment.OutlineModule, 0, len(v.Modules()))
+	for _, m := range v.Modules() {
+		outlineLessons := make([]enrollment.OutlineLesson, 0, len(m.Lessons()))
+		for _, l := range m.Lessons() {
+			outlineLesson := enrollment.NewOutlineLesson(l.Lesson().ID(), l.Lesson().Duration()).
+				WithGate(l.Lesson().Gate()).
+				WithPassScore(l.Lesson().PassScore()).
+				WithMaxAttempts(l.Lesson().MaxAttempts())
+			outlineLessons = append(outlineLessons, outlineLesson)
+		}
+		outlineModules = append(outlineModules, enrollment.NewOutlineModule(m.Module().ID(), outlineLessons).WithRelease(m.Module().Release()))
+	}
+
+	return enrollment.NewOutline(outlineModules, weightByDuration)
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type EditReview struct {
+	Actor    policy.Actor
+	ReviewID string
+	Rating   int
+	Text     string
+}
+
+type EditReviewHandler decorator.CommandHandler[EditReview]
+
+type editReviewHandler struct {
+	reviewRepository review.ReviewRepository
+}
+
+func NewEditReviewHandler(
+	reviewRepository review.ReviewRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) EditReviewHandler {
+	if reviewRepository == nil {
+		panic("review repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		editReviewHandler{
+			reviewRepository: reviewRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h editReviewHandler) Handle(ctx context.Context, cmd EditReview) error {
+	// Validate input
+	if cmd.ReviewID == "" {
+		return commonerrors.NewIncorrectInputError("review ID is required", "review-id-required")
+	}
+
+	// Get existing review
+	existingReview, err := h.reviewRepository.Get(ctx, cmd.ReviewID)
+	if err != nil {
+		return errors.Wrap(err, "review not found")
+	}
+
+	// Authorize actor: only the author edits a review
+	if err := policy.CanActFor(cmd.Actor, existingReview.UserID()); err != nil {
+		return err
+	}
+
+	if err := existingReview.Edit(cmd.Rating, cmd.Text); err != nil {
+		return errors.Wrap(err, "failed to edit review")
+	}
+
+	// Persist to repository, which also recomputes the course rating
+	if err := h.reviewRepository.Update(ctx, existingReview); err != nil {
+		return errors.Wrap(err, "failed to update review")
+	}
+
+	return nil
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/user"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type EnrollInCourse struct {
+	Actor        policy.Actor
 	EnrollmentID string
 	UserID       string
 	CourseID     string
@@ -23,12 +27,16 @@ type enrollInCourseHandler struct {
 	enrollmentRepository enrollment.EnrollmentRepository
 	userRepository       user.UserRepository
 	courseRepository     course.CourseRepository
+	waiverRepository     course.PrerequisiteWaiverRepository
+	versionRepository    version.VersionRepository
 }
 
 func NewEnrollInCourseHandler(
 	enrollmentRepository enrollment.EnrollmentRepository,
 	userRepository user.UserRepository,
 	courseRepository course.CourseRepository,
+	waiverRepository course.PrerequisiteWaiverRepository,
+	versionRepository version.VersionRepository,
 	logger *logrus.Entry,
 	metricsClient decorator.MetricsClient,
 ) EnrollInCourseHandler {
@@ -41,12 +49,20 @@ func NewEnrollInCourseHandler(
 	if courseRepository == nil {
 		panic("course repository is required")
 	}
+	if waiverRepository == nil {
+		panic("prerequisite waiver repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
 
 	return decorator.ApplyCommandDecorators(
 		enrollInCourseHandler{
 			enrollmentRepository: enrollmentRepository,
 			userRepository:       userRepository,
 			courseRepository:     courseRepository,
+			waiverRepository:     waiverRepository,
+			versionRepository:    versionRepository,
 		},
 		logger,
 		metricsClient,
@@ -56,13 +72,21 @@ func NewEnrollInCourseHandler(
 func (h enrollInCourseHandler) Handle(ctx context.Context, cmd EnrollInCourse) error {
 	// Validate input
 	if cmd.EnrollmentID == "" {
-		return errors.New("enrollment ID is required")
+		return commonerrors.NewIncorrectInputError("enrollment ID is required", "enrollment-id-required")
 	}
 	if cmd.UserID == "" {
-		return errors.New("user ID is required")
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
 	}
 	if cmd.CourseID == "" {
-		return errors.New("course ID is required")
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanEnroll(cmd.Actor); err != nil {
+		return err
+	}
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
 	}
 
 	// Verify user exists and can enroll
@@ -71,17 +95,37 @@ func (h enrollInCourseHandler) Handle(ctx context.Context, cmd EnrollInCourse) e
 		return errors.Wrap(err, "user not found")
 	}
 	if !student.CanEnroll() {
-		return errors.New("only students can enroll in courses")
+		return commonerrors.NewForbiddenError("only students can enroll in courses", "student-role-required")
 	}
 
-	// Verify course exists
-	_, err = h.courseRepository.Get(ctx, cmd.CourseID)
+	// Verify course exists and is open for enrollment
+	c, err := h.courseRepository.Get(ctx, cmd.CourseID)
 	if err != nil {
 		return errors.Wrap(err, "course not found")
 	}
+	if err := policy.CanViewCourse(cmd.Actor, c); err != nil {
+		return err
+	}
+	if !c.IsPublished() {
+		return commonerrors.NewConflictError("only published courses are open for enrollment", "course-not-published")
+	}
+
+	// Required prior courses have to be completed unless the student was let in
+	if err := h.checkPrerequisites(ctx, c, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Pin the enrollment to the latest published content
+	contentVersion, err := h.versionRepository.LatestNumber(ctx, cmd.CourseID)
+	if err != nil {
+		return err
+	}
+	if contentVersion == 0 {
+		return commonerrors.NewConflictError("the course has no published content yet", "course-not-published")
+	}
 
 	// Create enrollment entity
-	newEnrollment, err := enrollment.NewEnrollment(cmd.EnrollmentID, cmd.UserID, cmd.CourseID)
+	newEnrollment, err := enrollment.NewEnrollment(cmd.EnrollmentID, cmd.UserID, cmd.CourseID, contentVersion)
 	if err != nil {
 		return errors.Wrap(err, "failed to create enrollment")
 	}
@@ -93,3 +137,32 @@ func (h enrollInCourseHandler) Handle(ctx context.Context, cmd EnrollInCourse) e
 
 	return nil
 }
+
+// checkPrerequisites lets the student in when every required prerequisite of
+// the course was completed, or when the course owner or an admin waived them
+func (h enrollInCourseHandler) checkPrerequisites(ctx context.Context, c *course.Course, userID string) error {
+	if len(c.RequiredPrerequisites()) == 0 {
+		return nil
+	}
+
+	waived, err := h.waiverRepository.HasWaiver(ctx, c.ID(), userID)
+	if err != nil {
+		return err
+	}
+	if waived {
+		return nil
+	}
+
+	enrollments, err := h.enrollmentRepository.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get enrollments")
+	}
+	completed := make(map[string]bool, len(enrollments))
+	for _, e := range enrollments {
+		if e.IsCompleted() {
+			completed[e.CourseID()] = true
+		}
+	}
+
+	return c.CheckPrerequisitesMet(completed)
+}
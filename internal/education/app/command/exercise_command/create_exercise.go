@@ -0,0 +1,121 @@
+package exercise_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type CreateExercise struct {
+	Actor      policy.Actor
+	ExerciseID string
+	LessonID   string
+	Question   string
+	// Kind defaults to single choice
+	Kind    string
+	Answers []string
+	// CorrectAnswers holds the one correct answer of single choice,
+	// true/false and numeric questions, and every correct answer, accepted
+	// answer or item in order for the other kinds
+	CorrectAnswers []string
+	Tolerance      float64
+	TextMatch      string
+	Order          int
+	// Points weighs the exercise in the score of the lesson, zero for the
+	// default weight
+	Points int
+}
+
+type CreateExerciseHandler decorator.CommandHandler[CreateExercise]
+
+type createExerciseHandler struct {
+	exerciseRepository exercise.ExerciseRepository
+	lessonRepository   lesson.LessonRepository
+	authorizer         *policy.CourseAuthorizer
+}
+
+func NewCreateExerciseHandler(
+	exerciseRepository exercise.ExerciseRepository,
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CreateExerciseHandler {
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		createExerciseHandler{
+			exerciseRepository: exerciseRepository,
+			lessonRepository:   lessonRepository,
+			authorizer:         authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h createExerciseHandler) Handle(ctx context.Context, cmd CreateExercise) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+	if cmd.Order < 0 {
+		return commonerrors.NewIncorrectInputError("order cannot be negative", "negative-order")
+	}
+	key, err := exercise.ParseAnswerKey(cmd.Kind, cmd.Answers, cmd.CorrectAnswers, cmd.Tolerance, cmd.TextMatch)
+	if err != nil {
+		return err
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	// Check if parent lesson exists
+	exists, err := h.lessonRepository.Exists(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check lesson existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("lesson not found", "lesson-not-found")
+	}
+
+	// Create exercise entity
+	newExercise, err := exercise.NewExerciseWithKey(
+		cmd.ExerciseID,
+		cmd.LessonID,
+		cmd.Question,
+		key,
+		cmd.Order,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create exercise entity")
+	}
+	if cmd.Points != 0 {
+		if err := newExercise.SetPoints(cmd.Points); err != nil {
+			return err
+		}
+	}
+
+	// Persist to repository
+	if err := h.exerciseRepository.Create(ctx, newExercise); err != nil {
+		return errors.Wrap(err, "failed to save exercise")
+	}
+
+	return nil
+}
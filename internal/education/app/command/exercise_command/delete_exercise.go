@@ -0,0 +1,75 @@
+package exercise_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type DeleteExercise struct {
+	Actor      policy.Actor
+	ExerciseID string
+}
+
+type DeleteExerciseHandler decorator.CommandHandler[DeleteExercise]
+
+type deleteExerciseHandler struct {
+	exerciseRepository exercise.ExerciseRepository
+	authorizer         *policy.CourseAuthorizer
+}
+
+func NewDeleteExerciseHandler(
+	exerciseRepository exercise.ExerciseRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) DeleteExerciseHandler {
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		deleteExerciseHandler{
+			exerciseRepository: exerciseRepository,
+			authorizer:         authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h deleteExerciseHandler) Handle(ctx context.Context, cmd DeleteExercise) error {
+	// Validate input
+	if cmd.ExerciseID == "" {
+		return commonerrors.NewIncorrectInputError("exercise ID is required", "exercise-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageExercise(ctx, cmd.Actor, cmd.ExerciseID); err != nil {
+		return err
+	}
+
+	// Check if exercise exists
+	exists, err := h.exerciseRepository.Exists(ctx, cmd.ExerciseID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check exercise existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("exercise not found", "exercise-not-found")
+	}
+
+	// Delete exercise
+	if err := h.exerciseRepository.Delete(ctx, cmd.ExerciseID); err != nil {
+		return errors.Wrap(err, "failed to delete exercise")
+	}
+
+	return nil
+}
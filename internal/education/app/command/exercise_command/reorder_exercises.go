@@ -0,0 +1,82 @@
+package exercise_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type ReorderExercises struct {
+	Actor    policy.Actor
+	LessonID string
+	Orders   map[string]int // exercise ID -> new order
+}
+
+type ReorderExercisesHandler decorator.CommandHandler[ReorderExercises]
+
+type reorderExercisesHandler struct {
+	exerciseRepository exercise.ExerciseRepository
+	authorizer         *policy.CourseAuthorizer
+}
+
+func NewReorderExercisesHandler(
+	exerciseRepository exercise.ExerciseRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ReorderExercisesHandler {
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		reorderExercisesHandler{
+			exerciseRepository: exerciseRepository,
+			authorizer:         authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h reorderExercisesHandler) Handle(ctx context.Context, cmd ReorderExercises) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	// Only exercises of this lesson may be reordered
+	exercises, err := h.exerciseRepository.GetByLessonID(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get lesson exercises")
+	}
+
+	current := make(map[string]int, len(exercises))
+	for _, e := range exercises {
+		current[e.ID()] = e.Order()
+	}
+
+	if err := command.ValidateOrders(cmd.Orders, current); err != nil {
+		return err
+	}
+
+	if err := h.exerciseRepository.ReorderExercises(ctx, cmd.Orders); err != nil {
+		return errors.Wrap(err, "failed to reorder exercises")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package exercise_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type UpdateExercise struct {
+	Actor      policy.Actor
+	ExerciseID string
+	Question   string
+	// Kind defaults to single choice
+	Kind    string
+	Answers []string
+	// CorrectAnswers holds the one correct answer of single choice,
+	// true/false and numeric questions, and every correct answer, accepted
+	// answer or item in order for the other kinds
+	CorrectAnswers []string
+	Tolerance      float64
+	TextMatch      string
+	Order          int
+	// Points weighs the exercise in the score of the lesson, zero for the
+	// default weight
+	Points int
+}
+
+type UpdateExerciseHandler decorator.CommandHandler[UpdateExercise]
+
+type updateExerciseHandler struct {
+	exerciseRepository exercise.ExerciseRepository
+	authorizer         *policy.CourseAuthorizer
+}
+
+func NewUpdateExerciseHandler(
+	exerciseRepository exercise.ExerciseRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UpdateExerciseHandler {
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		updateExerciseHandler{
+			exerciseRepository: exerciseRepository,
+			authorizer:         authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateExerciseHandler) Handle(ctx context.Context, cmd UpdateExercise) error {
+	// Validate input
+	if cmd.ExerciseID == "" {
+		return commonerrors.NewIncorrectInputError("exercise ID is required", "exercise-id-required")
+	}
+	key, err := exercise.ParseAnswerKey(cmd.Kind, cmd.Answers, cmd.CorrectAnswers, cmd.Tolerance, cmd.TextMatch)
+	if err != nil {
+		return err
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageExercise(ctx, cmd.Actor, cmd.ExerciseID); err != nil {
+		return err
+	}
+
+	// Get existing exercise
+	e, err := h.exerciseRepository.Get(ctx, cmd.ExerciseID)
+	if err != nil {
+		return errors.Wrap(err, "exercise not found")
+	}
+
+	// Apply changes
+	if err := e.UpdateQuestion(cmd.Question); err != nil {
+		return errors.Wrap(err, "invalid question")
+	}
+	if err := e.UpdateAnswerKey(key); err != nil {
+		return errors.Wrap(err, "invalid answers")
+	}
+	if err := e.UpdateOrder(cmd.Order); err != nil {
+		return errors.Wrap(err, "invalid order")
+	}
+	points := cmd.Points
+	if points == 0 {
+		points = exercise.DefaultPoints
+	}
+	if err := e.SetPoints(points); err != nil {
+		return errors.Wrap(err, "invalid points")
+	}
+
+	// Persist to repository
+	if err := h.exerciseRepository.Update(ctx, e); err != nil {
+		return errors.Wrap(err, "failed to update exercise")
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type CreateLesson struct {
+	Actor    policy.Actor
+	LessonID string
+	ModuleID string
+	Title    string
+	Overview string
+	Content  string
+	VideoID  string
+	Duration int
+	Order    int
+	// PassScore is the exercise score needed to complete the lesson, zero
+	// for none
+	PassScore float64
+	// DrawCount is the number of exercises every quiz session draws, zero
+	// for all of them
+	DrawCount int
+	// TimeLimit is how many minutes a quiz session on the lesson lasts,
+	// zero for no limit
+	TimeLimit int
+	// MaxAttempts caps the attempts a student gets at the lesson, zero for
+	// no cap
+	MaxAttempts int
+}
+
+type CreateLessonHandler decorator.CommandHandler[CreateLesson]
+
+type createLessonHandler struct {
+	lessonRepository lesson.LessonRepository
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewCreateLessonHandler(
+	lessonRepository lesson.LessonRepository,
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CreateLessonHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		createLessonHandler{
+			lessonRepository: lessonRepository,
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h createLessonHandler) Handle(ctx context.Context, cmd CreateLesson) error {
+	// Validate input
+	if cmd.ModuleID == "" {
+		return commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+	if cmd.Duration < 0 {
+		return commonerrors.NewIncorrectInputError("duration cannot be negative", "negative-duration")
+	}
+	if cmd.Order < 0 {
+		return commonerrors.NewIncorrectInputError("order cannot be negative", "negative-order")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageModule(ctx, cmd.Actor, cmd.ModuleID); err != nil {
+		return err
+	}
+
+	// Check if parent module exists
+	exists, err := h.moduleRepository.Exists(ctx, cmd.ModuleID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check module existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("module not found", "module-not-found")
+	}
+
+	// Create lesson entity
+	newLesson, err := lesson.NewLesson(
+		cmd.LessonID,
+		cmd.ModuleID,
+		cmd.Title,
+		cmd.Overview,
+		cmd.Content,
+		cmd.VideoID,
+		cmd.Duration,
+		cmd.Order,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create lesson entity")
+	}
+	if err := newLesson.SetPassScore(cmd.PassScore); err != nil {
+		return err
+	}
+	if err := newLesson.SetDrawCount(cmd.DrawCount); err != nil {
+		return err
+	}
+	if err := newLesson.SetTimeLimit(cmd.TimeLimit); err != nil {
+		return err
+	}
+	if err := newLesson.SetMaxAttempts(cmd.MaxAttempts); err != nil {
+		return err
+	}
+
+	// Persist to repository
+	if err := h.lessonRepository.Create(ctx, newLesson); err != nil {
+		return errors.Wrap(err, "failed to save lesson")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type DeleteLesson struct {
+	Actor    policy.Actor
+	LessonID string
+}
+
+type DeleteLessonHandler decorator.CommandHandler[DeleteLesson]
+
+type deleteLessonHandler struct {
+	lessonRepository lesson.LessonRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewDeleteLessonHandler(
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) DeleteLessonHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		deleteLessonHandler{
+			lessonRepository: lessonRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h deleteLessonHandler) Handle(ctx context.Context, cmd DeleteLesson) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	// Check if lesson exists
+	exists, err := h.lessonRepository.Exists(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check lesson existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("lesson not found", "lesson-not-found")
+	}
+
+	// Delete lesson (CASCADE will delete all exercises)
+	if err := h.lessonRepository.Delete(ctx, cmd.LessonID); err != nil {
+		return errors.Wrap(err, "failed to delete lesson")
+	}
+
+	return nil
+}
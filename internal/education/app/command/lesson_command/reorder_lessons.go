@@ -0,0 +1,82 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type ReorderLessons struct {
+	Actor    policy.Actor
+	ModuleID string
+	Orders   map[string]int // lesson ID -> new order
+}
+
+type ReorderLessonsHandler decorator.CommandHandler[ReorderLessons]
+
+type reorderLessonsHandler struct {
+	lessonRepository lesson.LessonRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewReorderLessonsHandler(
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ReorderLessonsHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		reorderLessonsHandler{
+			lessonRepository: lessonRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h reorderLessonsHandler) Handle(ctx context.Context, cmd ReorderLessons) error {
+	// Validate input
+	if cmd.ModuleID == "" {
+		return commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageModule(ctx, cmd.Actor, cmd.ModuleID); err != nil {
+		return err
+	}
+
+	// Only lessons of this module may be reordered
+	lessons, err := h.lessonRepository.GetByModuleID(ctx, cmd.ModuleID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get module lessons")
+	}
+
+	current := make(map[string]int, len(lessons))
+	for _, l := range lessons {
+		current[l.ID()] = l.Order()
+	}
+
+	if err := command.ValidateOrders(cmd.Orders, current); err != nil {
+		return err
+	}
+
+	if err := h.lessonRepository.ReorderLessons(ctx, cmd.Orders); err != nil {
+		return errors.Wrap(err, "failed to reorder lessons")
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SetLessonBankQuestions replaces the bank questions a lesson asks next to
+// its own exercises: questions it always asks, and pools it draws a number
+// of questions from at random on every quiz session. The actor has to manage
+// every bank the lesson draws from.
+type SetLessonBankQuestions struct {
+	Actor       policy.Actor
+	LessonID    string
+	QuestionIDs []string
+	Pools       []LessonBankPool
+}
+
+// LessonBankPool draws Count questions from the questions of a bank tagged
+// with Topic and Difficulty. An empty Topic or Difficulty matches any.
+type LessonBankPool struct {
+	BankID     string
+	Topic      string
+	Difficulty string
+	Count      int
+}
+
+type SetLessonBankQuestionsHandler decorator.CommandHandler[SetLessonBankQuestions]
+
+type setLessonBankQuestionsHandler struct {
+	lessonRepository lesson.LessonRepository
+	bankRepository   bank.BankRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewSetLessonBankQuestionsHandler(
+	lessonRepository lesson.LessonRepository,
+	bankRepository bank.BankRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SetLessonBankQuestionsHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		setLessonBankQuestionsHandler{
+			lessonRepository: lessonRepository,
+			bankRepository:   bankRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h setLessonBankQuestionsHandler) Handle(ctx context.Context, cmd SetLessonBankQuestions) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+	var v commonerrors.Validation
+	pools := make([]bank.Pool, 0, len(cmd.Pools))
+	for _, p := range cmd.Pools {
+		var difficulty bank.Difficulty
+		if p.Difficulty != "" {
+			d, err := bank.NewDifficultyFromString(p.Difficulty)
+			if err != nil {
+				v.Add("pools", err.Error())
+				continue
+			}
+			difficulty = d
+		}
+		pool, err := bank.NewPool(p.BankID, bank.NewFilter(p.Topic, difficulty), p.Count)
+		if err != nil {
+			v.Add("pools", err.Error())
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	if err := v.Err("invalid-bank-questions"); err != nil {
+		return err
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	l, err := h.lessonRepository.Get(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "lesson not found")
+	}
+	if err := l.SetBankQuestions(cmd.QuestionIDs, pools); err != nil {
+		return err
+	}
+
+	// Every referenced question has to exist, and the actor has to manage
+	// the banks the lesson draws from
+	bankIDs := make([]string, 0, len(pools))
+	for _, p := range pools {
+		bankIDs = append(bankIDs, p.BankID())
+	}
+	if len(cmd.QuestionIDs) > 0 {
+		questions, err := h.bankRepository.GetQuestionsByIDs(ctx, cmd.QuestionIDs)
+		if err != nil {
+			return errors.Wrap(err, "failed to get bank questions")
+		}
+		if len(questions) != len(cmd.QuestionIDs) {
+			return commonerrors.NewNotFoundError("bank question not found", "bank-question-not-found")
+		}
+		for _, q := range questions {
+			bankIDs = append(bankIDs, q.BankID())
+		}
+	}
+	checked := make(map[string]bool, len(bankIDs))
+	for _, bankID := range bankIDs {
+		if checked[bankID] {
+			continue
+		}
+		checked[bankID] = true
+		b, err := h.bankRepository.Get(ctx, bankID)
+		if err != nil {
+			return errors.Wrap(err, "question bank not found")
+		}
+		if err := policy.CanManageBank(cmd.Actor, b); err != nil {
+			return err
+		}
+	}
+
+	// Persist to repository
+	if err := h.lessonRepository.Update(ctx, l); err != nil {
+		return errors.Wrap(err, "failed to update lesson")
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SetLessonGate replaces the unlock rules of a lesson. An empty ScoreLessonID
+// drops the score rule, and a gate without rules opens the lesson again.
+type SetLessonGate struct {
+	Actor           policy.Actor
+	LessonID        string
+	RequirePrevious bool
+	ScoreLessonID   string
+	MinScore        float64
+}
+
+type SetLessonGateHandler decorator.CommandHandler[SetLessonGate]
+
+type setLessonGateHandler struct {
+	lessonRepository lesson.LessonRepository
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewSetLessonGateHandler(
+	lessonRepository lesson.LessonRepository,
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SetLessonGateHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		setLessonGateHandler{
+			lessonRepository: lessonRepository,
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h setLessonGateHandler) Handle(ctx context.Context, cmd SetLessonGate) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+	gate, err := lesson.NewGate(cmd.RequirePrevious, cmd.ScoreLessonID, cmd.MinScore)
+	if err != nil {
+		return err
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	l, err := h.lessonRepository.Get(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "lesson not found")
+	}
+	if err := l.SetGate(gate); err != nil {
+		return err
+	}
+
+	// The score has to be reached on a lesson that comes earlier in the course
+	if gate.RequiresScore() {
+		previous, err := h.previousLessons(ctx, l)
+		if err != nil {
+			return err
+		}
+		if !previous[gate.ScoreLessonID()] {
+			return commonerrors.NewValidationError(
+				"invalid-lesson-gate",
+				commonerrors.NewFieldError("scoreLessonId", "score lesson must come before this lesson in the course"),
+			)
+		}
+	}
+
+	// Persist to repository
+	if err := h.lessonRepository.Update(ctx, l); err != nil {
+		return errors.Wrap(err, "failed to update lesson")
+	}
+
+	return nil
+}
+
+// previousLessons returns the IDs of the lessons that come before l in the
+// course, following module order and then lesson order
+func (h setLessonGateHandler) previousLessons(ctx context.Context, l *lesson.Lesson) (map[string]bool, error) {
+	m, err := h.moduleRepository.Get(ctx, l.ModuleID())
+	if err != nil {
+		return nil, errors.Wrap(err, "module not found")
+	}
+
+	modules, err := h.moduleRepository.GetByCourseID(ctx, m.CourseID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get course modules")
+	}
+
+	previous := make(map[string]bool)
+	for _, cm := range modules {
+		lessons, err := h.lessonRepository.GetByModuleID(ctx, cm.ID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get module lessons")
+		}
+		for _, ml := range lessons {
+			if ml.ID() == l.ID() {
+				return previous, nil
+			}
+			previous[ml.ID()] = true
+		}
+	}
+
+	return previous, nil
+}
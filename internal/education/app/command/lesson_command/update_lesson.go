@@ -0,0 +1,122 @@
+package lesson_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type UpdateLesson struct {
+	Actor    policy.Actor
+	LessonID string
+	Title    string
+	Overview string
+	Content  string
+	VideoID  string
+	Duration int
+	Order    int
+	// PassScore is the exercise score needed to complete the lesson, zero
+	// for none
+	PassScore float64
+	// DrawCount is the number of exercises every quiz session draws, zero
+	// for all of them
+	DrawCount int
+	// TimeLimit is how many minutes a quiz session on the lesson lasts,
+	// zero for no limit
+	TimeLimit int
+	// MaxAttempts caps the attempts a student gets at the lesson, zero for
+	// no cap
+	MaxAttempts int
+}
+
+type UpdateLessonHandler decorator.CommandHandler[UpdateLesson]
+
+type updateLessonHandler struct {
+	lessonRepository lesson.LessonRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewUpdateLessonHandler(
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UpdateLessonHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		updateLessonHandler{
+			lessonRepository: lessonRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateLessonHandler) Handle(ctx context.Context, cmd UpdateLesson) error {
+	// Validate input
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageLesson(ctx, cmd.Actor, cmd.LessonID); err != nil {
+		return err
+	}
+
+	// Get existing lesson
+	l, err := h.lessonRepository.Get(ctx, cmd.LessonID)
+	if err != nil {
+		return errors.Wrap(err, "lesson not found")
+	}
+
+	// Apply changes
+	if err := l.UpdateTitle(cmd.Title); err != nil {
+		return errors.Wrap(err, "invalid title")
+	}
+	if err := l.UpdateOverview(cmd.Overview); err != nil {
+		return errors.Wrap(err, "invalid overview")
+	}
+	if err := l.UpdateContent(cmd.Content); err != nil {
+		return errors.Wrap(err, "invalid content")
+	}
+	if err := l.UpdateVideoID(cmd.VideoID); err != nil {
+		return errors.Wrap(err, "invalid video ID")
+	}
+	if err := l.UpdateDuration(cmd.Duration); err != nil {
+		return errors.Wrap(err, "invalid duration")
+	}
+	if err := l.UpdateOrder(cmd.Order); err != nil {
+		return errors.Wrap(err, "invalid order")
+	}
+	if err := l.SetPassScore(cmd.PassScore); err != nil {
+		return errors.Wrap(err, "invalid pass score")
+	}
+	if err := l.SetDrawCount(cmd.DrawCount); err != nil {
+		return errors.Wrap(err, "invalid draw count")
+	}
+	if err := l.SetTimeLimit(cmd.TimeLimit); err != nil {
+		return errors.Wrap(err, "invalid time limit")
+	}
+	if err := l.SetMaxAttempts(cmd.MaxAttempts); err != nil {
+		return errors.Wrap(err, "invalid max attempts")
+	}
+
+	// Persist to repository
+	if err := h.lessonRepository.Update(ctx, l); err != nil {
+		return errors.Wrap(err, "failed to update lesson")
+	}
+
+	return nil
+}
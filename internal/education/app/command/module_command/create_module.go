@@ -0,0 +1,94 @@
+package module_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type CreateModule struct {
+	Actor    policy.Actor
+	ModuleID string
+	CourseID string
+	Title    string
+	Order    int
+}
+
+type CreateModuleHandler decorator.CommandHandler[CreateModule]
+
+type createModuleHandler struct {
+	moduleRepository module.ModuleRepository
+	courseRepository course.CourseRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewCreateModuleHandler(
+	moduleRepository module.ModuleRepository,
+	courseRepository course.CourseRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) CreateModuleHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		createModuleHandler{
+			moduleRepository: moduleRepository,
+			courseRepository: courseRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h createModuleHandler) Handle(ctx context.Context, cmd CreateModule) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.Order < 0 {
+		return commonerrors.NewIncorrectInputError("order cannot be negative", "negative-order")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageCourse(ctx, cmd.Actor, cmd.CourseID); err != nil {
+		return err
+	}
+
+	// Check if parent course exists
+	exists, err := h.courseRepository.Exists(ctx, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check course existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("course not found", "course-not-found")
+	}
+
+	// Create module entity
+	newModule, err := module.NewModule(cmd.ModuleID, cmd.CourseID, cmd.Title, cmd.Order)
+	if err != nil {
+		return errors.Wrap(err, "failed to create module entity")
+	}
+
+	// Persist to repository
+	if err := h.moduleRepository.Create(ctx, newModule); err != nil {
+		return errors.Wrap(err, "failed to save module")
+	}
+
+	return nil
+}
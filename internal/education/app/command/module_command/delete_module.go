@@ -0,0 +1,75 @@
+package module_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type DeleteModule struct {
+	Actor    policy.Actor
+	ModuleID string
+}
+
+type DeleteModuleHandler decorator.CommandHandler[DeleteModule]
+
+type deleteModuleHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewDeleteModuleHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) DeleteModuleHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		deleteModuleHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h deleteModuleHandler) Handle(ctx context.Context, cmd DeleteModule) error {
+	// Validate input
+	if cmd.ModuleID == "" {
+		return commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageModule(ctx, cmd.Actor, cmd.ModuleID); err != nil {
+		return err
+	}
+
+	// Check if module exists
+	exists, err := h.moduleRepository.Exists(ctx, cmd.ModuleID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check module existence")
+	}
+	if !exists {
+		return commonerrors.NewNotFoundError("module not found", "module-not-found")
+	}
+
+	// Delete module (CASCADE will delete all lessons and exercises)
+	if err := h.moduleRepository.Delete(ctx, cmd.ModuleID); err != nil {
+		return errors.Wrap(err, "failed to delete module")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package module_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type ReorderModules struct {
+	Actor    policy.Actor
+	CourseID string
+	Orders   map[string]int // module ID -> new order
+}
+
+type ReorderModulesHandler decorator.CommandHandler[ReorderModules]
+
+type reorderModulesHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewReorderModulesHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ReorderModulesHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		reorderModulesHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h reorderModulesHandler) Handle(ctx context.Context, cmd ReorderModules) error {
+	// Validate input
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageCourse(ctx, cmd.Actor, cmd.CourseID); err != nil {
+		return err
+	}
+
+	// Only modules of this course may be reordered
+	modules, err := h.moduleRepository.GetByCourseID(ctx, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get course modules")
+	}
+
+	current := make(map[string]int, len(modules))
+	for _, m := range modules {
+		current[m.ID()] = m.Order()
+	}
+
+	if err := command.ValidateOrders(cmd.Orders, current); err != nil {
+		return err
+	}
+
+	if err := h.moduleRepository.ReorderModules(ctx, cmd.Orders); err != nil {
+		return errors.Wrap(err, "failed to reorder modules")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package module_command
+
+import (
+	"context"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SetModuleRelease schedules when a module opens to students: a number of
+// days after they enrolled, or on a date. Leaving both unset opens the module
+// on enrollment again.
+type SetModuleRelease struct {
+	Actor               policy.Actor
+	ModuleID            string
+	DaysAfterEnrollment int
+	Date                time.Time
+}
+
+type SetModuleReleaseHandler decorator.CommandHandler[SetModuleRelease]
+
+type setModuleReleaseHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewSetModuleReleaseHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SetModuleReleaseHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		setModuleReleaseHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h setModuleReleaseHandler) Handle(ctx context.Context, cmd SetModuleRelease) error {
+	// Validate input
+	if cmd.ModuleID == "" {
+		return commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+	release, err := module.NewRelease(cmd.DaysAfterEnrollment, cmd.Date)
+	if err != nil {
+		return err
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageModule(ctx, cmd.Actor, cmd.ModuleID); err != nil {
+		return err
+	}
+
+	m, err := h.moduleRepository.Get(ctx, cmd.ModuleID)
+	if err != nil {
+		return errors.Wrap(err, "module not found")
+	}
+	m.SetRelease(release)
+
+	// Persist to repository
+	if err := h.moduleRepository.Update(ctx, m); err != nil {
+		return errors.Wrap(err, "failed to update module")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package module_command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type UpdateModule struct {
+	Actor    policy.Actor
+	ModuleID string
+	Title    string
+	Order    int
+}
+
+type UpdateModuleHandler decorator.CommandHandler[UpdateModule]
+
+type updateModuleHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewUpdateModuleHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UpdateModuleHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		updateModuleHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateModuleHandler) Handle(ctx context.Context, cmd UpdateModule) error {
+	// Validate input
+	if cmd.ModuleID == "" {
+		return commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+
+	// Authorize actor
+	if err := h.authorizer.CanManageModule(ctx, cmd.Actor, cmd.ModuleID); err != nil {
+		return err
+	}
+
+	// Get existing module
+	m, err := h.moduleRepository.Get(ctx, cmd.ModuleID)
+	if err != nil {
+		return errors.Wrap(err, "module not found")
+	}
+
+	// Apply changes
+	if err := m.UpdateTitle(cmd.Title); err != nil {
+		return errors.Wrap(err, "invalid title")
+	}
+	if err := m.UpdateOrder(cmd.Order); err != nil {
+		return errors.Wrap(err, "invalid order")
+	}
+
+	// Persist to repository
+	if err := h.moduleRepository.Update(ctx, m); err != nil {
+		return errors.Wrap(err, "failed to update module")
+	}
+
+	return nil
+}
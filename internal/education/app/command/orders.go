@@ -0,0 +1,41 @@
+package command
+
+import (
+	"fmt"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// ValidateOrders checks a reorder request against the current positions of the children of a parent
+// (child ID -> order). Every reordered ID must belong to the parent, and after applying the new
+// positions no two children may share the same order.
+func ValidateOrders(orders map[string]int, current map[string]int) error {
+	if len(orders) == 0 {
+		return commonerrors.NewIncorrectInputError("at least one item is required", "empty-reorder")
+	}
+
+	final := make(map[string]int, len(current))
+	for id, order := range current {
+		final[id] = order
+	}
+
+	for id, order := range orders {
+		if _, ok := current[id]; !ok {
+			return commonerrors.NewIncorrectInputError(fmt.Sprintf("item '%s' does not belong to the parent", id), "foreign-item")
+		}
+		if order < 0 {
+			return commonerrors.NewIncorrectInputError("order cannot be negative", "negative-order")
+		}
+		final[id] = order
+	}
+
+	used := make(map[int]bool, len(final))
+	for _, order := range final {
+		if used[order] {
+			return commonerrors.NewIncorrectInputError(fmt.Sprintf("more than one item has order %d", order), "duplicate-order")
+		}
+		used[order] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type PostReview struct {
+	Actor    policy.Actor
+	ReviewID string
+	UserID   string
+	CourseID string
+	Rating   int
+	Text     string
+}
+
+type PostReviewHandler decorator.CommandHandler[PostReview]
+
+type postReviewHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	reviewRepository     review.ReviewRepository
+}
+
+func NewPostReviewHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	reviewRepository review.ReviewRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) PostReviewHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if reviewRepository == nil {
+		panic("review repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		postReviewHandler{
+			enrollmentRepository: enrollmentRepository,
+			reviewRepository:     reviewRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h postReviewHandler) Handle(ctx context.Context, cmd PostReview) error {
+	// Validate input
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanReview(cmd.Actor); err != nil {
+		return err
+	}
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Only students enrolled in the course may review it
+	if _, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID); err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	// Create review entity
+	newReview, err := review.NewReview(cmd.ReviewID, cmd.CourseID, cmd.UserID, cmd.Rating, cmd.Text)
+	if err != nil {
+		return errors.Wrap(err, "failed to create review")
+	}
+
+	// Persist to repository, which also recomputes the course rating
+	if err := h.reviewRepository.Create(ctx, newReview); err != nil {
+		return errors.Wrap(err, "failed to save review")
+	}
+
+	return nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/user"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type RegisterUser struct {
+	Actor    policy.Actor
 	UserID   string
 	Username string
 	Email    string
@@ -44,19 +47,27 @@ func NewRegisterUserHandler(
 func (h registerUserHandler) Handle(ctx context.Context, cmd RegisterUser) error {
 	// Validate input
 	if cmd.UserID == "" {
-		return errors.New("user ID is required")
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
 	}
 	if cmd.Username == "" {
-		return errors.New("username is required")
+		return commonerrors.NewIncorrectInputError("username is required", "username-required")
 	}
 	if cmd.Email == "" {
-		return errors.New("email is required")
+		return commonerrors.NewIncorrectInputError("email is required", "email-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
 	}
 
 	// Parse role
 	role, err := user.NewRoleFromString(cmd.Role)
 	if err != nil {
-		return errors.Wrap(err, "invalid role")
+		return commonerrors.NewValidationError("invalid-role", commonerrors.NewFieldError("role", err.Error()))
+	}
+	if !cmd.Actor.IsAdmin() && role != cmd.Actor.Role {
+		return commonerrors.NewForbiddenError("users cannot grant themselves another role", "role-mismatch")
 	}
 
 	// Create user entity
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/quiz"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type StartQuizSession struct {
+	Actor     policy.Actor
+	SessionID string
+	UserID    string
+	CourseID  string
+	LessonID  string
+}
+
+type StartQuizSessionHandler decorator.CommandHandler[StartQuizSession]
+
+type startQuizSessionHandler struct {
+	enrollmentRepository  enrollment.EnrollmentRepository
+	quizSessionRepository quiz.SessionRepository
+	versionRepository     version.VersionRepository
+	weightByDuration      bool
+}
+
+// NewStartQuizSessionHandler creates the handler. weightByDuration applies to
+// the outline the lesson lock and attempt cap are checked against.
+func NewStartQuizSessionHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	quizSessionRepository quiz.SessionRepository,
+	versionRepository version.VersionRepository,
+	weightByDuration bool,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) StartQuizSessionHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if quizSessionRepository == nil {
+		panic("quiz session repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		startQuizSessionHandler{
+			enrollmentRepository:  enrollmentRepository,
+			quizSessionRepository: quizSessionRepository,
+			versionRepository:     versionRepository,
+			weightByDuration:      weightByDuration,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h startQuizSessionHandler) Handle(ctx context.Context, cmd StartQuizSession) error {
+	// Validate input
+	if cmd.SessionID == "" {
+		return commonerrors.NewIncorrectInputError("quiz session ID is required", "quiz-session-id-required")
+	}
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Get enrollment
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	// Exercises are drawn from the content version the student is on
+	pinned, err := h.versionRepository.Get(ctx, enroll.CourseID(), enroll.ContentVersion())
+	if err != nil {
+		return errors.Wrap(err, "failed to get course content")
+	}
+	lessonContent, err := pinned.Lesson(cmd.LessonID)
+	if err != nil {
+		return commonerrors.NewIncorrectInputError(fmt.Sprintf("lesson '%s' does not belong to the course", cmd.LessonID), "lesson-not-in-course")
+	}
+
+	outline := courseOutline(pinned, h.weightByDuration)
+
+	// Locked lessons cannot be quizzed on until their gate is met
+	if err := enroll.CheckLessonUnlocked(cmd.LessonID, outline); err != nil {
+		return err
+	}
+
+	// Draw the exercises with a fresh seed, kept on the server only. The
+	// deadline of a timed lesson starts running now.
+	session, err := quiz.NewSession(
+		cmd.SessionID,
+		enroll.ID(),
+		cmd.LessonID,
+		enroll.ContentVersion(),
+		lessonContent.Exercises(),
+		lessonContent.Lesson().DrawCount(),
+		lessonContent.Lesson().PoolDraws(),
+		time.Duration(lessonContent.Lesson().TimeLimit())*time.Minute,
+		rand.Int63(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to draw quiz")
+	}
+
+	// Persist to repository. Every session started counts as an attempt,
+	// submitted or not, and the cap is checked on the locked enrollment.
+	if err := h.quizSessionRepository.Create(ctx, session, func(e *enrollment.Enrollment) error {
+		return e.StartAttempt(cmd.LessonID, outline)
+	}); err != nil {
+		return errors.Wrap(err, "failed to save quiz session")
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type SubmitLessonAnswers struct {
+	Actor     policy.Actor
+	AttemptID string
+	UserID    string
+	CourseID  string
+	LessonID  string
+	// Answers maps exercise ID to the response given: a single value for most
+	// kinds of exercises, the selected options for multiple choice and the
+	// items in order for ordering questions
+	Answers map[string][]string
+}
+
+type SubmitLessonAnswersHandler decorator.CommandHandler[SubmitLessonAnswers]
+
+type submitLessonAnswersHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	attemptRepository    attempt.AttemptRepository
+	versionRepository    version.VersionRepository
+	weightByDuration     bool
+}
+
+// NewSubmitLessonAnswersHandler creates the handler. weightByDuration applies
+// to the progress recomputed when a passing attempt completes the lesson.
+func NewSubmitLessonAnswersHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	attemptRepository attempt.AttemptRepository,
+	versionRepository version.VersionRepository,
+	weightByDuration bool,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SubmitLessonAnswersHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if attemptRepository == nil {
+		panic("attempt repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		submitLessonAnswersHandler{
+			enrollmentRepository: enrollmentRepository,
+			attemptRepository:    attemptRepository,
+			versionRepository:    versionRepository,
+			weightByDuration:     weightByDuration,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h submitLessonAnswersHandler) Handle(ctx context.Context, cmd SubmitLessonAnswers) error {
+	// Validate input
+	if cmd.AttemptID == "" {
+		return commonerrors.NewIncorrectInputError("attempt ID is required", "attempt-id-required")
+	}
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if cmd.LessonID == "" {
+		return commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Get enrollment
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	// Answers are graded against the content version the student is on
+	pinned, err := h.versionRepository.Get(ctx, enroll.CourseID(), enroll.ContentVersion())
+	if err != nil {
+		return errors.Wrap(err, "failed to get course content")
+	}
+	lessonContent, err := pinned.Lesson(cmd.LessonID)
+	if err != nil {
+		return commonerrors.NewIncorrectInputError(fmt.Sprintf("lesson '%s' does not belong to the course", cmd.LessonID), "lesson-not-in-course")
+	}
+
+	// Time limits, attempt caps and bank pools are only enforced by quiz sessions
+	if lessonContent.Lesson().IsRestricted() {
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("lesson '%s' has to be answered in a quiz session", cmd.LessonID),
+			"quiz-session-required",
+		)
+	}
+	outline := courseOutline(pinned, h.weightByDuration)
+
+	// Locked lessons cannot be answered until their gate is met
+	if err := enroll.CheckLessonUnlocked(cmd.LessonID, outline); err != nil {
+		return err
+	}
+
+	// Grade the answers
+	newAttempt, err := attempt.NewAttempt(cmd.AttemptID, enroll.ID(), cmd.LessonID, lessonContent.Exercises(), cmd.Answers)
+	if err != nil {
+		return errors.Wrap(err, "failed to grade answers")
+	}
+
+	// Persist to repository. The attempt is counted against the cap before
+	// it is saved, and only kept together with the enrollment progress.
+	if err := h.attemptRepository.Create(ctx, newAttempt, func(e *enrollment.Enrollment) error {
+		if err := e.StartAttempt(cmd.LessonID, outline); err != nil {
+			return err
+		}
+
+		// Keep the best score on the lesson progress
+		if err := e.RecordExerciseScore(cmd.LessonID, newAttempt.Score()); err != nil {
+			return errors.Wrap(err, "failed to record exercise score")
+		}
+
+		// Reaching the pass score of the lesson completes it
+		if _, err := e.CompleteIfPassed(cmd.LessonID, outline); err != nil {
+			return errors.Wrap(err, "failed to complete lesson")
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to save attempt")
+	}
+
+	return nil
+}
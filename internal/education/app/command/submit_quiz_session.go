@@ -0,0 +1,144 @@
+package command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/quiz"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type SubmitQuizSession struct {
+	Actor     policy.Actor
+	SessionID string
+	AttemptID string
+	UserID    string
+	CourseID  string
+	// Responses maps exercise ID to the response given, either the positions
+	// of the options as the session presented them or the values themselves
+	Responses map[string]quiz.Response
+}
+
+type SubmitQuizSessionHandler decorator.CommandHandler[SubmitQuizSession]
+
+type submitQuizSessionHandler struct {
+	enrollmentRepository  enrollment.EnrollmentRepository
+	quizSessionRepository quiz.SessionRepository
+	versionRepository     version.VersionRepository
+	weightByDuration      bool
+}
+
+// NewSubmitQuizSessionHandler creates the handler. weightByDuration applies
+// to the progress recomputed when a passing attempt completes the lesson.
+func NewSubmitQuizSessionHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	quizSessionRepository quiz.SessionRepository,
+	versionRepository version.VersionRepository,
+	weightByDuration bool,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SubmitQuizSessionHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if quizSessionRepository == nil {
+		panic("quiz session repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		submitQuizSessionHandler{
+			enrollmentRepository:  enrollmentRepository,
+			quizSessionRepository: quizSessionRepository,
+			versionRepository:     versionRepository,
+			weightByDuration:      weightByDuration,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h submitQuizSessionHandler) Handle(ctx context.Context, cmd SubmitQuizSession) error {
+	// Validate input
+	if cmd.SessionID == "" {
+		return commonerrors.NewIncorrectInputError("quiz session ID is required", "quiz-session-id-required")
+	}
+	if cmd.AttemptID == "" {
+		return commonerrors.NewIncorrectInputError("attempt ID is required", "attempt-id-required")
+	}
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Get enrollment and the session, which has to belong to it
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+	session, err := h.quizSessionRepository.Get(ctx, cmd.SessionID)
+	if err != nil {
+		return err
+	}
+	if session.EnrollmentID() != enroll.ID() {
+		return commonerrors.NewNotFoundError("quiz session not found", "quiz-session-not-found")
+	}
+
+	// Answers are graded against the content version the exercises were drawn from
+	drawnFrom, err := h.versionRepository.Get(ctx, enroll.CourseID(), session.ContentVersion())
+	if err != nil {
+		return errors.Wrap(err, "failed to get course content")
+	}
+	lessonContent, err := drawnFrom.Lesson(session.LessonID())
+	if err != nil {
+		return errors.Wrap(err, "failed to get quiz lesson")
+	}
+
+	// Grade the answers against the presented questions
+	newAttempt, err := session.Submit(cmd.AttemptID, lessonContent.Exercises(), cmd.Responses)
+	if err != nil {
+		return errors.Wrap(err, "failed to grade answers")
+	}
+
+	// Reaching the pass score of the lesson completes it. Progress follows the
+	// content version the student is on, which an upgrade may have moved on.
+	pinned := drawnFrom
+	if enroll.ContentVersion() != session.ContentVersion() {
+		if pinned, err = h.versionRepository.Get(ctx, enroll.CourseID(), enroll.ContentVersion()); err != nil {
+			return errors.Wrap(err, "failed to get course content")
+		}
+	}
+	outline := courseOutline(pinned, h.weightByDuration)
+
+	// Closing the session, saving the attempt and the enrollment progress
+	// happen together, so the session cannot be graded twice
+	if err := h.quizSessionRepository.MarkSubmitted(ctx, session, newAttempt, func(e *enrollment.Enrollment) error {
+		// Keep the best score on the lesson progress
+		if err := e.RecordExerciseScore(session.LessonID(), newAttempt.Score()); err != nil {
+			return errors.Wrap(err, "failed to record exercise score")
+		}
+
+		if _, err := e.CompleteIfPassed(session.LessonID(), outline); err != nil {
+			return errors.Wrap(err, "failed to complete lesson")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
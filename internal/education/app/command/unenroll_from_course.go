@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type UnenrollFromCourse struct {
+	Actor    policy.Actor
+	UserID   string
+	CourseID string
+}
+
+type UnenrollFromCourseHandler decorator.CommandHandler[UnenrollFromCourse]
+
+type unenrollFromCourseHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+}
+
+func NewUnenrollFromCourseHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UnenrollFromCourseHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		unenrollFromCourseHandler{
+			enrollmentRepository: enrollmentRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h unenrollFromCourseHandler) Handle(ctx context.Context, cmd UnenrollFromCourse) error {
+	// Validate input
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Get enrollment
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	// Progress rows are removed by the database cascade
+	if err := h.enrollmentRepository.Delete(ctx, enroll.ID()); err != nil {
+		return errors.Wrap(err, "failed to delete enrollment")
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// UpgradeEnrollmentContent moves an enrollment to the latest published content
+// version of its course, carrying lesson progress over by lesson ID.
+type UpgradeEnrollmentContent struct {
+	Actor    policy.Actor
+	UserID   string
+	CourseID string
+}
+
+type UpgradeEnrollmentContentHandler decorator.CommandHandler[UpgradeEnrollmentContent]
+
+type upgradeEnrollmentContentHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	versionRepository    version.VersionRepository
+	weightByDuration     bool
+}
+
+func NewUpgradeEnrollmentContentHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	versionRepository version.VersionRepository,
+	weightByDuration bool,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) UpgradeEnrollmentContentHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyCommandDecorators(
+		upgradeEnrollmentContentHandler{
+			enrollmentRepository: enrollmentRepository,
+			versionRepository:    versionRepository,
+			weightByDuration:     weightByDuration,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h upgradeEnrollmentContentHandler) Handle(ctx context.Context, cmd UpgradeEnrollmentContent) error {
+	// Validate input
+	if cmd.UserID == "" {
+		return commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if cmd.CourseID == "" {
+		return commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(cmd.Actor, cmd.UserID); err != nil {
+		return err
+	}
+
+	// Get enrollment
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID)
+	if err != nil {
+		return errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	latest, err := h.versionRepository.LatestNumber(ctx, cmd.CourseID)
+	if err != nil {
+		return err
+	}
+	if latest <= enroll.ContentVersion() {
+		return commonerrors.NewConflictError("enrollment is already on the latest content version", "content-already-up-to-date")
+	}
+
+	latestVersion, err := h.versionRepository.Get(ctx, cmd.CourseID, latest)
+	if err != nil {
+		return errors.Wrap(err, "failed to get course content")
+	}
+
+	// Remap lesson progress onto the new outline. It runs on the locked
+	// enrollment, so attempts counted meanwhile are carried over too.
+	outline := courseOutline(latestVersion, h.weightByDuration)
+	if err := h.enrollmentRepository.Update(ctx, enroll.ID(), func(e *enrollment.Enrollment) error {
+		return e.UpgradeContent(latestVersion.Number(), outline)
+	}); err != nil {
+		return errors.Wrap(err, "failed to update enrollment")
+	}
+
+	return nil
+}
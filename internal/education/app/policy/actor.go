@@ -0,0 +1,140 @@
+package policy
+
+import (
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/user"
+)
+
+// Actor is the authenticated user a command is executed on behalf of.
+type Actor struct {
+	UserID string
+	Role   user.Role
+}
+
+func NewActor(userID string, role string) (Actor, error) {
+	if userID == "" {
+		return Actor{}, ErrUnauthenticated
+	}
+
+	r, err := user.NewRoleFromString(role)
+	if err != nil {
+		return Actor{}, commonerrors.NewAuthorizationError(err.Error(), "unknown-role")
+	}
+
+	return Actor{UserID: userID, Role: r}, nil
+}
+
+func (a Actor) IsAdmin() bool {
+	return a.Role == user.RoleAdmin
+}
+
+var (
+	ErrUnauthenticated = commonerrors.NewAuthorizationError("command requires an authenticated user", "unauthenticated")
+)
+
+// CanActFor allows users to act on their own behalf, and admins on behalf of anyone.
+func CanActFor(actor Actor, userID string) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.IsAdmin() || actor.UserID == userID {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("cannot act on behalf of another user", "acting-for-another-user")
+}
+
+// CanCreateCourse allows teachers and admins to create courses.
+func CanCreateCourse(actor Actor) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.Role == user.RoleTeacher || actor.IsAdmin() {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only teachers can create courses", "teacher-role-required")
+}
+
+// CanManageCourse allows the owning teacher and admins to change a course and its content.
+func CanManageCourse(actor Actor, c *course.Course) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	if actor.Role == user.RoleTeacher && c.IsOwnedBy(actor.UserID) {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only the course owner can manage the course", "not-course-owner")
+}
+
+// CanCreateQuestionBank allows teachers and admins to create question banks.
+func CanCreateQuestionBank(actor Actor) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.Role == user.RoleTeacher || actor.IsAdmin() {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only teachers can create question banks", "teacher-role-required")
+}
+
+// CanManageBank allows the owning teacher and admins to see and change a
+// question bank, and to draw its questions into lessons.
+func CanManageBank(actor Actor, b *bank.Bank) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	if actor.Role == user.RoleTeacher && b.IsOwnedBy(actor.UserID) {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only the bank owner can manage the question bank", "not-bank-owner")
+}
+
+// CanChangeCourseStatus allows the owning teacher and admins to move a course
+// through its publishing workflow. Only admins approve a course for publishing.
+func CanChangeCourseStatus(actor Actor, c *course.Course, to course.CourseStatus) error {
+	if err := CanManageCourse(actor, c); err != nil {
+		return err
+	}
+	if to == course.StatusPublished && !actor.IsAdmin() {
+		return commonerrors.NewForbiddenError("only admins can publish courses", "admin-role-required")
+	}
+	return nil
+}
+
+// CanViewCourse shows published courses to everyone, and unpublished ones only to
+// the owning teacher and admins. Anyone else is told the course does not exist.
+func CanViewCourse(actor Actor, c *course.Course) error {
+	if c.IsPublished() || actor.IsAdmin() || c.IsOwnedBy(actor.UserID) {
+		return nil
+	}
+	return commonerrors.NewNotFoundError("course not found", "course-not-found")
+}
+
+// CanEnroll allows students to take courses.
+func CanEnroll(actor Actor) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.Role == user.RoleStudent {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only students can take courses", "student-role-required")
+}
+
+// CanReview allows students to review the courses they take.
+func CanReview(actor Actor) error {
+	if actor.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if actor.Role == user.RoleStudent {
+		return nil
+	}
+	return commonerrors.NewForbiddenError("only students can review courses", "student-role-required")
+}
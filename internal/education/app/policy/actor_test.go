@@ -0,0 +1,231 @@
+package policy
+
+import (
+	"testing"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/user"
+)
+
+const (
+	ownerID   = "teacher-owner"
+	teacherID = "teacher-other"
+	studentID = "student-1"
+	adminID   = "admin-1"
+)
+
+var (
+	anonymous    = Actor{}
+	student      = Actor{UserID: studentID, Role: user.RoleStudent}
+	otherTeacher = Actor{UserID: teacherID, Role: user.RoleTeacher}
+	owner        = Actor{UserID: ownerID, Role: user.RoleTeacher}
+	admin        = Actor{UserID: adminID, Role: user.RoleAdmin}
+	// ownerAsStudent has the ID of the course owner but not the teacher role
+	ownerAsStudent = Actor{UserID: ownerID, Role: user.RoleStudent}
+)
+
+func TestNewActor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		userID   string
+		role     string
+		wantSlug string
+	}{
+		{"student", studentID, "student", ""},
+		{"teacher", ownerID, "teacher", ""},
+		{"admin", adminID, "admin", ""},
+		{"missing user ID", "", "student", "unauthenticated"},
+		{"unknown role", studentID, "guest", "unknown-role"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor, err := NewActor(tt.userID, tt.role)
+			assertSlug(t, err, tt.wantSlug)
+			if tt.wantSlug == "" && (actor.UserID != tt.userID || actor.Role.String() != tt.role) {
+				t.Errorf("unexpected actor %+v", actor)
+			}
+		})
+	}
+}
+
+func TestCanActFor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		actor    Actor
+		userID   string
+		wantSlug string
+	}{
+		{"anonymous", anonymous, studentID, "unauthenticated"},
+		{"student for themselves", student, studentID, ""},
+		{"student for another user", student, "student-2", "acting-for-another-user"},
+		{"teacher for themselves", owner, ownerID, ""},
+		{"teacher for a student", owner, studentID, "acting-for-another-user"},
+		{"admin for themselves", admin, adminID, ""},
+		{"admin for a student", admin, studentID, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanActFor(tt.actor, tt.userID), tt.wantSlug)
+		})
+	}
+}
+
+func TestCanCreateCourse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		actor    Actor
+		wantSlug string
+	}{
+		{"anonymous", anonymous, "unauthenticated"},
+		{"student", student, "teacher-role-required"},
+		{"teacher", otherTeacher, ""},
+		{"admin", admin, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanCreateCourse(tt.actor), tt.wantSlug)
+		})
+	}
+}
+
+func TestCanManageCourse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		actor     Actor
+		published bool
+		wantSlug  string
+	}{
+		{"anonymous", anonymous, false, "unauthenticated"},
+		{"student", student, false, "not-course-owner"},
+		{"owner ID without teacher role", ownerAsStudent, false, "not-course-owner"},
+		{"other teacher", otherTeacher, false, "not-course-owner"},
+		{"other teacher on published course", otherTeacher, true, "not-course-owner"},
+		{"owner", owner, false, ""},
+		{"owner on published course", owner, true, ""},
+		{"admin", admin, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanManageCourse(tt.actor, newTestCourse(t, tt.published)), tt.wantSlug)
+		})
+	}
+}
+
+func TestCanChangeCourseStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		actor    Actor
+		to       course.CourseStatus
+		wantSlug string
+	}{
+		{"anonymous submits for review", anonymous, course.StatusInReview, "unauthenticated"},
+		{"student submits for review", student, course.StatusInReview, "not-course-owner"},
+		{"other teacher submits for review", otherTeacher, course.StatusInReview, "not-course-owner"},
+		{"owner submits for review", owner, course.StatusInReview, ""},
+		{"admin submits for review", admin, course.StatusInReview, ""},
+		{"other teacher publishes", otherTeacher, course.StatusPublished, "not-course-owner"},
+		{"owner publishes", owner, course.StatusPublished, "admin-role-required"},
+		{"admin publishes", admin, course.StatusPublished, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanChangeCourseStatus(tt.actor, newTestCourse(t, false), tt.to), tt.wantSlug)
+		})
+	}
+}
+
+func TestCanViewCourse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		actor     Actor
+		published bool
+		wantSlug  string
+	}{
+		{"anonymous on published course", anonymous, true, ""},
+		{"student on published course", student, true, ""},
+		{"other teacher on published course", otherTeacher, true, ""},
+		{"anonymous on draft", anonymous, false, "course-not-found"},
+		{"student on draft", student, false, "course-not-found"},
+		{"other teacher on draft", otherTeacher, false, "course-not-found"},
+		{"owner on draft", owner, false, ""},
+		{"admin on draft", admin, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanViewCourse(tt.actor, newTestCourse(t, tt.published)), tt.wantSlug)
+		})
+	}
+}
+
+func TestCanEnrollAndReview(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		actor    Actor
+		wantSlug string
+	}{
+		{"anonymous", anonymous, "unauthenticated"},
+		{"student", student, ""},
+		{"teacher", owner, "student-role-required"},
+		{"admin", admin, "student-role-required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSlug(t, CanEnroll(tt.actor), tt.wantSlug)
+			assertSlug(t, CanReview(tt.actor), tt.wantSlug)
+		})
+	}
+}
+
+// newTestCourse creates a course owned by ownerID, published or in draft
+func newTestCourse(t *testing.T, published bool) *course.Course {
+	t.Helper()
+
+	c, err := course.NewCourse("course-1", ownerID, "Go Basics", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	if published {
+		for _, status := range []course.CourseStatus{course.StatusInReview, course.StatusPublished} {
+			if err := c.ChangeStatus(status); err != nil {
+				t.Fatalf("failed to publish course: %v", err)
+			}
+		}
+	}
+	return c
+}
+
+// assertSlug checks that err is nil when wantSlug is empty, and a slug error
+// with wantSlug otherwise
+func assertSlug(t *testing.T, err error, wantSlug string) {
+	t.Helper()
+
+	if wantSlug == "" {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return
+	}
+
+	slugErr, ok := err.(commonerrors.SlugError)
+	if !ok {
+		t.Fatalf("expected slug error '%s', got %v", wantSlug, err)
+	}
+	if slugErr.Slug() != wantSlug {
+		t.Errorf("expected slug '%s', got '%s'", wantSlug, slugErr.Slug())
+	}
+}
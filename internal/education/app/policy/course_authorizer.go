@@ -0,0 +1,79 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+)
+
+// CourseAuthorizer resolves the course that owns a piece of content and
+// checks that the actor may manage it.
+type CourseAuthorizer struct {
+	courseRepository   course.CourseRepository
+	moduleRepository   module.ModuleRepository
+	lessonRepository   lesson.LessonRepository
+	exerciseRepository exercise.ExerciseRepository
+}
+
+func NewCourseAuthorizer(
+	courseRepository course.CourseRepository,
+	moduleRepository module.ModuleRepository,
+	lessonRepository lesson.LessonRepository,
+	exerciseRepository exercise.ExerciseRepository,
+) *CourseAuthorizer {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+
+	return &CourseAuthorizer{
+		courseRepository:   courseRepository,
+		moduleRepository:   moduleRepository,
+		lessonRepository:   lessonRepository,
+		exerciseRepository: exerciseRepository,
+	}
+}
+
+func (a *CourseAuthorizer) CanManageCourse(ctx context.Context, actor Actor, courseID string) error {
+	c, err := a.courseRepository.Get(ctx, courseID)
+	if err != nil {
+		return errors.Wrap(err, "course not found")
+	}
+	return CanManageCourse(actor, c)
+}
+
+func (a *CourseAuthorizer) CanManageModule(ctx context.Context, actor Actor, moduleID string) error {
+	m, err := a.moduleRepository.Get(ctx, moduleID)
+	if err != nil {
+		return errors.Wrap(err, "module not found")
+	}
+	return a.CanManageCourse(ctx, actor, m.CourseID())
+}
+
+func (a *CourseAuthorizer) CanManageLesson(ctx context.Context, actor Actor, lessonID string) error {
+	l, err := a.lessonRepository.Get(ctx, lessonID)
+	if err != nil {
+		return errors.Wrap(err, "lesson not found")
+	}
+	return a.CanManageModule(ctx, actor, l.ModuleID())
+}
+
+func (a *CourseAuthorizer) CanManageExercise(ctx context.Context, actor Actor, exerciseID string) error {
+	e, err := a.exerciseRepository.Get(ctx, exerciseID)
+	if err != nil {
+		return errors.Wrap(err, "exercise not found")
+	}
+	return a.CanManageLesson(ctx, actor, e.LessonID())
+}
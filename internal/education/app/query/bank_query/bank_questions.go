@@ -0,0 +1,69 @@
+package bank_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// BankQuestionsQuery lists the questions of a bank, optionally only the ones
+// tagged with Topic and Difficulty.
+type BankQuestionsQuery struct {
+	Actor      policy.Actor
+	BankID     string
+	Topic      string
+	Difficulty string
+}
+
+type BankQuestionsHandler decorator.QueryHandler[BankQuestionsQuery, []*bank.Question]
+
+type bankQuestionsHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewBankQuestionsHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) BankQuestionsHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		bankQuestionsHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h bankQuestionsHandler) Handle(ctx context.Context, query BankQuestionsQuery) ([]*bank.Question, error) {
+	if query.BankID == "" {
+		return nil, commonerrors.NewIncorrectInputError("bank ID is required", "bank-id-required")
+	}
+	var difficulty bank.Difficulty
+	if query.Difficulty != "" {
+		d, err := bank.NewDifficultyFromString(query.Difficulty)
+		if err != nil {
+			return nil, commonerrors.NewIncorrectInputError(err.Error(), "invalid-difficulty")
+		}
+		difficulty = d
+	}
+
+	b, err := h.bankRepository.Get(ctx, query.BankID)
+	if err != nil {
+		return nil, errors.Wrap(err, "question bank not found")
+	}
+	if err := policy.CanManageBank(query.Actor, b); err != nil {
+		return nil, err
+	}
+
+	return h.bankRepository.GetQuestions(ctx, b.ID(), bank.NewFilter(query.Topic, difficulty))
+}
@@ -0,0 +1,47 @@
+package bank_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/sirupsen/logrus"
+)
+
+// BanksByTeacherQuery lists the question banks of the actor.
+type BanksByTeacherQuery struct {
+	Actor policy.Actor
+}
+
+type BanksByTeacherHandler decorator.QueryHandler[BanksByTeacherQuery, []*bank.Bank]
+
+type banksByTeacherHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewBanksByTeacherHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) BanksByTeacherHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		banksByTeacherHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h banksByTeacherHandler) Handle(ctx context.Context, query BanksByTeacherQuery) ([]*bank.Bank, error) {
+	if query.Actor.UserID == "" {
+		return nil, policy.ErrUnauthenticated
+	}
+
+	return h.bankRepository.GetByTeacherID(ctx, query.Actor.UserID)
+}
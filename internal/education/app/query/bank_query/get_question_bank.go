@@ -0,0 +1,59 @@
+package bank_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetQuestionBank struct {
+	Actor  policy.Actor
+	BankID string
+}
+
+type GetQuestionBankHandler decorator.QueryHandler[GetQuestionBank, *bank.Bank]
+
+type getQuestionBankHandler struct {
+	bankRepository bank.BankRepository
+}
+
+func NewGetQuestionBankHandler(
+	bankRepository bank.BankRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetQuestionBankHandler {
+	if bankRepository == nil {
+		panic("bank repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getQuestionBankHandler{
+			bankRepository: bankRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getQuestionBankHandler) Handle(ctx context.Context, query GetQuestionBank) (*bank.Bank, error) {
+	if query.BankID == "" {
+		return nil, commonerrors.NewIncorrectInputError("bank ID is required", "bank-id-required")
+	}
+
+	b, err := h.bankRepository.Get(ctx, query.BankID)
+	if err != nil {
+		return nil, errors.Wrap(err, "question bank not found")
+	}
+
+	// Banks are private to their teacher
+	if err := policy.CanManageBank(query.Actor, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
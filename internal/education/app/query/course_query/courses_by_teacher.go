@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
-	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type CourseByTeacherQuery struct {
+	// Actor is the caller, anonymous for public requests
+	Actor     policy.Actor
 	TeacherID string
 }
 
@@ -39,7 +42,21 @@ func NewCourseByTeacherHandler(
 
 func (h courseByTeacherHandler) Handle(ctx context.Context, query CourseByTeacherQuery) ([]*course.Course, error) {
 	if query.TeacherID == "" {
-		return nil, errors.New("teacher ID is required")
+		return nil, commonerrors.NewIncorrectInputError("teacher ID is required", "teacher-id-required")
 	}
-	return h.courseRepository.GetAllByTeacherID(ctx, query.TeacherID)
+
+	courses, err := h.courseRepository.GetAllByTeacherID(ctx, query.TeacherID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unpublished courses are only listed for their teacher and admins
+	visible := make([]*course.Course, 0, len(courses))
+	for _, c := range courses {
+		if policy.CanViewCourse(query.Actor, c) == nil {
+			visible = append(visible, c)
+		}
+	}
+
+	return visible, nil
 }
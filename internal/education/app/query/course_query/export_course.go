@@ -0,0 +1,59 @@
+package course_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type ExportCourse struct {
+	Actor    policy.Actor
+	CourseID string
+}
+
+type ExportCourseHandler decorator.QueryHandler[ExportCourse, *bundle.Bundle]
+
+type exportCourseHandler struct {
+	bundleRepository bundle.BundleRepository
+}
+
+func NewExportCourseHandler(
+	bundleRepository bundle.BundleRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ExportCourseHandler {
+	if bundleRepository == nil {
+		panic("bundle repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		exportCourseHandler{
+			bundleRepository: bundleRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h exportCourseHandler) Handle(ctx context.Context, query ExportCourse) (*bundle.Bundle, error) {
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	b, err := h.bundleRepository.Get(ctx, query.CourseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "course not found")
+	}
+
+	// Bundles carry the correct answers, so only the owner of the course may export it
+	if err := policy.CanManageCourse(query.Actor, b.Course()); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -4,18 +4,29 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/sirupsen/logrus"
 )
 
 type GetAllCourses struct {
-	// Add filtering options if needed
-	Domain string // optional filter
-	Level  string // optional filter
-	Tag    string // optional filter
+	// Optional filters
+	Domain      string
+	Level       string
+	Tags        []string
+	TeacherID   string
+	MinRating   *float64
+	MaxRating   *float64
+	MinDuration *int
+	MaxDuration *int
+
+	// Sorting and keyset pagination
+	Sort   string
+	Limit  int
+	Cursor string
 }
 
-type GetAllCoursesHandler decorator.QueryHandler[GetAllCourses, []*course.Course]
+type GetAllCoursesHandler decorator.QueryHandler[GetAllCourses, course.ListPage]
 
 type getAllCoursesHandler struct {
 	courseRepository course.CourseRepository
@@ -39,41 +50,56 @@ func NewGetAllCoursesHandler(
 	)
 }
 
-// !!! Change in the future
-func (h getAllCoursesHandler) Handle(ctx context.Context, query GetAllCourses) ([]*course.Course, error) {
-	// For MVP, return all courses
-	// In production, you'd implement filtering logic here or in repository
-	courses, err := h.courseRepository.GetAll(ctx)
-	if err != nil {
-		return nil, err
+func (h getAllCoursesHandler) Handle(ctx context.Context, query GetAllCourses) (course.ListPage, error) {
+	// Parse filters and sort, reporting every invalid value at once.
+	// The public catalog only ever lists published courses.
+	published := course.StatusPublished
+	var v commonerrors.Validation
+	filter := course.ListFilter{
+		Status:      &published,
+		TeacherID:   query.TeacherID,
+		MinRating:   query.MinRating,
+		MaxRating:   query.MaxRating,
+		MinDuration: query.MinDuration,
+		MaxDuration: query.MaxDuration,
 	}
-
-	// Optional: filter courses based on query parameters
-	if query.Domain != "" || query.Level != "" || query.Tag != "" {
-		filtered := make([]*course.Course, 0)
-		for _, c := range courses {
-			if h.matchesFilters(c, query) {
-				filtered = append(filtered, c)
-			}
+	if query.Domain != "" {
+		domain, err := course.NewDomainFromString(query.Domain)
+		if err != nil {
+			v.Add("domain", err.Error())
 		}
-		return filtered, nil
+		filter.Domain = &domain
 	}
-
-	return courses, nil
-}
-
-func (h getAllCoursesHandler) matchesFilters(c *course.Course, query GetAllCourses) bool {
-	if query.Domain != "" && c.Domain().String() != query.Domain {
-		return false
+	if query.Level != "" {
+		level, err := course.NewCourseLevelFromString(query.Level)
+		if err != nil {
+			v.Add("level", err.Error())
+		}
+		filter.Level = &level
 	}
-	if query.Level != "" && c.Level().String() != query.Level {
-		return false
+	for _, tagStr := range query.Tags {
+		tag, err := course.NewTagFromString(tagStr)
+		if err != nil {
+			v.Add("tag", err.Error())
+			continue
+		}
+		filter.Tags = append(filter.Tags, tag)
 	}
-	if query.Tag != "" {
-		tag, err := course.NewTagFromString(query.Tag)
-		if err != nil || !c.HasTag(tag) {
-			return false
+	var sort course.ListSort
+	if query.Sort != "" {
+		var err error
+		if sort, err = course.NewListSortFromString(query.Sort); err != nil {
+			v.Add("sort", err.Error())
 		}
 	}
-	return true
+	if err := v.Err("invalid-course-listing"); err != nil {
+		return course.ListPage{}, err
+	}
+
+	spec, err := course.NewListSpec(filter, sort, query.Limit, query.Cursor)
+	if err != nil {
+		return course.ListPage{}, err
+	}
+
+	return h.courseRepository.List(ctx, spec)
 }
@@ -4,12 +4,16 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type GetCourseDetails struct {
+	// Actor is the caller, anonymous for public requests
+	Actor    policy.Actor
 	CourseID string
 }
 
@@ -39,7 +43,7 @@ func NewGetCourseDetailsHandler(
 
 func (h getCourseDetailsHandler) Handle(ctx context.Context, query GetCourseDetails) (*course.Course, error) {
 	if query.CourseID == "" {
-		return nil, errors.New("course ID is required")
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
 	}
 
 	course, err := h.courseRepository.Get(ctx, query.CourseID)
@@ -47,5 +51,9 @@ func (h getCourseDetailsHandler) Handle(ctx context.Context, query GetCourseDeta
 		return nil, errors.Wrap(err, "course not found")
 	}
 
+	if err := policy.CanViewCourse(query.Actor, course); err != nil {
+		return nil, err
+	}
+
 	return course, nil
 }
@@ -0,0 +1,118 @@
+package course_query
+
+import (
+	"context"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetCourseOutline struct {
+	Actor    policy.Actor
+	CourseID string
+}
+
+// CourseOutline is the syllabus of a course: its modules in order, and the
+// lessons of every module in order
+type CourseOutline struct {
+	Course  *course.Course
+	Modules []OutlineModule
+}
+
+type OutlineModule struct {
+	ID       string
+	Title    string
+	Order    int
+	Duration int
+	Release  module.Release
+	// UnlockAt is the date the module opens for the student when the outline
+	// is read for an enrollment, nil when it opens on enrollment
+	UnlockAt *time.Time
+	Lessons  []OutlineLesson
+}
+
+type OutlineLesson struct {
+	ID            string
+	Title         string
+	Duration      int
+	Order         int
+	HasVideo      bool
+	ExerciseCount int
+	Gate          lesson.Gate
+	// Status is the progress status of the lesson when the outline is read
+	// for an enrollment, empty until the student starts the lesson
+	Status string
+	// Lock is the unmet gate rule when the outline is read for an
+	// enrollment, nil while the lesson is unlocked
+	Lock *enrollment.Lock
+}
+
+type CourseOutlineReadModel interface {
+	// CourseOutline returns the outline of the latest published version of a
+	// course, empty while the course was never published
+	CourseOutline(ctx context.Context, courseID string) ([]OutlineModule, error)
+
+	// EnrollmentOutline returns the outline of the content version an
+	// enrollment is pinned to, with the progress status of every lesson
+	EnrollmentOutline(ctx context.Context, enrollmentID string) ([]OutlineModule, error)
+}
+
+type GetCourseOutlineHandler decorator.QueryHandler[GetCourseOutline, CourseOutline]
+
+type getCourseOutlineHandler struct {
+	courseRepository course.CourseRepository
+	readModel        CourseOutlineReadModel
+}
+
+func NewGetCourseOutlineHandler(
+	courseRepository course.CourseRepository,
+	readModel CourseOutlineReadModel,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetCourseOutlineHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if readModel == nil {
+		panic("course outline read model is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getCourseOutlineHandler{
+			courseRepository: courseRepository,
+			readModel:        readModel,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getCourseOutlineHandler) Handle(ctx context.Context, query GetCourseOutline) (CourseOutline, error) {
+	if query.CourseID == "" {
+		return CourseOutline{}, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	c, err := h.courseRepository.Get(ctx, query.CourseID)
+	if err != nil {
+		return CourseOutline{}, errors.Wrap(err, "course not found")
+	}
+
+	if err := policy.CanViewCourse(query.Actor, c); err != nil {
+		return CourseOutline{}, err
+	}
+
+	modules, err := h.readModel.CourseOutline(ctx, c.ID())
+	if err != nil {
+		return CourseOutline{}, err
+	}
+
+	return CourseOutline{Course: c, Modules: modules}, nil
+}
@@ -0,0 +1,87 @@
+package course_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetCourseVersion struct {
+	Actor    policy.Actor
+	CourseID string
+	// Version is the version number, 0 for the latest version
+	Version int
+}
+
+type GetCourseVersionHandler decorator.QueryHandler[GetCourseVersion, *version.Version]
+
+type getCourseVersionHandler struct {
+	courseRepository  course.CourseRepository
+	versionRepository version.VersionRepository
+}
+
+func NewGetCourseVersionHandler(
+	courseRepository course.CourseRepository,
+	versionRepository version.VersionRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetCourseVersionHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getCourseVersionHandler{
+			courseRepository:  courseRepository,
+			versionRepository: versionRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getCourseVersionHandler) Handle(ctx context.Context, query GetCourseVersion) (*version.Version, error) {
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if query.Version < 0 {
+		return nil, commonerrors.NewIncorrectInputError("version must be positive", "invalid-version")
+	}
+
+	c, err := h.courseRepository.Get(ctx, query.CourseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "course not found")
+	}
+
+	// Students read the version they are enrolled on through their enrollment
+	if err := policy.CanManageCourse(query.Actor, c); err != nil {
+		return nil, err
+	}
+
+	number := query.Version
+	if number == 0 {
+		number, err = h.versionRepository.LatestNumber(ctx, c.ID())
+		if err != nil {
+			return nil, err
+		}
+		if number == 0 {
+			return nil, commonerrors.NewNotFoundError("the course has no published version", "version-not-found")
+		}
+	}
+
+	v, err := h.versionRepository.Get(ctx, c.ID(), number)
+	if err != nil {
+		return nil, errors.Wrap(err, "version not found")
+	}
+
+	return v, nil
+}
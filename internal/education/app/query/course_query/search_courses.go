@@ -0,0 +1,89 @@
+package course_query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+type SearchCourses struct {
+	Query string
+	Limit int
+}
+
+// HighlightStart and HighlightStop delimit the matched terms in the title
+// highlight and the snippet of a search result. They are control characters
+// rather than markup, so the ports escape the text before marking the terms.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// CourseSearchResult is a course matching a search, with the matched terms
+// highlighted in its title and in a snippet of its description and published
+// lessons
+type CourseSearchResult struct {
+	Course         *course.Course
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+type CourseSearchReadModel interface {
+	SearchCourses(ctx context.Context, query string, limit int) ([]CourseSearchResult, error)
+}
+
+type SearchCoursesHandler decorator.QueryHandler[SearchCourses, []CourseSearchResult]
+
+type searchCoursesHandler struct {
+	readModel CourseSearchReadModel
+}
+
+func NewSearchCoursesHandler(
+	readModel CourseSearchReadModel,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) SearchCoursesHandler {
+	if readModel == nil {
+		panic("course search read model is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		searchCoursesHandler{
+			readModel: readModel,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h searchCoursesHandler) Handle(ctx context.Context, query SearchCourses) ([]CourseSearchResult, error) {
+	// Validate input
+	terms := strings.TrimSpace(query.Query)
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	var v commonerrors.Validation
+	if terms == "" {
+		v.Add("q", "search query is required")
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		v.Add("limit", "limit must be between 1 and 100")
+	}
+	if err := v.Err("invalid-search"); err != nil {
+		return nil, err
+	}
+
+	return h.readModel.SearchCourses(ctx, terms, limit)
+}
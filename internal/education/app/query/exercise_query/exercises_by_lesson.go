@@ -0,0 +1,60 @@
+package exercise_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/sirupsen/logrus"
+)
+
+type ExercisesByLesson struct {
+	// Actor has to manage the course, students read its content through
+	// their enrollment, which only shows released modules
+	Actor    policy.Actor
+	LessonID string
+}
+
+type ExercisesByLessonHandler decorator.QueryHandler[ExercisesByLesson, []*exercise.Exercise]
+
+type exercisesByLessonHandler struct {
+	exerciseRepository exercise.ExerciseRepository
+	authorizer         *policy.CourseAuthorizer
+}
+
+func NewExercisesByLessonHandler(
+	exerciseRepository exercise.ExerciseRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ExercisesByLessonHandler {
+	if exerciseRepository == nil {
+		panic("exercise repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		exercisesByLessonHandler{
+			exerciseRepository: exerciseRepository,
+			authorizer:         authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h exercisesByLessonHandler) Handle(ctx context.Context, query ExercisesByLesson) ([]*exercise.Exercise, error) {
+	if query.LessonID == "" {
+		return nil, commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	if err := h.authorizer.CanManageLesson(ctx, query.Actor, query.LessonID); err != nil {
+		return nil, err
+	}
+
+	return h.exerciseRepository.GetByLessonID(ctx, query.LessonID)
+}
@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetAttempt struct {
+	AttemptID string
+}
+
+type GetAttemptHandler decorator.QueryHandler[GetAttempt, *attempt.Attempt]
+
+type getAttemptHandler struct {
+	attemptRepository attempt.AttemptRepository
+}
+
+func NewGetAttemptHandler(
+	attemptRepository attempt.AttemptRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetAttemptHandler {
+	if attemptRepository == nil {
+		panic("attempt repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getAttemptHandler{
+			attemptRepository: attemptRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getAttemptHandler) Handle(ctx context.Context, query GetAttempt) (*attempt.Attempt, error) {
+	if query.AttemptID == "" {
+		return nil, commonerrors.NewIncorrectInputError("attempt ID is required", "attempt-id-required")
+	}
+
+	a, err := h.attemptRepository.Get(ctx, query.AttemptID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attempt")
+	}
+
+	return a, nil
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetCourseReviews struct {
+	CourseID string
+}
+
+type GetCourseReviewsHandler decorator.QueryHandler[GetCourseReviews, []*review.Review]
+
+type getCourseReviewsHandler struct {
+	courseRepository course.CourseRepository
+	reviewRepository review.ReviewRepository
+}
+
+func NewGetCourseReviewsHandler(
+	courseRepository course.CourseRepository,
+	reviewRepository review.ReviewRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetCourseReviewsHandler {
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if reviewRepository == nil {
+		panic("review repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getCourseReviewsHandler{
+			courseRepository: courseRepository,
+			reviewRepository: reviewRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getCourseReviewsHandler) Handle(ctx context.Context, query GetCourseReviews) ([]*review.Review, error) {
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	// An unknown course is reported instead of an empty list
+	if _, err := h.courseRepository.Get(ctx, query.CourseID); err != nil {
+		return nil, errors.Wrap(err, "course not found")
+	}
+
+	reviews, err := h.reviewRepository.GetAllByCourseID(ctx, query.CourseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reviews")
+	}
+
+	return reviews, nil
+}
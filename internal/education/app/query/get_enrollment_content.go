@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// GetEnrollmentContent returns the course content version an enrollment is
+// pinned to. Modules that are not released to the student yet come without
+// their lessons.
+type GetEnrollmentContent struct {
+	UserID   string
+	CourseID string
+}
+
+type GetEnrollmentContentHandler decorator.QueryHandler[GetEnrollmentContent, *version.Version]
+
+type getEnrollmentContentHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	versionRepository    version.VersionRepository
+}
+
+func NewGetEnrollmentContentHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	versionRepository version.VersionRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetEnrollmentContentHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getEnrollmentContentHandler{
+			enrollmentRepository: enrollmentRepository,
+			versionRepository:    versionRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getEnrollmentContentHandler) Handle(ctx context.Context, query GetEnrollmentContent) (*version.Version, error) {
+	if query.UserID == "" {
+		return nil, commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, query.UserID, query.CourseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	pinned, err := h.versionRepository.Get(ctx, enroll.CourseID(), enroll.ContentVersion())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get course content")
+	}
+
+	return pinned.ReleasedTo(enroll.EnrolledAt(), time.Now()), nil
+}
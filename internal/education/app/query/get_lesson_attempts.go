@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetLessonAttempts struct {
+	Actor    policy.Actor
+	UserID   string
+	CourseID string
+	LessonID string
+}
+
+type GetLessonAttemptsHandler decorator.QueryHandler[GetLessonAttempts, []*attempt.Attempt]
+
+type getLessonAttemptsHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	attemptRepository    attempt.AttemptRepository
+}
+
+func NewGetLessonAttemptsHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	attemptRepository attempt.AttemptRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetLessonAttemptsHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if attemptRepository == nil {
+		panic("attempt repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getLessonAttemptsHandler{
+			enrollmentRepository: enrollmentRepository,
+			attemptRepository:    attemptRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getLessonAttemptsHandler) Handle(ctx context.Context, query GetLessonAttempts) ([]*attempt.Attempt, error) {
+	if query.UserID == "" {
+		return nil, commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if query.LessonID == "" {
+		return nil, commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	// Authorize actor
+	if err := policy.CanActFor(query.Actor, query.UserID); err != nil {
+		return nil, err
+	}
+
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, query.UserID, query.CourseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	attempts, err := h.attemptRepository.GetByEnrollmentAndLesson(ctx, enroll.ID(), query.LessonID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attempts")
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// GetMyCourseOutline returns the outline of the content an enrollment is
+// pinned to, with the completion status and lock state of every lesson for
+// the student
+type GetMyCourseOutline struct {
+	UserID   string
+	CourseID string
+}
+
+type GetMyCourseOutlineHandler decorator.QueryHandler[GetMyCourseOutline, course_query.CourseOutline]
+
+type getMyCourseOutlineHandler struct {
+	enrollmentRepository enrollment.EnrollmentRepository
+	courseRepository     course.CourseRepository
+	readModel            course_query.CourseOutlineReadModel
+}
+
+func NewGetMyCourseOutlineHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	courseRepository course.CourseRepository,
+	readModel course_query.CourseOutlineReadModel,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetMyCourseOutlineHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if courseRepository == nil {
+		panic("course repository is required")
+	}
+	if readModel == nil {
+		panic("course outline read model is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getMyCourseOutlineHandler{
+			enrollmentRepository: enrollmentRepository,
+			courseRepository:     courseRepository,
+			readModel:            readModel,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getMyCourseOutlineHandler) Handle(ctx context.Context, query GetMyCourseOutline) (course_query.CourseOutline, error) {
+	if query.UserID == "" {
+		return course_query.CourseOutline{}, commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if query.CourseID == "" {
+		return course_query.CourseOutline{}, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, query.UserID, query.CourseID)
+	if err != nil {
+		return course_query.CourseOutline{}, errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	c, err := h.courseRepository.Get(ctx, enroll.CourseID())
+	if err != nil {
+		return course_query.CourseOutline{}, errors.Wrap(err, "course not found")
+	}
+
+	modules, err := h.readModel.EnrollmentOutline(ctx, enroll.ID())
+	if err != nil {
+		return course_query.CourseOutline{}, err
+	}
+
+	lockLessons(enroll, modules)
+
+	return course_query.CourseOutline{Course: c, Modules: modules}, nil
+}
+
+// lockLessons evaluates the release of every module and the gate of every
+// lesson of the outline against the enrollment
+func lockLessons(enroll *enrollment.Enrollment, modules []course_query.OutlineModule) {
+	outlineModules := make([]enrollment.OutlineModule, 0, len(modules))
+	for _, m := range modules {
+		outlineLessons := make([]enrollment.OutlineLesson, 0, len(m.Lessons))
+		for _, l := range m.Lessons {
+			outlineLessons = append(outlineLessons, enrollment.NewOutlineLesson(l.ID, l.Duration).WithGate(l.Gate))
+		}
+		outlineModules = append(outlineModules, enrollment.NewOutlineModule(m.ID, outlineLessons).WithRelease(m.Release))
+	}
+	outline := enrollment.NewOutline(outlineModules, false)
+
+	for i := range modules {
+		if !modules[i].Release.IsImmediate() {
+			unlockAt, _ := enroll.ModuleUnlockAt(outlineModules[i])
+			modules[i].UnlockAt = &unlockAt
+		}
+		for j := range modules[i].Lessons {
+			if lock, locked := enroll.LessonLock(modules[i].Lessons[j].ID, outline); locked {
+				modules[i].Lessons[j].Lock = &lock
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -39,7 +40,7 @@ func NewGetMyEnrollmentsHandler(
 
 func (h getMyEnrollmentsHandler) Handle(ctx context.Context, query GetMyEnrollments) ([]*enrollment.Enrollment, error) {
 	if query.UserID == "" {
-		return nil, errors.New("user ID is required")
+		return nil, commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
 	}
 
 	enrollments, err := h.enrollmentRepository.GetAllByUserID(ctx, query.UserID)
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/quiz"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// GetQuizSession returns a quiz session of the student with its questions as
+// they are presented
+type GetQuizSession struct {
+	UserID    string
+	CourseID  string
+	SessionID string
+}
+
+// QuizSession is a quiz session with its drawn exercises, in order and with
+// their options shuffled
+type QuizSession struct {
+	Session   *quiz.Session
+	Questions []quiz.Question
+}
+
+type GetQuizSessionHandler decorator.QueryHandler[GetQuizSession, QuizSession]
+
+type getQuizSessionHandler struct {
+	enrollmentRepository  enrollment.EnrollmentRepository
+	quizSessionRepository quiz.SessionRepository
+	versionRepository     version.VersionRepository
+}
+
+func NewGetQuizSessionHandler(
+	enrollmentRepository enrollment.EnrollmentRepository,
+	quizSessionRepository quiz.SessionRepository,
+	versionRepository version.VersionRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetQuizSessionHandler {
+	if enrollmentRepository == nil {
+		panic("enrollment repository is required")
+	}
+	if quizSessionRepository == nil {
+		panic("quiz session repository is required")
+	}
+	if versionRepository == nil {
+		panic("version repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getQuizSessionHandler{
+			enrollmentRepository:  enrollmentRepository,
+			quizSessionRepository: quizSessionRepository,
+			versionRepository:     versionRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getQuizSessionHandler) Handle(ctx context.Context, query GetQuizSession) (QuizSession, error) {
+	if query.UserID == "" {
+		return QuizSession{}, commonerrors.NewIncorrectInputError("user ID is required", "user-id-required")
+	}
+	if query.CourseID == "" {
+		return QuizSession{}, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+	if query.SessionID == "" {
+		return QuizSession{}, commonerrors.NewIncorrectInputError("quiz session ID is required", "quiz-session-id-required")
+	}
+
+	enroll, err := h.enrollmentRepository.GetByUserAndCourse(ctx, query.UserID, query.CourseID)
+	if err != nil {
+		return QuizSession{}, errors.Wrap(err, "enrollment not found - user not enrolled in course")
+	}
+
+	session, err := h.quizSessionRepository.Get(ctx, query.SessionID)
+	if err != nil {
+		return QuizSession{}, err
+	}
+	if session.EnrollmentID() != enroll.ID() {
+		return QuizSession{}, commonerrors.NewNotFoundError("quiz session not found", "quiz-session-not-found")
+	}
+
+	drawnFrom, err := h.versionRepository.Get(ctx, enroll.CourseID(), session.ContentVersion())
+	if err != nil {
+		return QuizSession{}, errors.Wrap(err, "failed to get course content")
+	}
+	lessonContent, err := drawnFrom.Lesson(session.LessonID())
+	if err != nil {
+		return QuizSession{}, errors.Wrap(err, "failed to get quiz lesson")
+	}
+
+	questions, err := session.Questions(lessonContent.Exercises())
+	if err != nil {
+		return QuizSession{}, err
+	}
+
+	return QuizSession{Session: session, Questions: questions}, nil
+}
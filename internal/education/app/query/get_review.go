@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetReview struct {
+	ReviewID string
+}
+
+type GetReviewHandler decorator.QueryHandler[GetReview, *review.Review]
+
+type getReviewHandler struct {
+	reviewRepository review.ReviewRepository
+}
+
+func NewGetReviewHandler(
+	reviewRepository review.ReviewRepository,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetReviewHandler {
+	if reviewRepository == nil {
+		panic("review repository is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getReviewHandler{
+			reviewRepository: reviewRepository,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getReviewHandler) Handle(ctx context.Context, query GetReview) (*review.Review, error) {
+	if query.ReviewID == "" {
+		return nil, commonerrors.NewIncorrectInputError("review ID is required", "review-id-required")
+	}
+
+	r, err := h.reviewRepository.Get(ctx, query.ReviewID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get review")
+	}
+
+	return r, nil
+}
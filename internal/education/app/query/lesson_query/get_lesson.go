@@ -0,0 +1,66 @@
+package lesson_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetLesson struct {
+	// Actor has to manage the course, students read its content through
+	// their enrollment, which only shows released modules
+	Actor    policy.Actor
+	LessonID string
+}
+
+type GetLessonHandler decorator.QueryHandler[GetLesson, *lesson.Lesson]
+
+type getLessonHandler struct {
+	lessonRepository lesson.LessonRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewGetLessonHandler(
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetLessonHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getLessonHandler{
+			lessonRepository: lessonRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getLessonHandler) Handle(ctx context.Context, query GetLesson) (*lesson.Lesson, error) {
+	if query.LessonID == "" {
+		return nil, commonerrors.NewIncorrectInputError("lesson ID is required", "lesson-id-required")
+	}
+
+	l, err := h.lessonRepository.Get(ctx, query.LessonID)
+	if err != nil {
+		return nil, errors.Wrap(err, "lesson not found")
+	}
+
+	if err := h.authorizer.CanManageModule(ctx, query.Actor, l.ModuleID()); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
@@ -0,0 +1,60 @@
+package lesson_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/sirupsen/logrus"
+)
+
+type LessonsByModule struct {
+	// Actor has to manage the course, students read its content through
+	// their enrollment, which only shows released modules
+	Actor    policy.Actor
+	ModuleID string
+}
+
+type LessonsByModuleHandler decorator.QueryHandler[LessonsByModule, []*lesson.Lesson]
+
+type lessonsByModuleHandler struct {
+	lessonRepository lesson.LessonRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewLessonsByModuleHandler(
+	lessonRepository lesson.LessonRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) LessonsByModuleHandler {
+	if lessonRepository == nil {
+		panic("lesson repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		lessonsByModuleHandler{
+			lessonRepository: lessonRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h lessonsByModuleHandler) Handle(ctx context.Context, query LessonsByModule) ([]*lesson.Lesson, error) {
+	if query.ModuleID == "" {
+		return nil, commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+
+	if err := h.authorizer.CanManageModule(ctx, query.Actor, query.ModuleID); err != nil {
+		return nil, err
+	}
+
+	return h.lessonRepository.GetByModuleID(ctx, query.ModuleID)
+}
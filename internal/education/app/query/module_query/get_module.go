@@ -0,0 +1,66 @@
+package module_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type GetModule struct {
+	// Actor has to manage the course, students read its modules through the
+	// outline of the content version they are on
+	Actor    policy.Actor
+	ModuleID string
+}
+
+type GetModuleHandler decorator.QueryHandler[GetModule, *module.Module]
+
+type getModuleHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewGetModuleHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) GetModuleHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		getModuleHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h getModuleHandler) Handle(ctx context.Context, query GetModule) (*module.Module, error) {
+	if query.ModuleID == "" {
+		return nil, commonerrors.NewIncorrectInputError("module ID is required", "module-id-required")
+	}
+
+	m, err := h.moduleRepository.Get(ctx, query.ModuleID)
+	if err != nil {
+		return nil, errors.Wrap(err, "module not found")
+	}
+
+	if err := h.authorizer.CanManageCourse(ctx, query.Actor, m.CourseID()); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
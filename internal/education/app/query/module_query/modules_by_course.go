@@ -0,0 +1,60 @@
+package module_query
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/common/decorator"
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/sirupsen/logrus"
+)
+
+type ModulesByCourse struct {
+	// Actor has to manage the course, students read its content through
+	// their enrollment, which only shows released modules
+	Actor    policy.Actor
+	CourseID string
+}
+
+type ModulesByCourseHandler decorator.QueryHandler[ModulesByCourse, []*module.Module]
+
+type modulesByCourseHandler struct {
+	moduleRepository module.ModuleRepository
+	authorizer       *policy.CourseAuthorizer
+}
+
+func NewModulesByCourseHandler(
+	moduleRepository module.ModuleRepository,
+	authorizer *policy.CourseAuthorizer,
+	logger *logrus.Entry,
+	metricsClient decorator.MetricsClient,
+) ModulesByCourseHandler {
+	if moduleRepository == nil {
+		panic("module repository is required")
+	}
+	if authorizer == nil {
+		panic("course authorizer is required")
+	}
+
+	return decorator.ApplyQueryDecorators(
+		modulesByCourseHandler{
+			moduleRepository: moduleRepository,
+			authorizer:       authorizer,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h modulesByCourseHandler) Handle(ctx context.Context, query ModulesByCourse) ([]*module.Module, error) {
+	if query.CourseID == "" {
+		return nil, commonerrors.NewIncorrectInputError("course ID is required", "course-id-required")
+	}
+
+	if err := h.authorizer.CanManageCourse(ctx, query.Actor, query.CourseID); err != nil {
+		return nil, err
+	}
+
+	return h.moduleRepository.GetByCourseID(ctx, query.CourseID)
+}
@@ -0,0 +1,177 @@
+package attempt
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+)
+
+// Attempt is a graded submission of answers to the exercises of a lesson. Its
+// score is the share of the points of the lesson the answers earned, from 0
+// to 100.
+type Attempt struct {
+	id           string
+	enrollmentID string
+	lessonID     string
+	answers      []Answer
+	score        float64
+	submittedAt  time.Time
+}
+
+// Answer is the response given to a single exercise and the points it
+// earned out of the points the exercise is worth. The response holds a single
+// value for most kinds of exercises, the selected options for multiple choice
+// and the items in order for ordering questions.
+type Answer struct {
+	exerciseID string
+	response   []string
+	earned     float64
+	points     int
+}
+
+// NewAttempt grades the submitted responses against the exercises of the
+// lesson, weighing every exercise by its points. Responses are keyed by
+// exercise ID; exercises left unanswered earn nothing.
+func NewAttempt(
+	id string,
+	enrollmentID string,
+	lessonID string,
+	exercises []*exercise.Exercise,
+	submitted map[string][]string,
+) (*Attempt, error) {
+	var v commonerrors.Validation
+	if id == "" {
+		v.Add("id", "attempt id is required")
+	}
+	if enrollmentID == "" {
+		v.Add("enrollmentId", "enrollment id is required")
+	}
+	if lessonID == "" {
+		v.Add("lessonId", "lesson id is required")
+	}
+	if err := v.Err("invalid-attempt"); err != nil {
+		return nil, err
+	}
+	if len(exercises) == 0 {
+		return nil, commonerrors.NewIncorrectInputError("lesson has no exercises", "lesson-has-no-exercises")
+	}
+	if len(submitted) == 0 {
+		return nil, commonerrors.NewValidationError("invalid-answers", commonerrors.NewFieldError("answers", "at least one answer is required"))
+	}
+
+	known := make(map[string]bool, len(exercises))
+	for _, e := range exercises {
+		if e.LessonID() != lessonID {
+			return nil, errors.Errorf("exercise '%s' does not belong to the lesson", e.ID())
+		}
+		known[e.ID()] = true
+	}
+	submittedIDs := make([]string, 0, len(submitted))
+	for exerciseID := range submitted {
+		submittedIDs = append(submittedIDs, exerciseID)
+	}
+	sort.Strings(submittedIDs)
+	for _, exerciseID := range submittedIDs {
+		if !known[exerciseID] {
+			v.Add("answers", fmt.Sprintf("exercise '%s' does not belong to the lesson", exerciseID))
+		}
+	}
+	if err := v.Err("invalid-answers"); err != nil {
+		return nil, err
+	}
+
+	answers := make([]Answer, 0, len(exercises))
+	for _, e := range exercises {
+		given := submitted[e.ID()]
+		var earned float64
+		if len(given) > 0 {
+			earned = e.Grade(given...)
+		}
+		answers = append(answers, Answer{
+			exerciseID: e.ID(),
+			response:   given,
+			earned:     earned,
+			points:     e.Points(),
+		})
+	}
+
+	a := &Attempt{
+		id:           id,
+		enrollmentID: enrollmentID,
+		lessonID:     lessonID,
+		answers:      answers,
+		submittedAt:  time.Now(),
+	}
+	a.score = a.EarnedPoints() / float64(a.TotalPoints()) * 100
+
+	return a, nil
+}
+
+// UnmarshalAttemptFromDatabase restores an already graded attempt.
+// It should only be used by repositories.
+func UnmarshalAttemptFromDatabase(
+	id string,
+	enrollmentID string,
+	lessonID string,
+	answers []Answer,
+	score float64,
+	submittedAt time.Time,
+) *Attempt {
+	return &Attempt{
+		id:           id,
+		enrollmentID: enrollmentID,
+		lessonID:     lessonID,
+		answers:      answers,
+		score:        score,
+		submittedAt:  submittedAt,
+	}
+}
+
+func NewAnswer(exerciseID string, response []string, earned float64, points int) Answer {
+	return Answer{
+		exerciseID: exerciseID,
+		response:   response,
+		earned:     earned,
+		points:     points,
+	}
+}
+
+// Getters (read-only access for serialization/display)
+func (a *Attempt) ID() string             { return a.id }
+func (a *Attempt) EnrollmentID() string   { return a.enrollmentID }
+func (a *Attempt) LessonID() string       { return a.lessonID }
+func (a *Attempt) Answers() []Answer      { return a.answers }
+func (a *Attempt) Score() float64         { return a.score }
+func (a *Attempt) SubmittedAt() time.Time { return a.submittedAt }
+
+// EarnedPoints sums the points earned by the answers.
+func (a *Attempt) EarnedPoints() float64 {
+	var earned float64
+	for _, ans := range a.answers {
+		earned += ans.earned
+	}
+	return earned
+}
+
+// TotalPoints sums the points of the exercises of the lesson.
+func (a *Attempt) TotalPoints() int {
+	var total int
+	for _, ans := range a.answers {
+		total += ans.points
+	}
+	return total
+}
+
+func (a Answer) ExerciseID() string { return a.exerciseID }
+func (a Answer) Response() []string { return a.response }
+func (a Answer) Earned() float64    { return a.earned }
+func (a Answer) Points() int        { return a.points }
+
+// Correct reports whether the answer earned every point of the exercise.
+func (a Answer) Correct() bool {
+	return a.earned >= float64(a.points)
+}
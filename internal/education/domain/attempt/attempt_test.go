@@ -0,0 +1,166 @@
+package attempt
+
+import (
+	"testing"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+)
+
+func newTestExercises(t *testing.T) []*exercise.Exercise {
+	t.Helper()
+
+	first, err := exercise.NewExercise("exercise-1", "lesson-1", "2 + 2?", []string{"3", "4"}, "4", 0)
+	if err != nil {
+		t.Fatalf("failed to create exercise: %v", err)
+	}
+	second, err := exercise.NewExercise("exercise-2", "lesson-1", "Capital of France?", []string{"Paris", "Rome"}, "Paris", 1)
+	if err != nil {
+		t.Fatalf("failed to create exercise: %v", err)
+	}
+
+	return []*exercise.Exercise{first, second}
+}
+
+func TestNewAttempt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("grades every exercise of the lesson", func(t *testing.T) {
+		a, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", newTestExercises(t), map[string][]string{
+			"exercise-1": {"4"},
+			"exercise-2": {"Rome"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if a.Score() != 50 {
+			t.Errorf("expected score 50, got %v", a.Score())
+		}
+		if len(a.Answers()) != 2 {
+			t.Fatalf("expected 2 answers, got %d", len(a.Answers()))
+		}
+		if !a.Answers()[0].Correct() {
+			t.Error("expected first answer to be correct")
+		}
+		if a.Answers()[1].Correct() {
+			t.Error("expected second answer to be incorrect")
+		}
+		if a.SubmittedAt().IsZero() {
+			t.Error("expected submittedAt to be set")
+		}
+	})
+
+	t.Run("counts unanswered exercises as incorrect", func(t *testing.T) {
+		a, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", newTestExercises(t), map[string][]string{
+			"exercise-2": {"Paris"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if a.Score() != 50 {
+			t.Errorf("expected score 50, got %v", a.Score())
+		}
+		if len(a.Answers()[0].Response()) != 0 || a.Answers()[0].Correct() {
+			t.Error("expected unanswered exercise to be empty and incorrect")
+		}
+	})
+
+	t.Run("weighs exercises by their points", func(t *testing.T) {
+		exercises := newTestExercises(t)
+		if err := exercises[1].SetPoints(3); err != nil {
+			t.Fatalf("failed to set points: %v", err)
+		}
+
+		a, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", exercises, map[string][]string{
+			"exercise-1": {"3"},
+			"exercise-2": {"Paris"},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if a.Score() != 75 {
+			t.Errorf("expected score 75, got %v", a.Score())
+		}
+		if a.EarnedPoints() != 3 || a.TotalPoints() != 4 {
+			t.Errorf("expected 3 of 4 points, got %v of %d", a.EarnedPoints(), a.TotalPoints())
+		}
+	})
+
+	t.Run("gives partial credit for multiple choice", func(t *testing.T) {
+		key, err := exercise.NewAnswerKey(exercise.MultipleChoice, []string{"2", "3", "4", "5"}, []string{"2", "3", "5"}, 0, exercise.TextMatch{})
+		if err != nil {
+			t.Fatalf("failed to create answer key: %v", err)
+		}
+		primes, err := exercise.NewExerciseWithKey("exercise-1", "lesson-1", "Which are prime?", key, 0)
+		if err != nil {
+			t.Fatalf("failed to create exercise: %v", err)
+		}
+		if err := primes.SetPoints(6); err != nil {
+			t.Fatalf("failed to set points: %v", err)
+		}
+
+		tests := []struct {
+			response []string
+			earned   float64
+		}{
+			{[]string{"2", "3", "5"}, 6},
+			{[]string{"2", "3"}, 4},
+			{[]string{"2", "3", "4"}, 2},
+			{[]string{"2", "4"}, 0},
+			{[]string{"2", "3", "4", "5"}, 4},
+		}
+		for _, tt := range tests {
+			a, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", []*exercise.Exercise{primes}, map[string][]string{
+				"exercise-1": tt.response,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := a.Answers()[0].Earned(); got != tt.earned {
+				t.Errorf("expected %v points for %v, got %v", tt.earned, tt.response, got)
+			}
+			if a.Answers()[0].Correct() != (tt.earned == 6) {
+				t.Errorf("expected %v to be correct only with full credit", tt.response)
+			}
+		}
+	})
+
+	t.Run("fails when answering an exercise of another lesson", func(t *testing.T) {
+		_, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", newTestExercises(t), map[string][]string{
+			"exercise-9": {"4"},
+		})
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if err.Error() != "exercise 'exercise-9' does not belong to the lesson" {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+
+	t.Run("fails when lesson has no exercises", func(t *testing.T) {
+		_, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", nil, map[string][]string{
+			"exercise-1": {"4"},
+		})
+		if err == nil || err.Error() != "lesson has no exercises" {
+			t.Errorf("expected 'lesson has no exercises' error, got %v", err)
+		}
+	})
+
+	t.Run("fails when no answers are submitted", func(t *testing.T) {
+		_, err := NewAttempt("attempt-1", "enrollment-1", "lesson-1", newTestExercises(t), map[string][]string{})
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("fails when id is empty", func(t *testing.T) {
+		_, err := NewAttempt("", "enrollment-1", "lesson-1", newTestExercises(t), map[string][]string{
+			"exercise-1": {"4"},
+		})
+		if err == nil || err.Error() != "attempt id is required" {
+			t.Errorf("expected 'attempt id is required' error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package attempt
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+)
+
+// AttemptRepository manages Attempt persistence
+type AttemptRepository interface {
+	// Create saves a new attempt together with the changes updateEnrollment
+	// makes to the enrollment it belongs to. The enrollment is locked while
+	// updateEnrollment runs, and nothing is saved when it fails.
+	Create(ctx context.Context, attempt *Attempt, updateEnrollment func(e *enrollment.Enrollment) error) error
+
+	// Get retrieves an attempt by ID
+	Get(ctx context.Context, id string) (*Attempt, error)
+
+	// GetByEnrollmentAndLesson retrieves all attempts of a lesson, newest first
+	GetByEnrollmentAndLesson(ctx context.Context, enrollmentID, lessonID string) ([]*Attempt, error)
+}
@@ -0,0 +1,64 @@
+package bank
+
+import (
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// Bank is a collection of questions owned by a teacher. Lessons of any of
+// the teacher's courses can refer to its questions instead of keeping a copy
+// of their own, so editing a question changes every lesson that was not
+// published yet.
+type Bank struct {
+	id        string
+	teacherID string
+	title     string
+	createdAt time.Time
+}
+
+func NewBank(id string, teacherID string, title string) (*Bank, error) {
+	var v commonerrors.Validation
+	if id == "" {
+		v.Add("id", "bank id is required")
+	}
+	if teacherID == "" {
+		v.Add("teacherId", "teacher id is required")
+	}
+	if title == "" {
+		v.Add("title", "bank title is required")
+	}
+	if err := v.Err("invalid-question-bank"); err != nil {
+		return nil, err
+	}
+
+	return &Bank{
+		id:        id,
+		teacherID: teacherID,
+		title:     title,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// UnmarshalBankFromDatabase restores a bank from persisted state.
+// It should only be used by repositories.
+func UnmarshalBankFromDatabase(id string, teacherID string, title string, createdAt time.Time) (*Bank, error) {
+	b, err := NewBank(id, teacherID, title)
+	if err != nil {
+		return nil, err
+	}
+
+	b.createdAt = createdAt
+
+	return b, nil
+}
+
+// Getters (read-only access for serialization/display)
+func (b *Bank) ID() string           { return b.id }
+func (b *Bank) TeacherID() string    { return b.teacherID }
+func (b *Bank) Title() string        { return b.title }
+func (b *Bank) CreatedAt() time.Time { return b.createdAt }
+
+func (b *Bank) IsOwnedBy(teacherID string) bool {
+	return b.teacherID == teacherID
+}
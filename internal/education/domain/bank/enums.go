@@ -0,0 +1,39 @@
+package bank
+
+import "github.com/pkg/errors"
+
+// Difficulty enum
+var (
+	Easy   = Difficulty{d: "easy"}
+	Medium = Difficulty{d: "medium"}
+	Hard   = Difficulty{d: "hard"}
+)
+
+var difficultyValues = []Difficulty{
+	Easy,
+	Medium,
+	Hard,
+}
+
+// Difficulty is how hard a question of a bank is. The zero Difficulty is
+// unknown and only used by filters matching any difficulty.
+type Difficulty struct {
+	d string
+}
+
+func (d Difficulty) String() string {
+	return d.d
+}
+
+func (d Difficulty) IsZero() bool {
+	return d == Difficulty{}
+}
+
+func NewDifficultyFromString(difficultyStr string) (Difficulty, error) {
+	for _, difficulty := range difficultyValues {
+		if difficulty.String() == difficultyStr {
+			return difficulty, nil
+		}
+	}
+	return Difficulty{}, errors.Errorf("unknown '%s' difficulty", difficultyStr)
+}
@@ -0,0 +1,74 @@
+package bank
+
+import (
+	"fmt"
+	"strings"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// Filter selects questions of a bank by topic and difficulty. An empty topic
+// or a zero difficulty matches every question.
+type Filter struct {
+	topic      string
+	difficulty Difficulty
+}
+
+func NewFilter(topic string, difficulty Difficulty) Filter {
+	return Filter{
+		topic:      strings.ToLower(strings.TrimSpace(topic)),
+		difficulty: difficulty,
+	}
+}
+
+func (f Filter) Topic() string          { return f.topic }
+func (f Filter) Difficulty() Difficulty { return f.difficulty }
+
+// Matches reports whether the question has the topic and the difficulty of
+// the filter.
+func (f Filter) Matches(q *Question) bool {
+	if f.topic != "" && !q.HasTopic(f.topic) {
+		return false
+	}
+	if !f.difficulty.IsZero() && q.difficulty != f.difficulty {
+		return false
+	}
+	return true
+}
+
+// Pool is a lesson's reference to the questions of a bank matching a
+// filter. Every quiz session on the lesson draws count of them at random.
+type Pool struct {
+	bankID string
+	filter Filter
+	count  int
+}
+
+func NewPool(bankID string, filter Filter, count int) (Pool, error) {
+	var v commonerrors.Validation
+	if bankID == "" {
+		v.Add("bankId", "bank id is required")
+	}
+	if count < 1 {
+		v.Add("count", "a pool has to draw at least one question")
+	}
+	if err := v.Err("invalid-question-pool"); err != nil {
+		return Pool{}, err
+	}
+
+	return Pool{
+		bankID: bankID,
+		filter: filter,
+		count:  count,
+	}, nil
+}
+
+func (p Pool) BankID() string { return p.bankID }
+func (p Pool) Filter() Filter { return p.filter }
+func (p Pool) Count() int     { return p.count }
+
+// Key names the pool among the pools of a lesson, which never draw from the
+// same questions twice.
+func (p Pool) Key() string {
+	return fmt.Sprintf("%s/%s/%s", p.bankID, p.filter.topic, p.filter.difficulty)
+}
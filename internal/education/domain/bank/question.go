@@ -0,0 +1,164 @@
+package bank
+
+import (
+	"sort"
+	"strings"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+)
+
+// Question is a question of a bank. It is graded like an exercise, and is
+// tagged with the topics it covers and how difficult it is, so lessons can
+// draw from the questions matching a filter.
+type Question struct {
+	id       string
+	bankID   string
+	question string
+	key      exercise.AnswerKey
+	// points weighs the question in the score of the lessons asking it
+	points int
+	// topics are lowercase and sorted
+	topics     []string
+	difficulty Difficulty
+}
+
+// NewQuestion creates a question of a bank worth the default points.
+func NewQuestion(id string, bankID string, question string, key exercise.AnswerKey, topics []string, difficulty Difficulty) (*Question, error) {
+	var v commonerrors.Validation
+	if id == "" {
+		v.Add("id", "question id is required")
+	}
+	if bankID == "" {
+		v.Add("bankId", "bank id is required")
+	}
+	if question == "" {
+		v.Add("question", "question is required")
+	}
+	if key.Kind() == (exercise.Kind{}) {
+		v.Add("kind", "question kind is required")
+	}
+	if difficulty.IsZero() {
+		v.Add("difficulty", "difficulty is required")
+	}
+	normalized := normalizeTopics(&v, topics)
+	if err := v.Err("invalid-bank-question"); err != nil {
+		return nil, err
+	}
+
+	return &Question{
+		id:         id,
+		bankID:     bankID,
+		question:   question,
+		key:        key,
+		points:     exercise.DefaultPoints,
+		topics:     normalized,
+		difficulty: difficulty,
+	}, nil
+}
+
+// Getters (read-only access for serialization/display)
+func (q *Question) ID() string             { return q.id }
+func (q *Question) BankID() string         { return q.bankID }
+func (q *Question) Question() string       { return q.question }
+func (q *Question) Kind() exercise.Kind    { return q.key.Kind() }
+func (q *Question) Answers() []string      { return q.key.Options() }
+func (q *Question) Points() int            { return q.points }
+func (q *Question) Topics() []string       { return q.topics }
+func (q *Question) Difficulty() Difficulty { return q.difficulty }
+
+// AnswerKeyForStorage returns the answer key for persistence layer only,
+// like the answer key of an exercise it is never shown to students
+func (q *Question) AnswerKeyForStorage() exercise.AnswerKey {
+	return q.key
+}
+
+// Behavior methods
+
+// Update replaces the question and its answer key, which may change the
+// kind of the question.
+func (q *Question) Update(question string, key exercise.AnswerKey) error {
+	var v commonerrors.Validation
+	if question == "" {
+		v.Add("question", "question is required")
+	}
+	if key.Kind() == (exercise.Kind{}) {
+		v.Add("kind", "question kind is required")
+	}
+	if err := v.Err("invalid-bank-question"); err != nil {
+		return err
+	}
+
+	q.question = question
+	q.key = key
+	return nil
+}
+
+// SetPoints changes the weight of the question in the score of the lessons
+// asking it.
+func (q *Question) SetPoints(points int) error {
+	if points < 1 {
+		return commonerrors.NewValidationError("invalid-points", commonerrors.NewFieldError("points", "points must be at least 1"))
+	}
+	q.points = points
+	return nil
+}
+
+// Tag replaces the topics and the difficulty of the question.
+func (q *Question) Tag(topics []string, difficulty Difficulty) error {
+	var v commonerrors.Validation
+	if difficulty.IsZero() {
+		v.Add("difficulty", "difficulty is required")
+	}
+	normalized := normalizeTopics(&v, topics)
+	if err := v.Err("invalid-bank-question"); err != nil {
+		return err
+	}
+
+	q.topics = normalized
+	q.difficulty = difficulty
+	return nil
+}
+
+// HasTopic reports whether the question is tagged with topic, ignoring case.
+func (q *Question) HasTopic(topic string) bool {
+	topic = strings.ToLower(strings.TrimSpace(topic))
+	for _, t := range q.topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// ToExercise copies the question into an exercise of a lesson, keeping the
+// ID of the question so attempts stay comparable across versions.
+func (q *Question) ToExercise(lessonID string, order int) (*exercise.Exercise, error) {
+	e, err := exercise.NewExerciseWithKey(q.id, lessonID, q.question, q.key, order)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.SetPoints(q.points); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// normalizeTopics lowercases and sorts topics, dropping duplicates
+func normalizeTopics(v *commonerrors.Validation, topics []string) []string {
+	seen := make(map[string]bool, len(topics))
+	normalized := make([]string, 0, len(topics))
+	for _, t := range topics {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			v.Add("topics", "topics cannot be empty")
+			continue
+		}
+		if !seen[t] {
+			seen[t] = true
+			normalized = append(normalized, t)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
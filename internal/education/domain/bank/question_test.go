@@ -0,0 +1,105 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+)
+
+func newTestKey(t *testing.T) exercise.AnswerKey {
+	t.Helper()
+
+	key, err := exercise.ParseAnswerKey("", []string{"3", "4"}, []string{"4"}, 0, "")
+	if err != nil {
+		t.Fatalf("failed to create answer key: %v", err)
+	}
+	return key
+}
+
+func TestNewQuestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normalizes the topics", func(t *testing.T) {
+		q, err := NewQuestion("question-1", "bank-1", "2 + 2?", newTestKey(t), []string{"Math", " arithmetic", "math"}, Easy)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(q.Topics()) != 2 || q.Topics()[0] != "arithmetic" || q.Topics()[1] != "math" {
+			t.Errorf("expected topics [arithmetic math], got %v", q.Topics())
+		}
+		if q.Points() != exercise.DefaultPoints {
+			t.Errorf("expected default points, got %d", q.Points())
+		}
+	})
+
+	t.Run("fails without difficulty", func(t *testing.T) {
+		if _, err := NewQuestion("question-1", "bank-1", "2 + 2?", newTestKey(t), nil, Difficulty{}); err == nil {
+			t.Fatal("expected error for missing difficulty, got nil")
+		}
+	})
+
+	t.Run("fails with an empty topic", func(t *testing.T) {
+		if _, err := NewQuestion("question-1", "bank-1", "2 + 2?", newTestKey(t), []string{" "}, Easy); err == nil {
+			t.Fatal("expected error for empty topic, got nil")
+		}
+	})
+
+	t.Run("fails without bank", func(t *testing.T) {
+		if _, err := NewQuestion("question-1", "", "2 + 2?", newTestKey(t), nil, Easy); err == nil {
+			t.Fatal("expected error for missing bank, got nil")
+		}
+	})
+}
+
+func TestQuestion_ToExercise(t *testing.T) {
+	t.Parallel()
+	q, _ := NewQuestion("question-1", "bank-1", "2 + 2?", newTestKey(t), []string{"math"}, Medium)
+	_ = q.SetPoints(3)
+
+	e, err := q.ToExercise("lesson-1", 4)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if e.ID() != "question-1" || e.LessonID() != "lesson-1" || e.Order() != 4 {
+		t.Errorf("expected exercise 'question-1' of 'lesson-1' at 4, got '%s' of '%s' at %d", e.ID(), e.LessonID(), e.Order())
+	}
+	if e.Points() != 3 || !e.CheckAnswer("4") {
+		t.Error("expected the points and the answer key to be copied")
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+	q, _ := NewQuestion("question-1", "bank-1", "2 + 2?", newTestKey(t), []string{"math"}, Medium)
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty filter", NewFilter("", Difficulty{}), true},
+		{"topic ignoring case", NewFilter("Math", Difficulty{}), true},
+		{"topic and difficulty", NewFilter("math", Medium), true},
+		{"other topic", NewFilter("history", Difficulty{}), false},
+		{"other difficulty", NewFilter("math", Hard), false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Matches(q); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestNewPool(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPool("bank-1", NewFilter("math", Easy), 0); err == nil {
+		t.Fatal("expected error for a pool drawing nothing, got nil")
+	}
+
+	a, _ := NewPool("bank-1", NewFilter("Math", Easy), 1)
+	b, _ := NewPool("bank-1", NewFilter("math", Easy), 3)
+	if a.Key() != b.Key() {
+		t.Errorf("expected pools with the same filter to share a key, got '%s' and '%s'", a.Key(), b.Key())
+	}
+}
@@ -0,0 +1,36 @@
+package bank
+
+import "context"
+
+// BankRepository manages Bank aggregate persistence together with the
+// questions of every bank
+type BankRepository interface {
+	// Create saves a new bank
+	Create(ctx context.Context, bank *Bank) error
+
+	// Get retrieves a bank by ID
+	Get(ctx context.Context, id string) (*Bank, error)
+
+	// GetByTeacherID retrieves the banks of a teacher, ordered by title
+	GetByTeacherID(ctx context.Context, teacherID string) ([]*Bank, error)
+
+	// CreateQuestion saves a new question of a bank
+	CreateQuestion(ctx context.Context, question *Question) error
+
+	// UpdateQuestion modifies an existing question
+	UpdateQuestion(ctx context.Context, question *Question) error
+
+	// DeleteQuestion removes a question, unless a lesson refers to it
+	DeleteQuestion(ctx context.Context, id string) error
+
+	// GetQuestion retrieves a question by ID
+	GetQuestion(ctx context.Context, id string) (*Question, error)
+
+	// GetQuestions retrieves the questions of a bank matching the filter,
+	// ordered by ID
+	GetQuestions(ctx context.Context, bankID string, filter Filter) ([]*Question, error)
+
+	// GetQuestionsByIDs retrieves the questions with the given IDs, in any
+	// bank. Unknown IDs are skipped.
+	GetQuestionsByIDs(ctx context.Context, ids []string) ([]*Question, error)
+}
@@ -0,0 +1,174 @@
+package bundle
+
+import (
+	"fmt"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+// FormatVersion is the version of the bundle format written by exports.
+// Imports reject bundles written in any other format.
+const FormatVersion = 1
+
+// Bundle is a course together with all of its modules, lessons and exercises.
+// It is the unit courses are exported, imported and copied in, and it is
+// always saved all-or-nothing.
+type Bundle struct {
+	course  *course.Course
+	modules []version.ModuleContent
+}
+
+// NewBundle creates a bundle, checking that every module belongs to the
+// course, every lesson to its module and every exercise to its lesson.
+func NewBundle(c *course.Course, modules []version.ModuleContent) (*Bundle, error) {
+	if c == nil {
+		return nil, commonerrors.NewIncorrectInputError("bundle course is required", "invalid-bundle")
+	}
+
+	for _, m := range modules {
+		if m.Module().CourseID() != c.ID() {
+			return nil, mismatchError("module", m.Module().ID(), "course")
+		}
+		for _, l := range m.Lessons() {
+			if l.Lesson().ModuleID() != m.Module().ID() {
+				return nil, mismatchError("lesson", l.Lesson().ID(), "module")
+			}
+			for _, e := range l.Exercises() {
+				if e.LessonID() != l.Lesson().ID() {
+					return nil, mismatchError("exercise", e.ID(), "lesson")
+				}
+			}
+		}
+	}
+
+	return &Bundle{
+		course:  c,
+		modules: modules,
+	}, nil
+}
+
+// Getters (read-only access for serialization/display)
+func (b *Bundle) Course() *course.Course           { return b.course }
+func (b *Bundle) Modules() []version.ModuleContent { return b.modules }
+
+// Clone copies the bundle into a new draft course owned by teacherID. The
+// copy keeps the metadata, tags, module releases, lesson gates and the order
+// of every module, lesson and exercise, but starts unrated and gets fresh IDs
+// from newID. Lessons keep drawing from the question banks of the teacher
+// only when the teacher copies their own course, since banks are private.
+func (b *Bundle) Clone(courseID string, teacherID string, newID func() string) (*Bundle, error) {
+	src := b.course
+	tags := make([]course.Tag, len(src.Tags()))
+	copy(tags, src.Tags())
+
+	c, err := course.NewCourse(
+		courseID,
+		teacherID,
+		src.Title(),
+		src.Description(),
+		src.Thumbnail(),
+		src.Domain(),
+		tags,
+		0,
+		src.Level(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Score gates point at source lessons, they are remapped once every
+	// lesson has its new ID
+	clonedIDs := make(map[string]string)
+	gates := make(map[*lesson.Lesson]lesson.Gate)
+
+	modules := make([]version.ModuleContent, 0, len(b.modules))
+	for _, mc := range b.modules {
+		m, err := module.NewModule(newID(), c.ID(), mc.Module().Title(), mc.Module().Order())
+		if err != nil {
+			return nil, err
+		}
+		m.SetRelease(mc.Module().Release())
+
+		lessons := make([]version.LessonContent, 0, len(mc.Lessons()))
+		for _, lc := range mc.Lessons() {
+			src := lc.Lesson()
+			l, err := lesson.NewLesson(newID(), m.ID(), src.Title(), src.Overview(), src.Content(), src.VideoID(), src.Duration(), src.Order())
+			if err != nil {
+				return nil, err
+			}
+			if err := l.SetPassScore(src.PassScore()); err != nil {
+				return nil, err
+			}
+			if err := l.SetDrawCount(src.DrawCount()); err != nil {
+				return nil, err
+			}
+			if err := l.SetTimeLimit(src.TimeLimit()); err != nil {
+				return nil, err
+			}
+			if err := l.SetMaxAttempts(src.MaxAttempts()); err != nil {
+				return nil, err
+			}
+			if b.course.IsOwnedBy(teacherID) {
+				if err := l.SetBankQuestions(src.BankQuestionIDs(), src.BankPools()); err != nil {
+					return nil, err
+				}
+			}
+			clonedIDs[src.ID()] = l.ID()
+			if !src.Gate().IsOpen() {
+				gates[l] = src.Gate()
+			}
+
+			exercises := make([]*exercise.Exercise, 0, len(lc.Exercises()))
+			for _, src := range lc.Exercises() {
+				key := src.AnswerKeyForStorage()
+				answers := make([]string, len(key.Options()))
+				copy(answers, key.Options())
+				correct := make([]string, len(key.Correct()))
+				copy(correct, key.Correct())
+
+				copiedKey, err := exercise.NewAnswerKey(key.Kind(), answers, correct, key.Tolerance(), key.TextMatch())
+				if err != nil {
+					return nil, err
+				}
+				e, err := exercise.NewExerciseWithKey(newID(), l.ID(), src.Question(), copiedKey, src.Order())
+				if err != nil {
+					return nil, err
+				}
+				if err := e.SetPoints(src.Points()); err != nil {
+					return nil, err
+				}
+				exercises = append(exercises, e)
+			}
+			lessons = append(lessons, version.NewLessonContent(l, exercises))
+		}
+		modules = append(modules, version.NewModuleContent(m, lessons))
+	}
+
+	for l, src := range gates {
+		// A score rule on a lesson outside the bundle has nothing to point at
+		gate, err := lesson.NewGate(src.RequiresPrevious(), "", 0)
+		if scoreLessonID, ok := clonedIDs[src.ScoreLessonID()]; ok {
+			gate, err = lesson.NewGate(src.RequiresPrevious(), scoreLessonID, src.MinScore())
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := l.SetGate(gate); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewBundle(c, modules)
+}
+
+func mismatchError(entity string, id string, parent string) error {
+	return commonerrors.NewIncorrectInputError(
+		fmt.Sprintf("%s '%s' does not belong to the bundled %s", entity, id, parent),
+		"invalid-bundle",
+	)
+}
@@ -0,0 +1,166 @@
+package bundle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+)
+
+func newTestCourse(t *testing.T) *course.Course {
+	c, err := course.NewCourse("course-1", "teacher-1", "Learn Go", "", "", course.DomainProgramming, nil, 0, course.Beginner)
+	if err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+	return c
+}
+
+func TestNewBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts content that belongs together", func(t *testing.T) {
+		c := newTestCourse(t)
+		m, _ := module.NewModule("module-1", c.ID(), "Basics", 0)
+		l, _ := lesson.NewLesson("lesson-1", m.ID(), "Intro", "", "", "", 10, 0)
+		e, _ := exercise.NewExercise("exercise-1", l.ID(), "2 + 2?", []string{"3", "4"}, "4", 0)
+
+		b, err := NewBundle(c, []version.ModuleContent{
+			version.NewModuleContent(m, []version.LessonContent{
+				version.NewLessonContent(l, []*exercise.Exercise{e}),
+			}),
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(b.Modules()) != 1 {
+			t.Errorf("expected 1 module, got %d", len(b.Modules()))
+		}
+	})
+
+	t.Run("fails without a course", func(t *testing.T) {
+		if _, err := NewBundle(nil, nil); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("fails when a module belongs to another course", func(t *testing.T) {
+		c := newTestCourse(t)
+		m, _ := module.NewModule("module-1", "course-2", "Basics", 0)
+
+		if _, err := NewBundle(c, []version.ModuleContent{version.NewModuleContent(m, nil)}); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("fails when an exercise belongs to another lesson", func(t *testing.T) {
+		c := newTestCourse(t)
+		m, _ := module.NewModule("module-1", c.ID(), "Basics", 0)
+		l, _ := lesson.NewLesson("lesson-1", m.ID(), "Intro", "", "", "", 10, 0)
+		e, _ := exercise.NewExercise("exercise-1", "lesson-2", "2 + 2?", []string{"3", "4"}, "4", 0)
+
+		_, err := NewBundle(c, []version.ModuleContent{
+			version.NewModuleContent(m, []version.LessonContent{
+				version.NewLessonContent(l, []*exercise.Exercise{e}),
+			}),
+		})
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestBundle_Clone(t *testing.T) {
+	t.Parallel()
+
+	c, _ := course.NewCourse("course-1", "teacher-1", "Learn Go", "Basics of Go", "go.jpg", course.DomainProgramming, []course.Tag{course.TagBackend}, 4.5, course.Beginner)
+	m, _ := module.NewModule("module-1", c.ID(), "Basics", 3)
+	release, _ := module.NewRelease(7, time.Time{})
+	m.SetRelease(release)
+	l, _ := lesson.NewLesson("lesson-1", m.ID(), "Intro", "Overview", "Content", "video-1", 10, 2)
+	_ = l.SetPassScore(60)
+	_ = l.SetDrawCount(1)
+	_ = l.SetTimeLimit(20)
+	_ = l.SetMaxAttempts(3)
+	pool, _ := bank.NewPool("bank-1", bank.NewFilter("go", bank.Easy), 2)
+	_ = l.SetBankQuestions([]string{"question-1"}, []bank.Pool{pool})
+	e, _ := exercise.NewExercise("exercise-1", l.ID(), "2 + 2?", []string{"3", "4"}, "4", 1)
+	_ = e.SetPoints(5)
+	gated, _ := lesson.NewLesson("lesson-2", m.ID(), "Next", "", "", "", 5, 3)
+	gate, _ := lesson.NewGate(true, l.ID(), 80)
+	_ = gated.SetGate(gate)
+	b, _ := NewBundle(c, []version.ModuleContent{
+		version.NewModuleContent(m, []version.LessonContent{
+			version.NewLessonContent(l, []*exercise.Exercise{e}),
+			version.NewLessonContent(gated, nil),
+		}),
+	})
+
+	next := 0
+	newID := func() string {
+		next++
+		return fmt.Sprintf("copy-%d", next)
+	}
+
+	clone, err := b.Clone("course-2", "teacher-2", newID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	cc := clone.Course()
+	if cc.ID() != "course-2" || cc.TeacherID() != "teacher-2" {
+		t.Errorf("expected course-2 owned by teacher-2, got %s owned by %s", cc.ID(), cc.TeacherID())
+	}
+	if cc.Title() != c.Title() || cc.Description() != c.Description() || len(cc.Tags()) != 1 {
+		t.Errorf("expected metadata and tags to be copied, got %+v", cc)
+	}
+	if cc.Status() != course.StatusDraft || cc.Rating() != 0 {
+		t.Errorf("expected an unrated draft, got status %s and rating %v", cc.Status(), cc.Rating())
+	}
+
+	cm := clone.Modules()[0].Module()
+	cl := clone.Modules()[0].Lessons()[0].Lesson()
+	ce := clone.Modules()[0].Lessons()[0].Exercises()[0]
+	if cm.ID() == m.ID() || cl.ID() == l.ID() || ce.ID() == e.ID() {
+		t.Error("expected the copy to get fresh IDs")
+	}
+	if cm.Order() != 3 || cl.Order() != 2 || ce.Order() != 1 {
+		t.Errorf("expected orders to be preserved, got %d, %d and %d", cm.Order(), cl.Order(), ce.Order())
+	}
+	if cm.Release() != release {
+		t.Errorf("expected the module release to be copied, got %+v", cm.Release())
+	}
+	if cl.ModuleID() != cm.ID() || ce.LessonID() != cl.ID() {
+		t.Error("expected the copied content to point to its copied parents")
+	}
+	if !ce.CheckAnswer("4") {
+		t.Error("expected the correct answer to be copied")
+	}
+	if cl.PassScore() != 60 || ce.Points() != 5 {
+		t.Errorf("expected pass score and points to be copied, got %v and %d", cl.PassScore(), ce.Points())
+	}
+	if cl.DrawCount() != 1 {
+		t.Errorf("expected draw count to be copied, got %d", cl.DrawCount())
+	}
+	if cl.TimeLimit() != 20 || cl.MaxAttempts() != 3 {
+		t.Errorf("expected time limit and max attempts to be copied, got %d and %d", cl.TimeLimit(), cl.MaxAttempts())
+	}
+	if len(cl.BankQuestionIDs()) != 0 || len(cl.BankPools()) != 0 {
+		t.Error("expected the bank questions of another teacher not to be copied")
+	}
+	own, _ := b.Clone("course-3", "teacher-1", newID)
+	ol := own.Modules()[0].Lessons()[0].Lesson()
+	if len(ol.BankQuestionIDs()) != 1 || len(ol.BankPools()) != 1 || ol.BankPools()[0] != pool {
+		t.Errorf("expected the bank questions to be copied for their owner, got %v and %v", ol.BankQuestionIDs(), ol.BankPools())
+	}
+
+	cg := clone.Modules()[0].Lessons()[1].Lesson().Gate()
+	if !cg.RequiresPrevious() || cg.ScoreLessonID() != cl.ID() || cg.MinScore() != 80 {
+		t.Errorf("expected the gate to point to the copied lesson, got %+v", cg)
+	}
+}
@@ -0,0 +1,12 @@
+package bundle
+
+import "context"
+
+// BundleRepository manages Bundle persistence
+type BundleRepository interface {
+	// Create saves the course and all of its content in a single transaction
+	Create(ctx context.Context, bundle *Bundle) error
+
+	// Get retrieves a course together with the working copy of its content
+	Get(ctx context.Context, courseID string) (*Bundle, error)
+}
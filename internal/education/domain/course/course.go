@@ -1,6 +1,11 @@
 package course
 
-import "github.com/pkg/errors"
+import (
+	"fmt"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/pkg/errors"
+)
 
 type Course struct {
 	id          string
@@ -8,11 +13,15 @@ type Course struct {
 	title       string
 	description string
 	thumbnail   string
-	duration    int
+	duration    int // sum of the lesson durations, derived by the database
 	domain      Domain
 	tags        []Tag
 	rating      float64
+	reviewCount int
 	level       CourseLevel
+	status      CourseStatus
+
+	prerequisites []Prerequisite
 }
 
 func NewCourse(
@@ -21,22 +30,25 @@ func NewCourse(
 	title string,
 	description string,
 	thumbnail string,
-	duration int,
 	domain Domain,
 	tags []Tag,
 	rating float64,
 	level CourseLevel,
 ) (*Course, error) {
 
-	// Validate required fields
+	// Validate required fields, reporting every missing one at once
+	var v commonerrors.Validation
 	if id == "" {
-		return nil, errors.New("course id is required")
+		v.Add("id", "course id is required")
 	}
 	if teacherID == "" {
-		return nil, errors.New("teacher id is required")
+		v.Add("teacherId", "teacher id is required")
 	}
 	if title == "" {
-		return nil, errors.New("course title is required")
+		v.Add("title", "course title is required")
+	}
+	if err := v.Err("invalid-course"); err != nil {
+		return nil, err
 	}
 
 	return &Course{
@@ -45,26 +57,64 @@ func NewCourse(
 		title:       title,
 		description: description,
 		thumbnail:   thumbnail,
-		duration:    duration,
 		domain:      domain,
 		tags:        tags,
 		rating:      rating,
 		level:       level,
+		status:      StatusDraft,
 	}, nil
 
 }
 
+// UnmarshalCourseFromDatabase restores a stored course together with the
+// rating aggregated from its reviews, its publishing status and its
+// prerequisites. It should only be used by repositories.
+func UnmarshalCourseFromDatabase(
+	id string,
+	teacherID string,
+	title string,
+	description string,
+	thumbnail string,
+	duration int,
+	domain Domain,
+	tags []Tag,
+	rating float64,
+	reviewCount int,
+	level CourseLevel,
+	status CourseStatus,
+	prerequisites []Prerequisite,
+) *Course {
+	return &Course{
+		id:          id,
+		teacherID:   teacherID,
+		title:       title,
+		description: description,
+		thumbnail:   thumbnail,
+		duration:    duration,
+		domain:      domain,
+		tags:        tags,
+		rating:      rating,
+		reviewCount: reviewCount,
+		level:       level,
+		status:      status,
+
+		prerequisites: prerequisites,
+	}
+}
+
 // Getters (read-only access for serialization/display)
-func (c *Course) ID() string          { return c.id }
-func (c *Course) TeacherID() string   { return c.teacherID }
-func (c *Course) Title() string       { return c.title }
-func (c *Course) Description() string { return c.description }
-func (c *Course) Thumbnail() string   { return c.thumbnail }
-func (c *Course) Duration() int       { return c.duration }
-func (c *Course) Domain() Domain      { return c.domain }
-func (c *Course) Tags() []Tag         { return c.tags }
-func (c *Course) Rating() float64     { return c.rating }
-func (c *Course) Level() CourseLevel  { return c.level }
+func (c *Course) ID() string           { return c.id }
+func (c *Course) TeacherID() string    { return c.teacherID }
+func (c *Course) Title() string        { return c.title }
+func (c *Course) Description() string  { return c.description }
+func (c *Course) Thumbnail() string    { return c.thumbnail }
+func (c *Course) Duration() int        { return c.duration }
+func (c *Course) Domain() Domain       { return c.domain }
+func (c *Course) Tags() []Tag          { return c.tags }
+func (c *Course) Rating() float64      { return c.rating }
+func (c *Course) ReviewCount() int     { return c.reviewCount }
+func (c *Course) Level() CourseLevel   { return c.level }
+func (c *Course) Status() CourseStatus { return c.status }
 
 // Behavior methods
 func (c *Course) IsOwnedBy(teacherID string) bool {
@@ -82,7 +132,7 @@ func (c *Course) HasTag(tag Tag) bool {
 
 func (c *Course) UpdateBasicInfo(title, description, thumbnail string) error {
 	if title == "" {
-		return errors.New("title is required")
+		return commonerrors.NewValidationError("invalid-title", commonerrors.NewFieldError("title", "title is required"))
 	}
 	c.title = title
 	c.description = description
@@ -90,22 +140,30 @@ func (c *Course) UpdateBasicInfo(title, description, thumbnail string) error {
 	return nil
 }
 
-func (c *Course) UpdateDuration(duration int) error {
-	if duration < 0 {
-		return errors.New("duration cannot be negative")
+func (c *Course) UpdateRating(rating float64) error {
+	if rating < 0 || rating > 5 {
+		return commonerrors.NewValidationError("invalid-rating", commonerrors.NewFieldError("rating", "rating must be between 0 and 5"))
 	}
-	c.duration = duration
+	c.rating = rating
 	return nil
 }
 
-func (c *Course) UpdateRating(rating float64) error {
-	if rating < 0 || rating > 5 {
-		return errors.New("rating must be between 0 and 5")
+// ChangeStatus moves the course along its publishing workflow
+func (c *Course) ChangeStatus(to CourseStatus) error {
+	if !c.status.CanTransitionTo(to) {
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("course cannot move from %s to %s", c.status, to),
+			"invalid-status-transition",
+		)
 	}
-	c.rating = rating
+	c.status = to
 	return nil
 }
 
+func (c *Course) IsPublished() bool {
+	return c.status == StatusPublished
+}
+
 func (c *Course) AddTag(tag Tag) error {
 	if c.HasTag(tag) {
 		return errors.New("tag already exists")
@@ -1,7 +1,11 @@
 package course
 
 import (
+	"errors"
+	"strings"
 	"testing"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 )
 
 func TestNewCourse(t *testing.T) {
@@ -13,7 +17,6 @@ func TestNewCourse(t *testing.T) {
 			"Learn Go Programming",
 			"A comprehensive Go course",
 			"thumbnail.jpg",
-			3600,
 			DomainProgramming,
 			[]Tag{TagBackend, TagAPI},
 			4.5,
@@ -41,8 +44,8 @@ func TestNewCourse(t *testing.T) {
 		if course.Thumbnail() != "thumbnail.jpg" {
 			t.Errorf("expected Thumbnail 'thumbnail.jpg', got '%s'", course.Thumbnail())
 		}
-		if course.Duration() != 3600 {
-			t.Errorf("expected Duration 3600, got %d", course.Duration())
+		if course.Duration() != 0 {
+			t.Errorf("expected a new course without lessons to last 0, got %d", course.Duration())
 		}
 		if course.Domain().String() != "programming" {
 			t.Errorf("expected Domain 'programming', got '%s'", course.Domain().String())
@@ -65,7 +68,6 @@ func TestNewCourse(t *testing.T) {
 			"Learn Go Programming",
 			"A comprehensive Go course",
 			"thumbnail.jpg",
-			3600,
 			DomainProgramming,
 			[]Tag{TagBackend},
 			4.5,
@@ -90,7 +92,6 @@ func TestNewCourse(t *testing.T) {
 			"Learn Go Programming",
 			"A comprehensive Go course",
 			"thumbnail.jpg",
-			3600,
 			DomainProgramming,
 			[]Tag{TagBackend},
 			4.5,
@@ -115,7 +116,6 @@ func TestNewCourse(t *testing.T) {
 			"",
 			"A comprehensive Go course",
 			"thumbnail.jpg",
-			3600,
 			DomainProgramming,
 			[]Tag{TagBackend},
 			4.5,
@@ -133,6 +133,29 @@ func TestNewCourse(t *testing.T) {
 		}
 	})
 
+	t.Run("reports every missing field at once", func(t *testing.T) {
+		_, err := NewCourse("", "", "", "", "", DomainProgramming, nil, 0, Beginner)
+		if err == nil {
+			t.Fatal("expected error for empty fields, got nil")
+		}
+
+		var slugErr commonerrors.SlugError
+		if !errors.As(err, &slugErr) {
+			t.Fatalf("expected slug error, got %T", err)
+		}
+		if slugErr.Slug() != "invalid-course" {
+			t.Errorf("expected slug 'invalid-course', got '%s'", slugErr.Slug())
+		}
+
+		fields := make([]string, 0, len(slugErr.Fields()))
+		for _, f := range slugErr.Fields() {
+			fields = append(fields, f.Field())
+		}
+		if strings.Join(fields, ",") != "id,teacherId,title" {
+			t.Errorf("expected fields 'id,teacherId,title', got '%s'", strings.Join(fields, ","))
+		}
+	})
+
 	t.Run("creates course with minimal required fields", func(t *testing.T) {
 		course, err := NewCourse(
 			"course-123",
@@ -140,7 +163,6 @@ func TestNewCourse(t *testing.T) {
 			"Minimal Course",
 			"",
 			"",
-			0,
 			Domain{},
 			nil,
 			0,
@@ -164,7 +186,6 @@ func TestCourse_IsOwnedBy(t *testing.T) {
 		"Learn Go",
 		"",
 		"",
-		0,
 		DomainProgramming,
 		nil,
 		0,
@@ -192,7 +213,6 @@ func TestCourse_HasTag(t *testing.T) {
 		"Learn Go",
 		"",
 		"",
-		0,
 		DomainProgramming,
 		[]Tag{TagBackend, TagAPI, TagCloud},
 		0,
@@ -223,7 +243,6 @@ func TestCourse_UpdateBasicInfo(t *testing.T) {
 		"Original Title",
 		"Original Description",
 		"original.jpg",
-		3600,
 		DomainProgramming,
 		nil,
 		0,
@@ -281,59 +300,6 @@ func TestCourse_UpdateBasicInfo(t *testing.T) {
 	})
 }
 
-func TestCourse_UpdateDuration(t *testing.T) {
-	t.Parallel()
-	course, _ := NewCourse(
-		"course-123",
-		"teacher-456",
-		"Learn Go",
-		"",
-		"",
-		3600,
-		DomainProgramming,
-		nil,
-		0,
-		Beginner,
-	)
-
-	t.Run("successfully updates duration", func(t *testing.T) {
-		err := course.UpdateDuration(7200)
-
-		if err != nil {
-			t.Fatalf("expected no error, got %v", err)
-		}
-		if course.Duration() != 7200 {
-			t.Errorf("expected duration 7200, got %d", course.Duration())
-		}
-	})
-
-	t.Run("allows zero duration", func(t *testing.T) {
-		err := course.UpdateDuration(0)
-
-		if err != nil {
-			t.Fatalf("expected no error for zero duration, got %v", err)
-		}
-		if course.Duration() != 0 {
-			t.Errorf("expected duration 0, got %d", course.Duration())
-		}
-	})
-
-	t.Run("fails when duration is negative", func(t *testing.T) {
-		originalDuration := course.Duration()
-		err := course.UpdateDuration(-100)
-
-		if err == nil {
-			t.Fatal("expected error for negative duration, got nil")
-		}
-		if err.Error() != "duration cannot be negative" {
-			t.Errorf("expected error 'duration cannot be negative', got '%s'", err.Error())
-		}
-		if course.Duration() != originalDuration {
-			t.Error("expected duration to remain unchanged after failed update")
-		}
-	})
-}
-
 func TestCourse_UpdateRating(t *testing.T) {
 	t.Parallel()
 	course, _ := NewCourse(
@@ -342,7 +308,6 @@ func TestCourse_UpdateRating(t *testing.T) {
 		"Learn Go",
 		"",
 		"",
-		0,
 		DomainProgramming,
 		nil,
 		4.0,
@@ -421,7 +386,6 @@ func TestCourse_AddTag(t *testing.T) {
 		"Learn Go",
 		"",
 		"",
-		0,
 		DomainProgramming,
 		[]Tag{TagBackend},
 		0,
@@ -478,7 +442,6 @@ func TestCourse_RemoveTag(t *testing.T) {
 		"Learn Go",
 		"",
 		"",
-		0,
 		DomainProgramming,
 		[]Tag{TagBackend, TagAPI, TagCloud},
 		0,
@@ -523,3 +486,78 @@ func TestCourse_RemoveTag(t *testing.T) {
 		}
 	})
 }
+
+func TestCourse_ChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	newDraft := func(t *testing.T) *Course {
+		t.Helper()
+		c, err := NewCourse("course-123", "teacher-456", "Course", "", "", DomainProgramming, nil, 0, Beginner)
+		if err != nil {
+			t.Fatalf("failed to create course: %v", err)
+		}
+		return c
+	}
+
+	t.Run("new course is a draft", func(t *testing.T) {
+		c := newDraft(t)
+		if c.Status() != StatusDraft {
+			t.Errorf("expected status draft, got %s", c.Status())
+		}
+		if c.IsPublished() {
+			t.Error("expected draft not to be published")
+		}
+	})
+
+	t.Run("walks the publishing workflow", func(t *testing.T) {
+		c := newDraft(t)
+		for _, to := range []CourseStatus{StatusInReview, StatusPublished, StatusArchived} {
+			if err := c.ChangeStatus(to); err != nil {
+				t.Fatalf("expected no error moving to %s, got %v", to, err)
+			}
+			if c.Status() != to {
+				t.Errorf("expected status %s, got %s", to, c.Status())
+			}
+		}
+	})
+
+	t.Run("rejected course goes back to draft", func(t *testing.T) {
+		c := newDraft(t)
+		_ = c.ChangeStatus(StatusInReview)
+		if err := c.ChangeStatus(StatusDraft); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if c.Status() != StatusDraft {
+			t.Errorf("expected status draft, got %s", c.Status())
+		}
+	})
+
+	t.Run("fails to publish without review", func(t *testing.T) {
+		c := newDraft(t)
+		err := c.ChangeStatus(StatusPublished)
+		if err == nil {
+			t.Fatal("expected error publishing a draft, got nil")
+		}
+
+		var slugErr commonerrors.SlugError
+		if !errors.As(err, &slugErr) || slugErr.ErrorType() != commonerrors.ErrorTypeConflict {
+			t.Errorf("expected conflict error, got %v", err)
+		}
+		if c.Status() != StatusDraft {
+			t.Errorf("expected status to remain draft, got %s", c.Status())
+		}
+	})
+
+	t.Run("archived course is final", func(t *testing.T) {
+		c := newDraft(t)
+		_ = c.ChangeStatus(StatusInReview)
+		_ = c.ChangeStatus(StatusPublished)
+		_ = c.ChangeStatus(StatusArchived)
+
+		for _, to := range courseStatusValues {
+			if err := c.ChangeStatus(to); err == nil {
+				t.Errorf("expected error moving archived course to %s, got nil", to)
+			}
+		}
+	})
+}
@@ -289,3 +289,24 @@ func TestNewTagFromString(t *testing.T) {
 		}
 	})
 }
+
+func TestNewCourseStatusFromString(t *testing.T) {
+	t.Parallel()
+	t.Run("parses every status", func(t *testing.T) {
+		for _, expected := range []string{"draft", "in_review", "published", "archived"} {
+			status, err := NewCourseStatusFromString(expected)
+			if err != nil {
+				t.Fatalf("expected no error for '%s', got %v", expected, err)
+			}
+			if status.String() != expected {
+				t.Errorf("expected '%s', got '%s'", expected, status.String())
+			}
+		}
+	})
+
+	t.Run("fails for unknown status", func(t *testing.T) {
+		if _, err := NewCourseStatusFromString("live"); err == nil {
+			t.Fatal("expected error for unknown status, got nil")
+		}
+	})
+}
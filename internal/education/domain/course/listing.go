@@ -0,0 +1,151 @@
+package course
+
+import (
+	"strings"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// ListSort enum. A leading '-' sorts descending. The course ID breaks ties,
+// so every sort gives a stable order that can be paged by keyset.
+var (
+	SortCreatedAtAsc  = ListSort{s: "created_at"}
+	SortCreatedAtDesc = ListSort{s: "-created_at"}
+	SortTitleAsc      = ListSort{s: "title"}
+	SortTitleDesc     = ListSort{s: "-title"}
+	SortRatingAsc     = ListSort{s: "rating"}
+	SortRatingDesc    = ListSort{s: "-rating"}
+	SortDurationAsc   = ListSort{s: "duration"}
+	SortDurationDesc  = ListSort{s: "-duration"}
+)
+
+var listSortValues = []ListSort{
+	SortCreatedAtAsc,
+	SortCreatedAtDesc,
+	SortTitleAsc,
+	SortTitleDesc,
+	SortRatingAsc,
+	SortRatingDesc,
+	SortDurationAsc,
+	SortDurationDesc,
+}
+
+type ListSort struct {
+	s string
+}
+
+func (s ListSort) String() string {
+	return s.s
+}
+
+// Field is the course attribute the listing is ordered by
+func (s ListSort) Field() string {
+	return strings.TrimPrefix(s.s, "-")
+}
+
+func (s ListSort) Descending() bool {
+	return strings.HasPrefix(s.s, "-")
+}
+
+func NewListSortFromString(sortStr string) (ListSort, error) {
+	for _, sort := range listSortValues {
+		if sort.String() == sortStr {
+			return sort, nil
+		}
+	}
+	return ListSort{}, errors.Errorf("unknown '%s' sort", sortStr)
+}
+
+// ListFilter narrows a course listing. Nil and empty fields do not filter.
+type ListFilter struct {
+	Domain      *Domain
+	Level       *CourseLevel
+	Status      *CourseStatus
+	Tags        []Tag // a course must have every tag
+	TeacherID   string
+	MinRating   *float64
+	MaxRating   *float64
+	MinDuration *int
+	MaxDuration *int
+}
+
+// ListSpec describes one page of a filtered and sorted course listing
+type ListSpec struct {
+	filter ListFilter
+	sort   ListSort
+	limit  int
+	cursor string
+}
+
+// NewListSpec validates a listing request. A zero limit and sort fall back to
+// DefaultListLimit and newest first. The cursor is opaque to the domain and is
+// interpreted by the repository that issued it.
+func NewListSpec(filter ListFilter, sort ListSort, limit int, cursor string) (ListSpec, error) {
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if sort == (ListSort{}) {
+		sort = SortCreatedAtDesc
+	}
+
+	var v commonerrors.Validation
+	if limit < 0 || limit > MaxListLimit {
+		v.Add("limit", "limit must be between 1 and 100")
+	}
+	if filter.MinRating != nil && (*filter.MinRating < 0 || *filter.MinRating > 5) {
+		v.Add("minRating", "rating must be between 0 and 5")
+	}
+	if filter.MaxRating != nil && (*filter.MaxRating < 0 || *filter.MaxRating > 5) {
+		v.Add("maxRating", "rating must be between 0 and 5")
+	}
+	if filter.MinRating != nil && filter.MaxRating != nil && *filter.MinRating > *filter.MaxRating {
+		v.Add("minRating", "minimum rating cannot exceed maximum rating")
+	}
+	if filter.MinDuration != nil && *filter.MinDuration < 0 {
+		v.Add("minDuration", "duration cannot be negative")
+	}
+	if filter.MaxDuration != nil && *filter.MaxDuration < 0 {
+		v.Add("maxDuration", "duration cannot be negative")
+	}
+	if filter.MinDuration != nil && filter.MaxDuration != nil && *filter.MinDuration > *filter.MaxDuration {
+		v.Add("minDuration", "minimum duration cannot exceed maximum duration")
+	}
+	if err := v.Err("invalid-course-listing"); err != nil {
+		return ListSpec{}, err
+	}
+
+	return ListSpec{
+		filter: filter,
+		sort:   sort,
+		limit:  limit,
+		cursor: cursor,
+	}, nil
+}
+
+func (s ListSpec) Filter() ListFilter { return s.filter }
+func (s ListSpec) Sort() ListSort     { return s.sort }
+func (s ListSpec) Limit() int         { return s.limit }
+func (s ListSpec) Cursor() string     { return s.cursor }
+
+// ListPage is one page of a course listing. NextCursor is empty on the last page.
+type ListPage struct {
+	courses    []*Course
+	nextCursor string
+}
+
+func NewListPage(courses []*Course, nextCursor string) ListPage {
+	return ListPage{
+		courses:    courses,
+		nextCursor: nextCursor,
+	}
+}
+
+func (p ListPage) Courses() []*Course { return p.courses }
+func (p ListPage) NextCursor() string { return p.nextCursor }
+func (p ListPage) HasMore() bool      { return p.nextCursor != "" }
@@ -0,0 +1,73 @@
+package course
+
+import (
+	"testing"
+)
+
+func TestNewListSpec(t *testing.T) {
+	t.Parallel()
+	t.Run("applies default limit and sort", func(t *testing.T) {
+		spec, err := NewListSpec(ListFilter{}, ListSort{}, 0, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if spec.Limit() != DefaultListLimit {
+			t.Errorf("expected limit %d, got %d", DefaultListLimit, spec.Limit())
+		}
+		if spec.Sort() != SortCreatedAtDesc {
+			t.Errorf("expected sort '-created_at', got '%s'", spec.Sort().String())
+		}
+	})
+
+	t.Run("fails when limit exceeds maximum", func(t *testing.T) {
+		_, err := NewListSpec(ListFilter{}, SortTitleAsc, MaxListLimit+1, "")
+		if err == nil {
+			t.Fatal("expected error for limit above maximum, got nil")
+		}
+		if err.Error() != "limit must be between 1 and 100" {
+			t.Errorf("expected error 'limit must be between 1 and 100', got '%s'", err.Error())
+		}
+	})
+
+	t.Run("fails when rating range is inverted", func(t *testing.T) {
+		minRating, maxRating := 4.0, 3.0
+		_, err := NewListSpec(ListFilter{MinRating: &minRating, MaxRating: &maxRating}, SortRatingDesc, 10, "")
+		if err == nil {
+			t.Fatal("expected error for inverted rating range, got nil")
+		}
+		if err.Error() != "minimum rating cannot exceed maximum rating" {
+			t.Errorf("expected error 'minimum rating cannot exceed maximum rating', got '%s'", err.Error())
+		}
+	})
+
+	t.Run("fails when duration is negative", func(t *testing.T) {
+		minDuration := -1
+		_, err := NewListSpec(ListFilter{MinDuration: &minDuration}, SortDurationAsc, 10, "")
+		if err == nil {
+			t.Fatal("expected error for negative duration, got nil")
+		}
+		if err.Error() != "duration cannot be negative" {
+			t.Errorf("expected error 'duration cannot be negative', got '%s'", err.Error())
+		}
+	})
+}
+
+func TestNewListSortFromString(t *testing.T) {
+	t.Parallel()
+	t.Run("parses descending sort", func(t *testing.T) {
+		sort, err := NewListSortFromString("-rating")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if sort.Field() != "rating" || !sort.Descending() {
+			t.Errorf("expected descending rating sort, got '%s'", sort.String())
+		}
+	})
+
+	t.Run("fails for unknown sort", func(t *testing.T) {
+		_, err := NewListSortFromString("price")
+		if err == nil {
+			t.Fatal("expected error for unknown sort, got nil")
+		}
+	})
+}
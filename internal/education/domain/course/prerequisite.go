@@ -0,0 +1,150 @@
+package course
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// Prerequisite is a course students should take before this one. Required
+// prerequisites gate enrollment and are reserved for advanced courses,
+// recommended ones are only shown to students.
+type Prerequisite struct {
+	courseID string
+	required bool
+}
+
+func NewPrerequisite(courseID string, required bool) (Prerequisite, error) {
+	if courseID == "" {
+		return Prerequisite{}, commonerrors.NewValidationError("invalid-prerequisite", commonerrors.NewFieldError("courseId", "prerequisite course id is required"))
+	}
+
+	return Prerequisite{courseID: courseID, required: required}, nil
+}
+
+func (p Prerequisite) CourseID() string { return p.courseID }
+func (p Prerequisite) Required() bool   { return p.required }
+
+// PrerequisiteWaiver lets a student enroll in a course without having
+// completed its required prerequisites. Waivers are granted by the course
+// owner or an admin.
+type PrerequisiteWaiver struct {
+	courseID  string
+	userID    string
+	grantedBy string
+	grantedAt time.Time
+}
+
+func NewPrerequisiteWaiver(courseID string, userID string, grantedBy string) (PrerequisiteWaiver, error) {
+	var v commonerrors.Validation
+	if courseID == "" {
+		v.Add("courseId", "course id is required")
+	}
+	if userID == "" {
+		v.Add("userId", "user id is required")
+	}
+	if grantedBy == "" {
+		v.Add("grantedBy", "granting user id is required")
+	}
+	if err := v.Err("invalid-prerequisite-waiver"); err != nil {
+		return PrerequisiteWaiver{}, err
+	}
+
+	return PrerequisiteWaiver{
+		courseID:  courseID,
+		userID:    userID,
+		grantedBy: grantedBy,
+		grantedAt: time.Now(),
+	}, nil
+}
+
+func (w PrerequisiteWaiver) CourseID() string     { return w.courseID }
+func (w PrerequisiteWaiver) UserID() string       { return w.userID }
+func (w PrerequisiteWaiver) GrantedBy() string    { return w.grantedBy }
+func (w PrerequisiteWaiver) GrantedAt() time.Time { return w.grantedAt }
+
+func (c *Course) Prerequisites() []Prerequisite { return c.prerequisites }
+
+// RequiredPrerequisites returns the IDs of the courses a student has to
+// complete before enrolling.
+func (c *Course) RequiredPrerequisites() []string {
+	var required []string
+	for _, p := range c.prerequisites {
+		if p.required {
+			required = append(required, p.courseID)
+		}
+	}
+	return required
+}
+
+// SetPrerequisites replaces the prerequisites of the course. A course cannot
+// be its own prerequisite, and only advanced courses can require one.
+func (c *Course) SetPrerequisites(prerequisites []Prerequisite) error {
+	var v commonerrors.Validation
+	seen := make(map[string]bool, len(prerequisites))
+	for i, p := range prerequisites {
+		field := fmt.Sprintf("prerequisites[%d].courseId", i)
+		switch {
+		case p.courseID == c.id:
+			v.Add(field, "a course cannot be its own prerequisite")
+		case seen[p.courseID]:
+			v.Add(field, fmt.Sprintf("course '%s' is listed more than once", p.courseID))
+		}
+		if p.required && c.level != Advanced {
+			v.Add(fmt.Sprintf("prerequisites[%d].required", i), "only advanced courses can require prior courses")
+		}
+		seen[p.courseID] = true
+	}
+	if err := v.Err("invalid-prerequisites"); err != nil {
+		return err
+	}
+
+	c.prerequisites = prerequisites
+	return nil
+}
+
+// CheckPrerequisiteCycle rejects prerequisites leading back to the course.
+// transitive holds every course the prerequisites of the course depend on,
+// directly or not.
+func (c *Course) CheckPrerequisiteCycle(transitive []string) error {
+	for _, id := range transitive {
+		if id == c.id {
+			return commonerrors.NewConflictError(
+				fmt.Sprintf("course '%s' would end up as a prerequisite of itself", c.id),
+				"prerequisite-cycle",
+			)
+		}
+	}
+	return nil
+}
+
+// CanChangeLevelTo keeps required prerequisites on advanced courses only.
+func (c *Course) CanChangeLevelTo(level CourseLevel) error {
+	if level != Advanced && len(c.RequiredPrerequisites()) > 0 {
+		return commonerrors.NewConflictError(
+			"the course requires prior courses, make them recommended before leaving the advanced level",
+			"required-prerequisites-need-advanced-level",
+		)
+	}
+	return nil
+}
+
+// CheckPrerequisitesMet lets a student enroll once every required
+// prerequisite is among the courses the student completed.
+func (c *Course) CheckPrerequisitesMet(completed map[string]bool) error {
+	var missing []string
+	for _, id := range c.RequiredPrerequisites() {
+		if !completed[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return commonerrors.NewForbiddenError(
+			fmt.Sprintf("complete the required courses first: %s", strings.Join(missing, ", ")),
+			"prerequisites-not-met",
+		)
+	}
+	return nil
+}
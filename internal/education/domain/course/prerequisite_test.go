@@ -0,0 +1,111 @@
+package course
+
+import (
+	"errors"
+	"testing"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+func TestCourse_SetPrerequisites(t *testing.T) {
+	t.Parallel()
+
+	newCourse := func(t *testing.T, level CourseLevel) *Course {
+		t.Helper()
+		c, err := NewCourse("course-123", "teacher-456", "Course", "", "", DomainProgramming, nil, 0, level)
+		if err != nil {
+			t.Fatalf("failed to create course: %v", err)
+		}
+		return c
+	}
+	prerequisite := func(t *testing.T, courseID string, required bool) Prerequisite {
+		t.Helper()
+		p, err := NewPrerequisite(courseID, required)
+		if err != nil {
+			t.Fatalf("failed to create prerequisite: %v", err)
+		}
+		return p
+	}
+
+	t.Run("advanced course requires prior courses", func(t *testing.T) {
+		c := newCourse(t, Advanced)
+		err := c.SetPrerequisites([]Prerequisite{
+			prerequisite(t, "course-1", true),
+			prerequisite(t, "course-2", false),
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(c.Prerequisites()) != 2 {
+			t.Errorf("expected 2 prerequisites, got %d", len(c.Prerequisites()))
+		}
+		if required := c.RequiredPrerequisites(); len(required) != 1 || required[0] != "course-1" {
+			t.Errorf("expected course-1 to be required, got %v", required)
+		}
+	})
+
+	t.Run("beginner course only recommends prior courses", func(t *testing.T) {
+		c := newCourse(t, Beginner)
+		if err := c.SetPrerequisites([]Prerequisite{prerequisite(t, "course-1", false)}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := c.SetPrerequisites([]Prerequisite{prerequisite(t, "course-1", true)}); err == nil {
+			t.Fatal("expected error requiring a course on a beginner course, got nil")
+		}
+	})
+
+	t.Run("fails on self and duplicate prerequisites", func(t *testing.T) {
+		c := newCourse(t, Advanced)
+		err := c.SetPrerequisites([]Prerequisite{
+			prerequisite(t, "course-123", true),
+			prerequisite(t, "course-1", true),
+			prerequisite(t, "course-1", false),
+		})
+
+		var slugErr commonerrors.SlugError
+		if !errors.As(err, &slugErr) {
+			t.Fatalf("expected slug error, got %v", err)
+		}
+		if len(slugErr.Fields()) != 2 {
+			t.Errorf("expected 2 field errors, got %v", slugErr.Fields())
+		}
+		if len(c.Prerequisites()) != 0 {
+			t.Error("expected prerequisites to remain unchanged after failed update")
+		}
+	})
+
+	t.Run("detects cycles", func(t *testing.T) {
+		c := newCourse(t, Advanced)
+		if err := c.CheckPrerequisiteCycle([]string{"course-1", "course-2"}); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if err := c.CheckPrerequisiteCycle([]string{"course-1", "course-123"}); err == nil {
+			t.Error("expected cycle error, got nil")
+		}
+	})
+
+	t.Run("keeps required prerequisites on advanced courses", func(t *testing.T) {
+		c := newCourse(t, Advanced)
+		_ = c.SetPrerequisites([]Prerequisite{prerequisite(t, "course-1", true)})
+		if err := c.CanChangeLevelTo(Intermediate); err == nil {
+			t.Error("expected error leaving the advanced level, got nil")
+		}
+		if err := c.CanChangeLevelTo(Advanced); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("checks completed prerequisites", func(t *testing.T) {
+		c := newCourse(t, Advanced)
+		_ = c.SetPrerequisites([]Prerequisite{
+			prerequisite(t, "course-1", true),
+			prerequisite(t, "course-2", false),
+		})
+		if err := c.CheckPrerequisitesMet(map[string]bool{"course-2": true}); err == nil {
+			t.Error("expected error with a required course missing, got nil")
+		}
+		if err := c.CheckPrerequisitesMet(map[string]bool{"course-1": true}); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
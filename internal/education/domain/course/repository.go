@@ -10,6 +10,12 @@ type CourseRepository interface {
 	// Update modifies an existing course metadata
 	Update(ctx context.Context, course *Course) error
 
+	// UpdateStatus locks an existing course, changes it with updateFn and
+	// saves its publishing status in one transaction. When the course is
+	// published afterwards, the working copy of its content is frozen as the
+	// next version of the course in the same transaction.
+	UpdateStatus(ctx context.Context, id string, updateFn func(c *Course) error) error
+
 	// Delete removes a course and all associated data (modules, lessons, exercises) via CASCADE
 	Delete(ctx context.Context, id string) error
 
@@ -19,9 +25,32 @@ type CourseRepository interface {
 	// GetAll retrieves all courses
 	GetAll(ctx context.Context) ([]*Course, error)
 
+	// List retrieves one page of courses matching the specification
+	List(ctx context.Context, spec ListSpec) (ListPage, error)
+
 	// GetAllByTeacherID retrieves all courses for a specific teacher
 	GetAllByTeacherID(ctx context.Context, teacherID string) ([]*Course, error)
 
 	// Exists checks if a course with the given ID exists
 	Exists(ctx context.Context, id string) (bool, error)
+
+	// UpdatePrerequisites replaces the prerequisites of an existing course
+	UpdatePrerequisites(ctx context.Context, course *Course) error
+
+	// TransitivePrerequisites returns every course the given courses depend
+	// on through their prerequisites, directly or not
+	TransitivePrerequisites(ctx context.Context, courseIDs []string) ([]string, error)
+}
+
+// PrerequisiteWaiverRepository manages the waivers letting students skip the
+// required prerequisites of a course
+type PrerequisiteWaiverRepository interface {
+	// GrantWaiver saves a waiver, replacing the one the student already had
+	GrantWaiver(ctx context.Context, waiver PrerequisiteWaiver) error
+
+	// RevokeWaiver removes the waiver of a student, if any
+	RevokeWaiver(ctx context.Context, courseID string, userID string) error
+
+	// HasWaiver checks if the student was let in without the prerequisites
+	HasWaiver(ctx context.Context, courseID string, userID string) (bool, error)
 }
@@ -0,0 +1,54 @@
+package course
+
+import "github.com/pkg/errors"
+
+// CourseStatus enum. A course is written as a draft, submitted for review,
+// published once an admin approves it and finally archived.
+var (
+	StatusDraft     = CourseStatus{s: "draft"}
+	StatusInReview  = CourseStatus{s: "in_review"}
+	StatusPublished = CourseStatus{s: "published"}
+	StatusArchived  = CourseStatus{s: "archived"}
+)
+
+var courseStatusValues = []CourseStatus{
+	StatusDraft,
+	StatusInReview,
+	StatusPublished,
+	StatusArchived,
+}
+
+// statusTransitions lists the statuses a course may move to from each status.
+// A course in review goes back to draft when it is rejected or withdrawn.
+var statusTransitions = map[CourseStatus][]CourseStatus{
+	StatusDraft:     {StatusInReview},
+	StatusInReview:  {StatusPublished, StatusDraft},
+	StatusPublished: {StatusArchived},
+	StatusArchived:  {},
+}
+
+type CourseStatus struct {
+	s string
+}
+
+func (s CourseStatus) String() string {
+	return s.s
+}
+
+func (s CourseStatus) CanTransitionTo(to CourseStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+func NewCourseStatusFromString(statusStr string) (CourseStatus, error) {
+	for _, status := range courseStatusValues {
+		if status.String() == statusStr {
+			return status, nil
+		}
+	}
+	return CourseStatus{}, errors.Errorf("unknown '%s' course status", statusStr)
+}
@@ -1,8 +1,10 @@
 package enrollment
 
 import (
+	"fmt"
 	"time"
 
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 	"github.com/pkg/errors"
 )
 
@@ -10,6 +12,7 @@ type Enrollment struct {
 	id             string
 	userID         string
 	courseID       string
+	contentVersion int
 	enrolledAt     time.Time
 	startedAt      time.Time
 	completedAt    time.Time
@@ -18,21 +21,32 @@ type Enrollment struct {
 	lessonProgress []LessonProgress
 }
 
-func NewEnrollment(id string, userID string, courseID string) (*Enrollment, error) {
+// NewEnrollment enrolls a student in a course. The enrollment is pinned to the
+// content version that is current at enrollment time, so later edits of the
+// course do not shift the lessons under the student.
+func NewEnrollment(id string, userID string, courseID string, contentVersion int) (*Enrollment, error) {
+	var v commonerrors.Validation
 	if id == "" {
-		return nil, errors.New("enrollment id is required")
+		v.Add("id", "enrollment id is required")
 	}
 	if userID == "" {
-		return nil, errors.New("user id is required")
+		v.Add("userId", "user id is required")
 	}
 	if courseID == "" {
-		return nil, errors.New("course id is required")
+		v.Add("courseId", "course id is required")
+	}
+	if contentVersion < 1 {
+		v.Add("contentVersion", "content version must be at least 1")
+	}
+	if err := v.Err("invalid-enrollment"); err != nil {
+		return nil, err
 	}
 
 	return &Enrollment{
 		id:             id,
 		userID:         userID,
 		courseID:       courseID,
+		contentVersion: contentVersion,
 		enrolledAt:     time.Now(),
 		startedAt:      time.Time{}, // Not started yet
 		completedAt:    time.Time{}, // Not completed yet
@@ -42,10 +56,40 @@ func NewEnrollment(id string, userID string, courseID string) (*Enrollment, erro
 	}, nil
 }
 
+// UnmarshalEnrollmentFromDatabase restores an enrollment from persisted state.
+// It should only be used by repositories.
+func UnmarshalEnrollmentFromDatabase(
+	id string,
+	userID string,
+	courseID string,
+	contentVersion int,
+	enrolledAt time.Time,
+	startedAt time.Time,
+	completedAt time.Time,
+	courseProgress CourseProgress,
+	moduleProgress []ModuleProgress,
+	lessonProgress []LessonProgress,
+) (*Enrollment, error) {
+	e, err := NewEnrollment(id, userID, courseID, contentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	e.enrolledAt = enrolledAt
+	e.startedAt = startedAt
+	e.completedAt = completedAt
+	e.courseProgress = courseProgress
+	e.moduleProgress = moduleProgress
+	e.lessonProgress = lessonProgress
+
+	return e, nil
+}
+
 // Getters (read-only access for serialization/display)
 func (e *Enrollment) ID() string                       { return e.id }
 func (e *Enrollment) UserID() string                   { return e.userID }
 func (e *Enrollment) CourseID() string                 { return e.courseID }
+func (e *Enrollment) ContentVersion() int              { return e.contentVersion }
 func (e *Enrollment) EnrolledAt() time.Time            { return e.enrolledAt }
 func (e *Enrollment) StartedAt() time.Time             { return e.startedAt }
 func (e *Enrollment) CompletedAt() time.Time           { return e.completedAt }
@@ -53,11 +97,16 @@ func (e *Enrollment) CourseProgress() CourseProgress   { return e.courseProgress
 func (e *Enrollment) ModuleProgress() []ModuleProgress { return e.moduleProgress }
 func (e *Enrollment) LessonProgress() []LessonProgress { return e.lessonProgress }
 
-// !!! Change in the future
 // Behavior methods
-func (e *Enrollment) CompleteLesson(lessonID string) error {
+
+// CompleteLesson marks the lesson as completed and recomputes module and
+// course progress from the course outline.
+func (e *Enrollment) CompleteLesson(lessonID string, outline Outline) error {
 	if lessonID == "" {
-		return errors.New("lesson id is required")
+		return commonerrors.NewValidationError("invalid-lesson", commonerrors.NewFieldError("lessonId", "lesson id is required"))
+	}
+	if _, err := outline.ModuleOf(lessonID); err != nil {
+		return err
 	}
 
 	// Find or create lesson progress
@@ -82,9 +131,215 @@ func (e *Enrollment) CompleteLesson(lessonID string) error {
 		e.startedAt = time.Now()
 	}
 
+	e.RecalculateProgress(outline)
+
+	return nil
+}
+
+// RecalculateProgress derives module and course progress from the completed
+// lessons. Module progress is kept for every module the student has started.
+// The course moves Enrolled -> Started -> InProgress -> Completed, and
+// completedAt is stamped once every lesson of the course is done.
+func (e *Enrollment) RecalculateProgress(outline Outline) {
+	completed := make(map[string]bool, len(e.lessonProgress))
+	touched := make(map[string]bool, len(e.lessonProgress))
+	for _, lp := range e.lessonProgress {
+		touched[lp.LessonID()] = true
+		if lp.Progress().Status() == Completed {
+			completed[lp.LessonID()] = true
+		}
+	}
+
+	var allLessons []OutlineLesson
+	moduleProgress := make([]ModuleProgress, 0, len(outline.modules))
+	for _, m := range outline.modules {
+		allLessons = append(allLessons, m.lessons...)
+
+		started := false
+		for _, l := range m.lessons {
+			if touched[l.lessonID] {
+				started = true
+				break
+			}
+		}
+		if !started {
+			continue
+		}
+
+		percentage := outline.percentage(m.lessons, completed)
+		moduleProgress = append(moduleProgress, ModuleProgress{
+			moduleID: m.moduleID,
+			progress: NewProgress(percentage, statusFor(percentage, Started)),
+		})
+	}
+	e.moduleProgress = moduleProgress
+
+	percentage := outline.percentage(allLessons, completed)
+	initial := Enrolled
+	if !e.startedAt.IsZero() {
+		initial = Started
+	}
+	status := statusFor(percentage, initial)
+	e.courseProgress = CourseProgress{progress: NewProgress(percentage, status)}
+
+	switch {
+	case status == Completed && e.completedAt.IsZero():
+		e.completedAt = time.Now()
+	case status != Completed:
+		e.completedAt = time.Time{}
+	}
+}
+
+// UpgradeContent moves the enrollment to a newer content version. Lesson
+// progress is carried over by lesson ID: lessons kept in the new outline keep
+// their completion and exercise scores, lessons removed from it are dropped,
+// and module and course progress are recomputed from what remains.
+func (e *Enrollment) UpgradeContent(contentVersion int, outline Outline) error {
+	if contentVersion <= e.contentVersion {
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("enrollment is already on content version %d", e.contentVersion),
+			"content-already-up-to-date",
+		)
+	}
+
+	lessonProgress := make([]LessonProgress, 0, len(e.lessonProgress))
+	for _, lp := range e.lessonProgress {
+		if _, err := outline.ModuleOf(lp.LessonID()); err != nil {
+			continue
+		}
+		lessonProgress = append(lessonProgress, lp)
+	}
+
+	e.contentVersion = contentVersion
+	e.lessonProgress = lessonProgress
+	e.RecalculateProgress(outline)
+
 	return nil
 }
 
+// RecordExerciseScore stores the score of a graded lesson attempt. Only the
+// best score across attempts is kept.
+func (e *Enrollment) RecordExerciseScore(lessonID string, score float64) error {
+	var v commonerrors.Validation
+	if lessonID == "" {
+		v.Add("lessonId", "lesson id is required")
+	}
+	if score < 0 || score > 100 {
+		v.Add("score", "score must be between 0 and 100")
+	}
+	if err := v.Err("invalid-exercise-score"); err != nil {
+		return err
+	}
+
+	for i, lp := range e.lessonProgress {
+		if lp.LessonID() == lessonID {
+			if score > lp.ExerciseScore() {
+				e.lessonProgress[i].UpdateExerciseScore(score)
+			}
+			return nil
+		}
+	}
+
+	newLessonProgress := NewLessonProgress(lessonID)
+	newLessonProgress.UpdateExerciseScore(score)
+	e.lessonProgress = append(e.lessonProgress, newLessonProgress)
+
+	// Start the course if not started
+	if e.startedAt.IsZero() {
+		e.startedAt = time.Now()
+		if e.courseProgress.Progress().Status() == Enrolled {
+			e.courseProgress = CourseProgress{progress: NewProgress(e.courseProgress.Progress().ProgressPercentage(), Started)}
+		}
+	}
+
+	return nil
+}
+
+// StartAttempt counts a new attempt at the exercises of a lesson. It returns
+// a conflict error once the lesson has no attempts left.
+func (e *Enrollment) StartAttempt(lessonID string, outline Outline) error {
+	l, ok := outline.lesson(lessonID)
+	if !ok {
+		return commonerrors.NewIncorrectInputError(fmt.Sprintf("lesson '%s' does not belong to the course", lessonID), "lesson-not-in-course")
+	}
+
+	for i, lp := range e.lessonProgress {
+		if lp.LessonID() != lessonID {
+			continue
+		}
+		if l.maxAttempts > 0 && lp.Attempts() >= l.maxAttempts {
+			return commonerrors.NewConflictError(
+				fmt.Sprintf("lesson '%s' allows at most %d attempts", lessonID, l.maxAttempts),
+				"max-attempts-reached",
+			)
+		}
+		e.lessonProgress[i].countAttempt()
+		return nil
+	}
+
+	newLessonProgress := NewLessonProgress(lessonID)
+	newLessonProgress.countAttempt()
+	e.lessonProgress = append(e.lessonProgress, newLessonProgress)
+
+	// Start the course if not started
+	if e.startedAt.IsZero() {
+		e.startedAt = time.Now()
+		if e.courseProgress.Progress().Status() == Enrolled {
+			e.courseProgress = CourseProgress{progress: NewProgress(e.courseProgress.Progress().ProgressPercentage(), Started)}
+		}
+	}
+
+	return nil
+}
+
+// CheckLessonPassed returns a conflict error when the lesson has a pass score
+// the student has not reached yet on its exercises.
+func (e *Enrollment) CheckLessonPassed(lessonID string, outline Outline) error {
+	l, ok := outline.lesson(lessonID)
+	if !ok || l.passScore == 0 {
+		return nil
+	}
+
+	if e.bestScore(lessonID) < l.passScore {
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("lesson '%s' requires an exercise score of at least %.2f to be completed", lessonID, l.passScore),
+			"pass-score-not-reached",
+		)
+	}
+	return nil
+}
+
+// CompleteIfPassed completes a lesson with a pass score once the best
+// exercise score reaches it, and reports whether it did. Lessons without a
+// pass score, locked lessons and lessons already completed are left alone.
+func (e *Enrollment) CompleteIfPassed(lessonID string, outline Outline) (bool, error) {
+	l, ok := outline.lesson(lessonID)
+	if !ok || l.passScore == 0 || e.bestScore(lessonID) < l.passScore {
+		return false, nil
+	}
+	if _, locked := e.LessonLock(lessonID, outline); locked {
+		return false, nil
+	}
+	if lp, err := e.GetLessonProgress(lessonID); err == nil && lp.Progress().Status() == Completed {
+		return false, nil
+	}
+
+	if err := e.CompleteLesson(lessonID, outline); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bestScore returns the best exercise score recorded on the lesson.
+func (e *Enrollment) bestScore(lessonID string) float64 {
+	for _, lp := range e.lessonProgress {
+		if lp.LessonID() == lessonID {
+			return lp.ExerciseScore()
+		}
+	}
+	return 0
+}
+
 func (e *Enrollment) GetLessonProgress(lessonID string) (*LessonProgress, error) {
 	for _, lp := range e.lessonProgress {
 		if lp.LessonID() == lessonID {
@@ -0,0 +1,414 @@
+package enrollment
+
+import (
+	"testing"
+)
+
+func newTestOutline(weightByDuration bool) Outline {
+	return NewOutline([]OutlineModule{
+		NewOutlineModule("module-1", []OutlineLesson{
+			NewOutlineLesson("lesson-1", 10),
+			NewOutlineLesson("lesson-2", 30),
+		}),
+		NewOutlineModule("module-2", []OutlineLesson{
+			NewOutlineLesson("lesson-3", 60),
+		}),
+	}, weightByDuration)
+}
+
+func TestEnrollment_CompleteLesson(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new enrollment is enrolled without progress", func(t *testing.T) {
+		e, err := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if e.CourseProgress().Progress().Status() != Enrolled {
+			t.Errorf("expected status enrolled, got %s", e.CourseProgress().Progress().Status())
+		}
+		if !e.StartedAt().IsZero() {
+			t.Error("expected startedAt to be zero")
+		}
+		if e.ContentVersion() != 1 {
+			t.Errorf("expected content version 1, got %d", e.ContentVersion())
+		}
+	})
+
+	t.Run("fails without a content version", func(t *testing.T) {
+		if _, err := NewEnrollment("enrollment-1", "user-1", "course-1", 0); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rolls progress up to module and course", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if err := e.CompleteLesson("lesson-1", newTestOutline(false)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if e.StartedAt().IsZero() {
+			t.Error("expected startedAt to be set")
+		}
+		if len(e.ModuleProgress()) != 1 {
+			t.Fatalf("expected 1 module progress, got %d", len(e.ModuleProgress()))
+		}
+		mp := e.ModuleProgress()[0]
+		if mp.ModuleID() != "module-1" {
+			t.Errorf("expected module-1, got %s", mp.ModuleID())
+		}
+		if mp.Progress().ProgressPercentage() != 50 {
+			t.Errorf("expected module progress 50, got %v", mp.Progress().ProgressPercentage())
+		}
+		if mp.Progress().Status() != InProgress {
+			t.Errorf("expected module status in_progress, got %s", mp.Progress().Status())
+		}
+
+		cp := e.CourseProgress().Progress()
+		if cp.ProgressPercentage() < 33.33 || cp.ProgressPercentage() > 33.34 {
+			t.Errorf("expected course progress ~33.33, got %v", cp.ProgressPercentage())
+		}
+		if cp.Status() != InProgress {
+			t.Errorf("expected course status in_progress, got %s", cp.Status())
+		}
+		if e.IsCompleted() {
+			t.Error("expected enrollment not to be completed")
+		}
+	})
+
+	t.Run("weights progress by lesson duration", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if err := e.CompleteLesson("lesson-2", newTestOutline(true)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if got := e.ModuleProgress()[0].Progress().ProgressPercentage(); got != 75 {
+			t.Errorf("expected module progress 75, got %v", got)
+		}
+		if got := e.CourseProgress().Progress().ProgressPercentage(); got != 30 {
+			t.Errorf("expected course progress 30, got %v", got)
+		}
+	})
+
+	t.Run("falls back to lesson count when no lesson has a duration", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := NewOutline([]OutlineModule{
+			NewOutlineModule("module-1", []OutlineLesson{
+				NewOutlineLesson("lesson-1", 0),
+				NewOutlineLesson("lesson-2", 0),
+			}),
+		}, true)
+
+		if err := e.CompleteLesson("lesson-1", outline); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if got := e.CourseProgress().Progress().ProgressPercentage(); got != 50 {
+			t.Errorf("expected course progress 50, got %v", got)
+		}
+	})
+
+	t.Run("completes the course when the last lesson is done", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newTestOutline(false)
+
+		for _, lessonID := range []string{"lesson-1", "lesson-2", "lesson-3"} {
+			if err := e.CompleteLesson(lessonID, outline); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		cp := e.CourseProgress().Progress()
+		if cp.ProgressPercentage() != 100 {
+			t.Errorf("expected course progress 100, got %v", cp.ProgressPercentage())
+		}
+		if cp.Status() != Completed {
+			t.Errorf("expected course status completed, got %s", cp.Status())
+		}
+		if !e.IsCompleted() {
+			t.Error("expected completedAt to be set")
+		}
+		for _, mp := range e.ModuleProgress() {
+			if mp.Progress().Status() != Completed {
+				t.Errorf("expected module %s to be completed, got %s", mp.ModuleID(), mp.Progress().Status())
+			}
+		}
+	})
+
+	t.Run("completing a lesson twice is idempotent", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newTestOutline(false)
+
+		_ = e.CompleteLesson("lesson-3", outline)
+		_ = e.CompleteLesson("lesson-3", outline)
+
+		if len(e.LessonProgress()) != 1 {
+			t.Errorf("expected 1 lesson progress, got %d", len(e.LessonProgress()))
+		}
+	})
+
+	t.Run("fails when lesson does not belong to the course", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		err := e.CompleteLesson("lesson-unknown", newTestOutline(false))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if err.Error() != "lesson 'lesson-unknown' does not belong to the course" {
+			t.Errorf("unexpected error message: %v", err)
+		}
+		if len(e.LessonProgress()) != 0 {
+			t.Errorf("expected no lesson progress, got %d", len(e.LessonProgress()))
+		}
+	})
+
+	t.Run("fails when lesson id is empty", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if err := e.CompleteLesson("", newTestOutline(false)); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestEnrollment_RecordExerciseScore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps the best score", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		for _, score := range []float64{50, 100, 25} {
+			if err := e.RecordExerciseScore("lesson-1", score); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		lp, err := e.GetLessonProgress("lesson-1")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lp.ExerciseScore() != 100 {
+			t.Errorf("expected score 100, got %v", lp.ExerciseScore())
+		}
+		if lp.Progress().Status() != Started {
+			t.Errorf("expected lesson status started, got %s", lp.Progress().Status())
+		}
+		if e.CourseProgress().Progress().Status() != Started {
+			t.Errorf("expected course status started, got %s", e.CourseProgress().Progress().Status())
+		}
+	})
+
+	t.Run("fails when score is out of range", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if err := e.RecordExerciseScore("lesson-1", 101); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestEnrollment_PassScore(t *testing.T) {
+	t.Parallel()
+
+	newPassOutline := func() Outline {
+		return NewOutline([]OutlineModule{
+			NewOutlineModule("module-1", []OutlineLesson{
+				NewOutlineLesson("lesson-1", 10).WithPassScore(70),
+				NewOutlineLesson("lesson-2", 10),
+			}),
+		}, false)
+	}
+
+	t.Run("refuses to complete a lesson below its pass score", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		_ = e.RecordExerciseScore("lesson-1", 60)
+
+		if err := e.CheckLessonPassed("lesson-1", newPassOutline()); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		completed, err := e.CompleteIfPassed("lesson-1", newPassOutline())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if completed {
+			t.Error("expected lesson to stay incomplete")
+		}
+	})
+
+	t.Run("completes a lesson once its pass score is reached", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		_ = e.RecordExerciseScore("lesson-1", 70)
+
+		if err := e.CheckLessonPassed("lesson-1", newPassOutline()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		completed, err := e.CompleteIfPassed("lesson-1", newPassOutline())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !completed {
+			t.Fatal("expected lesson to be completed")
+		}
+		if e.CourseProgress().Progress().ProgressPercentage() != 50 {
+			t.Errorf("expected course progress 50, got %v", e.CourseProgress().Progress().ProgressPercentage())
+		}
+	})
+
+	t.Run("leaves lessons without a pass score to the student", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		_ = e.RecordExerciseScore("lesson-2", 100)
+
+		if err := e.CheckLessonPassed("lesson-2", newPassOutline()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if completed, _ := e.CompleteIfPassed("lesson-2", newPassOutline()); completed {
+			t.Error("expected lesson without pass score not to be completed automatically")
+		}
+	})
+}
+
+func TestEnrollment_StartAttempt(t *testing.T) {
+	t.Parallel()
+
+	newCappedOutline := func() Outline {
+		return NewOutline([]OutlineModule{
+			NewOutlineModule("module-1", []OutlineLesson{
+				NewOutlineLesson("lesson-1", 10).WithMaxAttempts(2),
+				NewOutlineLesson("lesson-2", 10),
+			}),
+		}, false)
+	}
+
+	t.Run("counts attempts up to the cap", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		for i := 0; i < 2; i++ {
+			if err := e.StartAttempt("lesson-1", newCappedOutline()); err != nil {
+				t.Fatalf("expected attempt %d to start, got %v", i+1, err)
+			}
+		}
+		if err := e.StartAttempt("lesson-1", newCappedOutline()); err == nil {
+			t.Fatal("expected error once attempts ran out, got nil")
+		}
+
+		lp, err := e.GetLessonProgress("lesson-1")
+		if err != nil {
+			t.Fatalf("expected lesson progress, got %v", err)
+		}
+		if lp.Attempts() != 2 {
+			t.Errorf("expected 2 attempts, got %d", lp.Attempts())
+		}
+	})
+
+	t.Run("lessons without a cap allow any number of attempts", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		for i := 0; i < 5; i++ {
+			if err := e.StartAttempt("lesson-2", newCappedOutline()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+	})
+
+	t.Run("fails for a lesson outside the course", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if err := e.StartAttempt("lesson-9", newCappedOutline()); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestEnrollment_UpgradeContent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("carries progress over by lesson id", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newTestOutline(false)
+		_ = e.CompleteLesson("lesson-1", outline)
+		_ = e.CompleteLesson("lesson-3", outline)
+		_ = e.RecordExerciseScore("lesson-1", 80)
+
+		// Version 2 drops lesson-3 and adds lesson-4
+		upgraded := NewOutline([]OutlineModule{
+			NewOutlineModule("module-1", []OutlineLesson{
+				NewOutlineLesson("lesson-1", 10),
+				NewOutlineLesson("lesson-2", 30),
+			}),
+			NewOutlineModule("module-2", []OutlineLesson{
+				NewOutlineLesson("lesson-4", 60),
+			}),
+		}, false)
+
+		if err := e.UpgradeContent(2, upgraded); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if e.ContentVersion() != 2 {
+			t.Errorf("expected content version 2, got %d", e.ContentVersion())
+		}
+		if len(e.LessonProgress()) != 1 {
+			t.Fatalf("expected 1 lesson progress, got %d", len(e.LessonProgress()))
+		}
+		lp, err := e.GetLessonProgress("lesson-1")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lp.Progress().Status() != Completed || lp.ExerciseScore() != 80 {
+			t.Errorf("expected lesson-1 completed with score 80, got %s with %v", lp.Progress().Status(), lp.ExerciseScore())
+		}
+		if len(e.ModuleProgress()) != 1 {
+			t.Errorf("expected 1 module progress, got %d", len(e.ModuleProgress()))
+		}
+		if got := e.CourseProgress().Progress().ProgressPercentage(); got < 33.33 || got > 33.34 {
+			t.Errorf("expected course progress ~33.33, got %v", got)
+		}
+	})
+
+	t.Run("reopens a completed course when lessons are added", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newTestOutline(false)
+		for _, lessonID := range []string{"lesson-1", "lesson-2", "lesson-3"} {
+			_ = e.CompleteLesson(lessonID, outline)
+		}
+
+		upgraded := NewOutline(append(outline.Modules(), NewOutlineModule("module-3", []OutlineLesson{
+			NewOutlineLesson("lesson-4", 0),
+		})), false)
+
+		if err := e.UpgradeContent(2, upgraded); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if e.IsCompleted() {
+			t.Error("expected enrollment not to be completed")
+		}
+	})
+
+	t.Run("fails when the version is not newer", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 2)
+
+		if err := e.UpgradeContent(2, newTestOutline(false)); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestNewStatusFromString(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []Status{Enrolled, Started, InProgress, Completed} {
+		got, err := NewStatusFromString(status.String())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if got != status {
+			t.Errorf("expected %s, got %s", status, got)
+		}
+	}
+
+	if _, err := NewStatusFromString("unknown"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
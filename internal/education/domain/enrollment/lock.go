@@ -0,0 +1,120 @@
+package enrollment
+
+import (
+	"fmt"
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// LockReason tells why a lesson is still locked for a student.
+type LockReason struct {
+	s string
+}
+
+var (
+	ModuleNotReleased         = LockReason{"module-not-released"}
+	PreviousLessonsIncomplete = LockReason{"previous-lessons-incomplete"}
+	ScoreTooLow               = LockReason{"score-too-low"}
+)
+
+func (r LockReason) String() string { return r.s }
+
+// Lock describes the first unmet rule keeping a lesson locked: the date its
+// module is released on, or the lesson the student has to complete, or score
+// at least minScore on, to unlock it.
+type Lock struct {
+	reason   LockReason
+	lessonID string
+	minScore float64
+	unlockAt time.Time
+}
+
+func (l Lock) Reason() LockReason  { return l.reason }
+func (l Lock) LessonID() string    { return l.lessonID }
+func (l Lock) MinScore() float64   { return l.minScore }
+func (l Lock) UnlockAt() time.Time { return l.unlockAt }
+
+// ModuleUnlockAt returns when the module opens for the student, and whether
+// it is still scheduled for later.
+func (e *Enrollment) ModuleUnlockAt(m OutlineModule) (time.Time, bool) {
+	if m.release.IsImmediate() {
+		return time.Time{}, false
+	}
+	unlockAt := m.release.UnlockAt(e.enrolledAt)
+	return unlockAt, !m.release.IsReleased(e.enrolledAt, time.Now())
+}
+
+// LessonLock evaluates the release of the module of a lesson and the gate of
+// the lesson against the progress of the enrollment. It returns false when
+// the lesson is unlocked. Previous lessons follow the course order of the
+// outline, and score rules pointing at lessons that are not part of the
+// outline are ignored.
+func (e *Enrollment) LessonLock(lessonID string, outline Outline) (Lock, bool) {
+	completed := make(map[string]bool, len(e.lessonProgress))
+	scores := make(map[string]float64, len(e.lessonProgress))
+	for _, lp := range e.lessonProgress {
+		if lp.Progress().Status() == Completed {
+			completed[lp.LessonID()] = true
+		}
+		scores[lp.LessonID()] = lp.ExerciseScore()
+	}
+
+	var previous []string
+	for _, m := range outline.modules {
+		for _, l := range m.lessons {
+			if l.lessonID != lessonID {
+				previous = append(previous, l.lessonID)
+				continue
+			}
+
+			if unlockAt, scheduled := e.ModuleUnlockAt(m); scheduled {
+				return Lock{reason: ModuleNotReleased, unlockAt: unlockAt}, true
+			}
+
+			gate := l.gate
+			if gate.RequiresPrevious() {
+				for _, id := range previous {
+					if !completed[id] {
+						return Lock{reason: PreviousLessonsIncomplete, lessonID: id}, true
+					}
+				}
+			}
+			if gate.RequiresScore() {
+				if _, err := outline.ModuleOf(gate.ScoreLessonID()); err == nil && scores[gate.ScoreLessonID()] < gate.MinScore() {
+					return Lock{reason: ScoreTooLow, lessonID: gate.ScoreLessonID(), minScore: gate.MinScore()}, true
+				}
+			}
+			return Lock{}, false
+		}
+	}
+
+	return Lock{}, false
+}
+
+// CheckLessonUnlocked returns a conflict error naming the unmet rule when the
+// lesson is locked for the student.
+func (e *Enrollment) CheckLessonUnlocked(lessonID string, outline Outline) error {
+	lock, locked := e.LessonLock(lessonID, outline)
+	if !locked {
+		return nil
+	}
+
+	switch lock.reason {
+	case ModuleNotReleased:
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("lesson '%s' is released on %s", lessonID, lock.unlockAt.Format(time.RFC3339)),
+			"module-not-released",
+		)
+	case ScoreTooLow:
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("lesson '%s' requires a score of at least %.2f on lesson '%s'", lessonID, lock.minScore, lock.lessonID),
+			"lesson-locked",
+		)
+	default:
+		return commonerrors.NewConflictError(
+			fmt.Sprintf("lesson '%s' requires lesson '%s' to be completed first", lessonID, lock.lessonID),
+			"lesson-locked",
+		)
+	}
+}
@@ -0,0 +1,123 @@
+package enrollment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+)
+
+func newGatedTestOutline(t *testing.T) Outline {
+	t.Helper()
+
+	sequential, err := lesson.NewGate(true, "", 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	scored, err := lesson.NewGate(false, "lesson-1", 70)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	dangling, err := lesson.NewGate(false, "lesson-removed", 70)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	return NewOutline([]OutlineModule{
+		NewOutlineModule("module-1", []OutlineLesson{
+			NewOutlineLesson("lesson-1", 10),
+			NewOutlineLesson("lesson-2", 30).WithGate(scored),
+		}),
+		NewOutlineModule("module-2", []OutlineLesson{
+			NewOutlineLesson("lesson-3", 60).WithGate(sequential),
+			NewOutlineLesson("lesson-4", 60).WithGate(dangling),
+		}),
+	}, false)
+}
+
+func TestEnrollment_LessonLock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lessons without gate are unlocked", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if _, locked := e.LessonLock("lesson-1", newGatedTestOutline(t)); locked {
+			t.Error("expected lesson-1 to be unlocked")
+		}
+	})
+
+	t.Run("sequential lesson is locked until every previous lesson is completed", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newGatedTestOutline(t)
+		_ = e.CompleteLesson("lesson-1", outline)
+
+		lock, locked := e.LessonLock("lesson-3", outline)
+		if !locked {
+			t.Fatal("expected lesson-3 to be locked")
+		}
+		if lock.Reason() != PreviousLessonsIncomplete || lock.LessonID() != "lesson-2" {
+			t.Errorf("expected lesson-2 to be incomplete, got %s on '%s'", lock.Reason(), lock.LessonID())
+		}
+
+		_ = e.CompleteLesson("lesson-2", outline)
+		if _, locked := e.LessonLock("lesson-3", outline); locked {
+			t.Error("expected lesson-3 to be unlocked")
+		}
+	})
+
+	t.Run("score gate is locked until the minimum score is reached", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		outline := newGatedTestOutline(t)
+		_ = e.RecordExerciseScore("lesson-1", 50)
+
+		lock, locked := e.LessonLock("lesson-2", outline)
+		if !locked {
+			t.Fatal("expected lesson-2 to be locked")
+		}
+		if lock.Reason() != ScoreTooLow || lock.LessonID() != "lesson-1" || lock.MinScore() != 70 {
+			t.Errorf("expected score 70 on lesson-1, got %s %v on '%s'", lock.Reason(), lock.MinScore(), lock.LessonID())
+		}
+		if err := e.CheckLessonUnlocked("lesson-2", outline); err == nil {
+			t.Error("expected error for locked lesson, got nil")
+		}
+
+		_ = e.RecordExerciseScore("lesson-1", 70)
+		if err := e.CheckLessonUnlocked("lesson-2", outline); err != nil {
+			t.Errorf("expected lesson-2 to be unlocked, got %v", err)
+		}
+	})
+
+	t.Run("score gate on a lesson outside the outline is ignored", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+
+		if _, locked := e.LessonLock("lesson-4", newGatedTestOutline(t)); locked {
+			t.Error("expected lesson-4 to be unlocked")
+		}
+	})
+
+	t.Run("lessons of a module released later are locked until its release", func(t *testing.T) {
+		e, _ := NewEnrollment("enrollment-1", "user-1", "course-1", 1)
+		weekLater, _ := module.NewRelease(7, time.Time{})
+		past, _ := module.NewRelease(0, time.Now().AddDate(0, 0, -1))
+		outline := NewOutline([]OutlineModule{
+			NewOutlineModule("module-1", []OutlineLesson{NewOutlineLesson("lesson-1", 10)}).WithRelease(past),
+			NewOutlineModule("module-2", []OutlineLesson{NewOutlineLesson("lesson-2", 10)}).WithRelease(weekLater),
+		}, false)
+
+		if _, locked := e.LessonLock("lesson-1", outline); locked {
+			t.Error("expected lesson-1 to be unlocked")
+		}
+
+		lock, locked := e.LessonLock("lesson-2", outline)
+		if !locked {
+			t.Fatal("expected lesson-2 to be locked")
+		}
+		if want := e.EnrolledAt().AddDate(0, 0, 7); lock.Reason() != ModuleNotReleased || !lock.UnlockAt().Equal(want) {
+			t.Errorf("expected module released at %v, got %s at %v", want, lock.Reason(), lock.UnlockAt())
+		}
+		if err := e.CheckLessonUnlocked("lesson-2", outline); err == nil {
+			t.Error("expected error for unreleased module, got nil")
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package enrollment
+
+import (
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+	"github.com/pkg/errors"
+)
+
+// Outline is the structure of a course as seen by progress tracking:
+// the modules of the course and the lessons of every module.
+type Outline struct {
+	modules          []OutlineModule
+	weightByDuration bool
+}
+
+type OutlineModule struct {
+	moduleID string
+	lessons  []OutlineLesson
+	release  module.Release
+}
+
+type OutlineLesson struct {
+	lessonID  string
+	duration  int
+	gate      lesson.Gate
+	passScore float64
+	// maxAttempts caps the attempts at the exercises of the lesson, zero for
+	// no cap
+	maxAttempts int
+}
+
+// NewOutline creates a course outline. When weightByDuration is set, longer
+// lessons contribute more to module and course progress than shorter ones.
+func NewOutline(modules []OutlineModule, weightByDuration bool) Outline {
+	return Outline{
+		modules:          modules,
+		weightByDuration: weightByDuration,
+	}
+}
+
+func NewOutlineModule(moduleID string, lessons []OutlineLesson) OutlineModule {
+	return OutlineModule{
+		moduleID: moduleID,
+		lessons:  lessons,
+	}
+}
+
+func NewOutlineLesson(lessonID string, duration int) OutlineLesson {
+	return OutlineLesson{
+		lessonID: lessonID,
+		duration: duration,
+	}
+}
+
+func (o Outline) Modules() []OutlineModule { return o.modules }
+func (o Outline) WeightByDuration() bool   { return o.weightByDuration }
+
+// WithRelease returns a copy of the outline module opening on the release rule.
+func (m OutlineModule) WithRelease(release module.Release) OutlineModule {
+	m.release = release
+	return m
+}
+
+func (m OutlineModule) ModuleID() string         { return m.moduleID }
+func (m OutlineModule) Lessons() []OutlineLesson { return m.lessons }
+func (m OutlineModule) Release() module.Release  { return m.release }
+
+// WithGate returns a copy of the outline lesson locked behind the gate.
+func (l OutlineLesson) WithGate(gate lesson.Gate) OutlineLesson {
+	l.gate = gate
+	return l
+}
+
+// WithPassScore returns a copy of the outline lesson that only counts as
+// completed once the student scored at least passScore on its exercises.
+func (l OutlineLesson) WithPassScore(passScore float64) OutlineLesson {
+	l.passScore = passScore
+	return l
+}
+
+// WithMaxAttempts returns a copy of the outline lesson allowing at most
+// maxAttempts attempts at its exercises.
+func (l OutlineLesson) WithMaxAttempts(maxAttempts int) OutlineLesson {
+	l.maxAttempts = maxAttempts
+	return l
+}
+
+func (l OutlineLesson) LessonID() string   { return l.lessonID }
+func (l OutlineLesson) Duration() int      { return l.duration }
+func (l OutlineLesson) Gate() lesson.Gate  { return l.gate }
+func (l OutlineLesson) PassScore() float64 { return l.passScore }
+func (l OutlineLesson) MaxAttempts() int   { return l.maxAttempts }
+
+// ModuleOf returns the ID of the module containing the lesson.
+func (o Outline) ModuleOf(lessonID string) (string, error) {
+	for _, m := range o.modules {
+		for _, l := range m.lessons {
+			if l.lessonID == lessonID {
+				return m.moduleID, nil
+			}
+		}
+	}
+	return "", errors.Errorf("lesson '%s' does not belong to the course", lessonID)
+}
+
+// lesson returns the outline lesson with the given ID.
+func (o Outline) lesson(lessonID string) (OutlineLesson, bool) {
+	for _, m := range o.modules {
+		for _, l := range m.lessons {
+			if l.lessonID == lessonID {
+				return l, true
+			}
+		}
+	}
+	return OutlineLesson{}, false
+}
+
+// percentage returns the completed share of the given lessons, from 0 to 100.
+// Lessons are weighted by duration when the outline asks for it, falling back
+// to equal weights when none of the lessons has a duration.
+func (o Outline) percentage(lessons []OutlineLesson, completed map[string]bool) float64 {
+	if len(lessons) == 0 {
+		return 0
+	}
+
+	var total, done float64
+	if o.weightByDuration {
+		for _, l := range lessons {
+			total += float64(l.duration)
+			if completed[l.lessonID] {
+				done += float64(l.duration)
+			}
+		}
+	}
+
+	if total == 0 {
+		total, done = 0, 0
+		for _, l := range lessons {
+			total++
+			if completed[l.lessonID] {
+				done++
+			}
+		}
+	}
+
+	return done / total * 100
+}
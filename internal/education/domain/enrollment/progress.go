@@ -18,6 +18,8 @@ type LessonProgress struct {
 	lessonID      string
 	progress      Progress
 	exerciseScore float64
+	// attempts counts the attempts started at the exercises of the lesson
+	attempts int
 }
 
 func NewProgress(progress float64, status Status) Progress {
@@ -48,6 +50,44 @@ func NewLessonProgress(lessonID string) LessonProgress {
 	}
 }
 
+// UnmarshalCourseProgressFromDatabase restores course progress from persisted state.
+func UnmarshalCourseProgressFromDatabase(progress float64, status Status) CourseProgress {
+	return CourseProgress{
+		progress: NewProgress(progress, status),
+	}
+}
+
+// UnmarshalModuleProgressFromDatabase restores module progress from persisted state.
+func UnmarshalModuleProgressFromDatabase(moduleID string, progress float64, status Status) ModuleProgress {
+	return ModuleProgress{
+		moduleID: moduleID,
+		progress: NewProgress(progress, status),
+	}
+}
+
+// UnmarshalLessonProgressFromDatabase restores lesson progress from persisted state.
+func UnmarshalLessonProgressFromDatabase(lessonID string, progress float64, status Status, exerciseScore float64, attempts int) LessonProgress {
+	return LessonProgress{
+		lessonID:      lessonID,
+		progress:      NewProgress(progress, status),
+		exerciseScore: exerciseScore,
+		attempts:      attempts,
+	}
+}
+
+// statusFor derives a status from a completion percentage. Progress with
+// nothing completed yet keeps the given initial status.
+func statusFor(percentage float64, initial Status) Status {
+	switch {
+	case percentage >= 100:
+		return Completed
+	case percentage > 0:
+		return InProgress
+	default:
+		return initial
+	}
+}
+
 // Progress getters
 func (p Progress) ProgressPercentage() float64 { return p.progress }
 func (p Progress) Status() Status              { return p.status }
@@ -56,6 +96,7 @@ func (p Progress) Status() Status              { return p.status }
 func (lp LessonProgress) LessonID() string         { return lp.lessonID }
 func (lp LessonProgress) Progress() Progress       { return lp.progress }
 func (lp LessonProgress) ExerciseScore() float64   { return lp.exerciseScore }
+func (lp LessonProgress) Attempts() int            { return lp.attempts }
 
 func (lp *LessonProgress) MarkCompleted() {
 	lp.progress.progress = 100.0
@@ -66,6 +107,10 @@ func (lp *LessonProgress) UpdateExerciseScore(score float64) {
 	lp.exerciseScore = score
 }
 
+func (lp *LessonProgress) countAttempt() {
+	lp.attempts++
+}
+
 // ModuleProgress methods
 func (mp ModuleProgress) ModuleID() string   { return mp.moduleID }
 func (mp ModuleProgress) Progress() Progress { return mp.progress }
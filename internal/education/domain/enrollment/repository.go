@@ -4,7 +4,10 @@ import "context"
 
 type EnrollmentRepository interface {
 	Create(ctx context.Context, enrollment *Enrollment) error
-	Update(ctx context.Context, enrollment *Enrollment) error
+	// Update locks the enrollment, applies updateFn to it and saves the result
+	// in one transaction, so concurrent updates never overwrite each other's
+	// progress. Nothing is saved when updateFn fails.
+	Update(ctx context.Context, id string, updateFn func(e *Enrollment) error) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (*Enrollment, error)
 	GetAll(ctx context.Context) ([]*Enrollment, error)
@@ -1,5 +1,7 @@
 package enrollment
 
+import "github.com/pkg/errors"
+
 var (
 	Enrolled   = Status{s: "enrolled"}
 	Started    = Status{s: "started"}
@@ -7,6 +9,13 @@ var (
 	Completed  = Status{s: "completed"}
 )
 
+var statusValues = []Status{
+	Enrolled,
+	Started,
+	InProgress,
+	Completed,
+}
+
 type Status struct {
 	s string
 }
@@ -15,6 +24,11 @@ func (s Status) String() string {
 	return s.s
 }
 
-func (s Status) NewStatusFromString(statusStr string) (Status, error) {
-	panic("not implemented")
+func NewStatusFromString(statusStr string) (Status, error) {
+	for _, status := range statusValues {
+		if status.String() == statusStr {
+			return status, nil
+		}
+	}
+	return Status{}, errors.Errorf("unknown '%s' status", statusStr)
 }
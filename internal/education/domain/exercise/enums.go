@@ -0,0 +1,70 @@
+package exercise
+
+import "github.com/pkg/errors"
+
+// Kind enum
+var (
+	SingleChoice   = Kind{k: "single_choice"}
+	MultipleChoice = Kind{k: "multiple_choice"}
+	TrueFalse      = Kind{k: "true_false"}
+	Numeric        = Kind{k: "numeric"}
+	ShortText      = Kind{k: "short_text"}
+	Ordering       = Kind{k: "ordering"}
+)
+
+var kindValues = []Kind{
+	SingleChoice,
+	MultipleChoice,
+	TrueFalse,
+	Numeric,
+	ShortText,
+	Ordering,
+}
+
+// Kind is the type of question an exercise asks, which decides how its
+// answers are validated and graded
+type Kind struct {
+	k string
+}
+
+func (k Kind) String() string {
+	return k.k
+}
+
+func NewKindFromString(kindStr string) (Kind, error) {
+	for _, kind := range kindValues {
+		if kind.String() == kindStr {
+			return kind, nil
+		}
+	}
+	return Kind{}, errors.Errorf("unknown '%s' exercise kind", kindStr)
+}
+
+// TextMatch enum
+var (
+	NormalizedMatch = TextMatch{m: "normalized"}
+	RegexMatch      = TextMatch{m: "regex"}
+)
+
+var textMatchValues = []TextMatch{
+	NormalizedMatch,
+	RegexMatch,
+}
+
+// TextMatch is how a short text answer is compared to the accepted answers
+type TextMatch struct {
+	m string
+}
+
+func (m TextMatch) String() string {
+	return m.m
+}
+
+func NewTextMatchFromString(matchStr string) (TextMatch, error) {
+	for _, match := range textMatchValues {
+		if match.String() == matchStr {
+			return match, nil
+		}
+	}
+	return TextMatch{}, errors.Errorf("unknown '%s' text match", matchStr)
+}
@@ -1,52 +1,61 @@
 package exercise
 
-import "github.com/pkg/errors"
+import commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+
+// DefaultPoints is the weight of an exercise unless set otherwise
+const DefaultPoints = 1
 
 type Exercise struct {
-	id            string
-	lessonID      string
-	question      string
-	answers       []string
-	correctAnswer string
-	order         int
+	id       string
+	lessonID string
+	question string
+	key      AnswerKey
+	order    int
+	// points weighs the exercise in the score of the lesson
+	points int
+	// pool is the question bank pool of the lesson the exercise was drawn
+	// into, empty for exercises every quiz session may ask
+	pool string
 }
 
+// NewExercise creates a single choice exercise, answered by picking
+// correctAnswer among answers.
 func NewExercise(id string, lessonID string, question string, answers []string, correctAnswer string, order int) (*Exercise, error) {
-	if id == "" {
-		return nil, errors.New("exercise id is required")
-	}
-	if lessonID == "" {
-		return nil, errors.New("lesson id is required")
-	}
-	if question == "" {
-		return nil, errors.New("question is required")
-	}
-	if len(answers) < 2 {
-		return nil, errors.New("at least 2 answers are required")
-	}
-	if correctAnswer == "" {
-		return nil, errors.New("correct answer is required")
+	var v commonerrors.Validation
+	validateExercise(&v, id, lessonID, question)
+	key := validateAnswerKey(&v, SingleChoice, answers, []string{correctAnswer}, 0, TextMatch{})
+	if err := v.Err("invalid-exercise"); err != nil {
+		return nil, err
 	}
 
-	// Validate correct answer exists in answers
-	found := false
-	for _, ans := range answers {
-		if ans == correctAnswer {
-			found = true
-			break
-		}
+	return &Exercise{
+		id:       id,
+		lessonID: lessonID,
+		question: question,
+		key:      key,
+		order:    order,
+		points:   DefaultPoints,
+	}, nil
+}
+
+// NewExerciseWithKey creates an exercise of any kind from its answer key.
+func NewExerciseWithKey(id string, lessonID string, question string, key AnswerKey, order int) (*Exercise, error) {
+	var v commonerrors.Validation
+	validateExercise(&v, id, lessonID, question)
+	if key.kind == (Kind{}) {
+		v.Add("kind", "exercise kind is required")
 	}
-	if !found {
-		return nil, errors.New("correct answer must be one of the provided answers")
+	if err := v.Err("invalid-exercise"); err != nil {
+		return nil, err
 	}
 
 	return &Exercise{
-		id:            id,
-		lessonID:      lessonID,
-		question:      question,
-		answers:       answers,
-		correctAnswer: correctAnswer,
-		order:         order,
+		id:       id,
+		lessonID: lessonID,
+		question: question,
+		key:      key,
+		order:    order,
+		points:   DefaultPoints,
 	}, nil
 }
 
@@ -54,60 +63,87 @@ func NewExercise(id string, lessonID string, question string, answers []string,
 func (e *Exercise) ID() string        { return e.id }
 func (e *Exercise) LessonID() string  { return e.lessonID }
 func (e *Exercise) Question() string  { return e.question }
-func (e *Exercise) Answers() []string { return e.answers }
+func (e *Exercise) Kind() Kind        { return e.key.kind }
+func (e *Exercise) Answers() []string { return e.key.options }
 func (e *Exercise) Order() int        { return e.order }
+func (e *Exercise) Points() int       { return e.points }
+func (e *Exercise) Pool() string      { return e.pool }
 
-// Note: correctAnswer is NOT exposed via public getter for security
-// Students should not be able to see the correct answer directly
+// Note: the answer key is NOT exposed via public getter for security
+// Students should not be able to see the correct answers directly
 
-// CorrectAnswerForStorage returns the correct answer for persistence layer only
-// This should only be used by the repository layer, not exposed to API/service layers
-func (e *Exercise) CorrectAnswerForStorage() string {
-	return e.correctAnswer
+// AnswerKeyForStorage returns the answer key for persistence layer only
+// This should only be used by the repository layer and by course exports and
+// copies, which only the owner of the course may make
+func (e *Exercise) AnswerKeyForStorage() AnswerKey {
+	return e.key
 }
 
 // Behavior methods
-func (e *Exercise) CheckAnswer(answer string) bool {
-	return e.correctAnswer == answer
+
+// CheckAnswer grades a response to the exercise: a single value for most
+// kinds, the selected options for multiple choice and the items in order for
+// ordering questions.
+func (e *Exercise) CheckAnswer(response ...string) bool {
+	return e.key.credit(response) == 1
+}
+
+// Grade returns the points earned by a response. Multiple-choice questions
+// give partial credit, every other kind is all or nothing.
+func (e *Exercise) Grade(response ...string) float64 {
+	return e.key.credit(response) * float64(e.points)
 }
 
 func (e *Exercise) UpdateQuestion(question string) error {
 	if question == "" {
-		return errors.New("question is required")
+		return commonerrors.NewValidationError("invalid-question", commonerrors.NewFieldError("question", "question is required"))
 	}
 	e.question = question
 	return nil
 }
 
-func (e *Exercise) UpdateAnswers(answers []string, correctAnswer string) error {
-	if len(answers) < 2 {
-		return errors.New("at least 2 answers are required")
-	}
-	if correctAnswer == "" {
-		return errors.New("correct answer is required")
+// UpdateAnswerKey replaces the answer key, which may change the kind of the
+// exercise.
+func (e *Exercise) UpdateAnswerKey(key AnswerKey) error {
+	if key.kind == (Kind{}) {
+		return commonerrors.NewValidationError("invalid-answers", commonerrors.NewFieldError("kind", "exercise kind is required"))
 	}
 
-	// Validate correct answer exists in answers
-	found := false
-	for _, ans := range answers {
-		if ans == correctAnswer {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return errors.New("correct answer must be one of the provided answers")
-	}
+	e.key = key
+	return nil
+}
 
-	e.answers = answers
-	e.correctAnswer = correctAnswer
+// SetPoints changes the weight of the exercise in the score of the lesson.
+func (e *Exercise) SetPoints(points int) error {
+	if points < 1 {
+		return commonerrors.NewValidationError("invalid-points", commonerrors.NewFieldError("points", "points must be at least 1"))
+	}
+	e.points = points
 	return nil
 }
 
+// SetPool puts the exercise in a question bank pool of its lesson. Quiz
+// sessions draw from every pool separately.
+func (e *Exercise) SetPool(pool string) {
+	e.pool = pool
+}
+
 func (e *Exercise) UpdateOrder(order int) error {
 	if order < 0 {
-		return errors.New("order cannot be negative")
+		return commonerrors.NewValidationError("invalid-order", commonerrors.NewFieldError("order", "order cannot be negative"))
 	}
 	e.order = order
 	return nil
 }
+
+func validateExercise(v *commonerrors.Validation, id string, lessonID string, question string) {
+	if id == "" {
+		v.Add("id", "exercise id is required")
+	}
+	if lessonID == "" {
+		v.Add("lessonId", "lesson id is required")
+	}
+	if question == "" {
+		v.Add("question", "question is required")
+	}
+}
@@ -0,0 +1,294 @@
+package exercise
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// trueFalseOptions are the options of every true/false question
+var trueFalseOptions = []string{"true", "false"}
+
+// numericEpsilon absorbs floating point noise when comparing numeric answers
+const numericEpsilon = 1e-9
+
+// AnswerKey holds the options shown to the student and what counts as a
+// correct answer, depending on the kind of the exercise:
+//   - single choice: the options and the one correct option
+//   - multiple choice: the options and every correct option
+//   - true/false: "true" or "false"
+//   - numeric: the correct value and the tolerance around it
+//   - short text: the accepted answers, compared after normalization or
+//     matched as regular expressions
+//   - ordering: the items to order and the same items in the correct order
+type AnswerKey struct {
+	kind      Kind
+	options   []string
+	correct   []string
+	tolerance float64
+	match     TextMatch
+}
+
+// NewAnswerKey validates the options and correct answers for the kind of
+// exercise. Tolerance only applies to numeric questions and the text match to
+// short text questions, which default to normalized matching.
+func NewAnswerKey(kind Kind, options []string, correct []string, tolerance float64, match TextMatch) (AnswerKey, error) {
+	var v commonerrors.Validation
+	key := validateAnswerKey(&v, kind, options, correct, tolerance, match)
+	if err := v.Err("invalid-answers"); err != nil {
+		return AnswerKey{}, err
+	}
+	return key, nil
+}
+
+// ParseAnswerKey builds an answer key from its serialized form, as sent by
+// clients or read from storage. An empty kind stands for single choice, the
+// only kind exercises had at first, and an empty text match for the default.
+func ParseAnswerKey(kind string, options []string, correct []string, tolerance float64, match string) (AnswerKey, error) {
+	var v commonerrors.Validation
+	k := SingleChoice
+	if kind != "" {
+		var err error
+		if k, err = NewKindFromString(kind); err != nil {
+			v.Add("kind", err.Error())
+		}
+	}
+	var m TextMatch
+	if match != "" {
+		var err error
+		if m, err = NewTextMatchFromString(match); err != nil {
+			v.Add("textMatch", err.Error())
+		}
+	}
+	if err := v.Err("invalid-answers"); err != nil {
+		return AnswerKey{}, err
+	}
+
+	return NewAnswerKey(k, options, correct, tolerance, m)
+}
+
+func (k AnswerKey) Kind() Kind           { return k.kind }
+func (k AnswerKey) Options() []string    { return k.options }
+func (k AnswerKey) Correct() []string    { return k.correct }
+func (k AnswerKey) Tolerance() float64   { return k.tolerance }
+func (k AnswerKey) TextMatch() TextMatch { return k.match }
+
+// credit grades a response from 0 to 1: the chosen option or typed value for
+// most kinds, the selected options for multiple choice and the items in order
+// for ordering questions. Only multiple choice gives partial credit.
+func (k AnswerKey) credit(response []string) float64 {
+	switch k.kind {
+	case MultipleChoice:
+		return k.selectionCredit(response)
+	case Ordering:
+		if len(response) != len(k.correct) {
+			return 0
+		}
+		for i := range response {
+			if response[i] != k.correct[i] {
+				return 0
+			}
+		}
+		return 1
+	}
+
+	if len(response) != 1 {
+		return 0
+	}
+	given := response[0]
+
+	switch k.kind {
+	case TrueFalse:
+		return fullCredit(strings.EqualFold(strings.TrimSpace(given), k.correct[0]))
+	case Numeric:
+		value, err := strconv.ParseFloat(strings.TrimSpace(given), 64)
+		if err != nil {
+			return 0
+		}
+		expected, _ := strconv.ParseFloat(k.correct[0], 64)
+		return fullCredit(math.Abs(value-expected) <= k.tolerance+numericEpsilon)
+	case ShortText:
+		for _, accepted := range k.correct {
+			if k.match == RegexMatch {
+				if regexp.MustCompile(anchored(accepted)).MatchString(strings.TrimSpace(given)) {
+					return 1
+				}
+			} else if normalize(given) == normalize(accepted) {
+				return 1
+			}
+		}
+		return 0
+	default:
+		return fullCredit(given == k.correct[0])
+	}
+}
+
+// selectionCredit gives a share of the credit for every correct option
+// selected and takes one back for every wrong option selected, so that
+// selecting everything earns nothing
+func (k AnswerKey) selectionCredit(response []string) float64 {
+	var right, wrong int
+	for i, r := range response {
+		if contains(response[:i], r) {
+			continue
+		}
+		if contains(k.correct, r) {
+			right++
+		} else {
+			wrong++
+		}
+	}
+	if right <= wrong {
+		return 0
+	}
+	return float64(right-wrong) / float64(len(k.correct))
+}
+
+func fullCredit(correct bool) float64 {
+	if correct {
+		return 1
+	}
+	return 0
+}
+
+// validateAnswerKey reports every problem of the answer key and returns the
+// key as it is stored
+func validateAnswerKey(v *commonerrors.Validation, kind Kind, options []string, correct []string, tolerance float64, match TextMatch) AnswerKey {
+	key := AnswerKey{kind: kind, options: options, correct: correct, tolerance: tolerance, match: match}
+
+	if tolerance != 0 && kind != Numeric {
+		v.Add("tolerance", "tolerance only applies to numeric questions")
+	}
+	if match != (TextMatch{}) && kind != ShortText {
+		v.Add("textMatch", "text match only applies to short text questions")
+	}
+
+	switch kind {
+	case SingleChoice:
+		if len(options) < 2 {
+			v.Add("answers", "at least 2 answers are required")
+		}
+		if len(correct) != 1 || correct[0] == "" {
+			v.Add("correctAnswer", "correct answer is required")
+			return key
+		}
+		if !contains(options, correct[0]) {
+			v.Add("correctAnswer", "correct answer must be one of the provided answers")
+		}
+	case MultipleChoice:
+		validateOptions(v, options)
+		if len(correct) == 0 {
+			v.Add("correctAnswers", "at least 1 correct answer is required")
+		}
+		for i, c := range correct {
+			if !contains(options, c) {
+				v.Add(fmt.Sprintf("correctAnswers[%d]", i), "correct answer must be one of the provided answers")
+			} else if contains(correct[:i], c) {
+				v.Add(fmt.Sprintf("correctAnswers[%d]", i), fmt.Sprintf("answer '%s' is listed more than once", c))
+			}
+		}
+	case TrueFalse:
+		if len(options) > 0 && !sameSet(options, trueFalseOptions) {
+			v.Add("answers", "true/false questions only have the answers 'true' and 'false'")
+		}
+		key.options = trueFalseOptions
+		if len(correct) != 1 || !contains(trueFalseOptions, correct[0]) {
+			v.Add("correctAnswer", "correct answer must be 'true' or 'false'")
+		}
+	case Numeric:
+		if len(options) > 0 {
+			v.Add("answers", "numeric questions have no answer options")
+		}
+		if tolerance < 0 {
+			v.Add("tolerance", "tolerance cannot be negative")
+		}
+		if len(correct) != 1 {
+			v.Add("correctAnswer", "correct value is required")
+			return key
+		}
+		if _, err := strconv.ParseFloat(correct[0], 64); err != nil {
+			v.Add("correctAnswer", "correct value must be a number")
+		}
+	case ShortText:
+		if len(options) > 0 {
+			v.Add("answers", "short text questions have no answer options")
+		}
+		if match == (TextMatch{}) {
+			key.match = NormalizedMatch
+		}
+		if len(correct) == 0 {
+			v.Add("correctAnswers", "at least 1 accepted answer is required")
+		}
+		for i, c := range correct {
+			field := fmt.Sprintf("correctAnswers[%d]", i)
+			if strings.TrimSpace(c) == "" {
+				v.Add(field, "accepted answer cannot be blank")
+			} else if key.match == RegexMatch {
+				if _, err := regexp.Compile(anchored(c)); err != nil {
+					v.Add(field, fmt.Sprintf("invalid regular expression: %v", err))
+				}
+			}
+		}
+	case Ordering:
+		validateOptions(v, options)
+		if len(correct) != len(options) || !sameSet(correct, options) {
+			v.Add("correctAnswers", "correct order must contain every answer exactly once")
+		}
+	default:
+		v.Add("kind", "exercise kind is required")
+	}
+
+	return key
+}
+
+// validateOptions requires at least two distinct options, so that selections
+// and orders are unambiguous
+func validateOptions(v *commonerrors.Validation, options []string) {
+	if len(options) < 2 {
+		v.Add("answers", "at least 2 answers are required")
+	}
+	for i, o := range options {
+		if contains(options[:i], o) {
+			v.Add(fmt.Sprintf("answers[%d]", i), fmt.Sprintf("answer '%s' is listed more than once", o))
+		}
+	}
+}
+
+// normalize ignores case and surrounding or repeated whitespace
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// anchored makes a pattern match the whole answer
+func anchored(pattern string) string {
+	return "^(?:" + pattern + ")$"
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sameSet reports whether a and b hold the same values, ignoring order and
+// repetitions
+func sameSet(a []string, b []string) bool {
+	for _, v := range a {
+		if !contains(b, v) {
+			return false
+		}
+	}
+	for _, v := range b {
+		if !contains(a, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package exercise
+
+import (
+	"testing"
+)
+
+func TestNewAnswerKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		kind      Kind
+		options   []string
+		correct   []string
+		tolerance float64
+		match     TextMatch
+		wantErr   bool
+	}{
+		{"single choice", SingleChoice, []string{"var", "const"}, []string{"const"}, 0, TextMatch{}, false},
+		{"single choice with answer outside the options", SingleChoice, []string{"var", "const"}, []string{"let"}, 0, TextMatch{}, true},
+		{"single choice with two correct answers", SingleChoice, []string{"var", "const"}, []string{"var", "const"}, 0, TextMatch{}, true},
+		{"multiple choice", MultipleChoice, []string{"a", "b", "c"}, []string{"a", "c"}, 0, TextMatch{}, false},
+		{"multiple choice without correct answers", MultipleChoice, []string{"a", "b"}, nil, 0, TextMatch{}, true},
+		{"multiple choice with duplicate options", MultipleChoice, []string{"a", "a"}, []string{"a"}, 0, TextMatch{}, true},
+		{"true/false", TrueFalse, nil, []string{"true"}, 0, TextMatch{}, false},
+		{"true/false with other answer", TrueFalse, nil, []string{"yes"}, 0, TextMatch{}, true},
+		{"numeric", Numeric, nil, []string{"3.14"}, 0.01, TextMatch{}, false},
+		{"numeric with non-number answer", Numeric, nil, []string{"pi"}, 0, TextMatch{}, true},
+		{"numeric with negative tolerance", Numeric, nil, []string{"3"}, -1, TextMatch{}, true},
+		{"numeric with options", Numeric, []string{"1", "2"}, []string{"1"}, 0, TextMatch{}, true},
+		{"tolerance on single choice", SingleChoice, []string{"1", "2"}, []string{"1"}, 0.5, TextMatch{}, true},
+		{"short text", ShortText, nil, []string{"Goroutine"}, 0, TextMatch{}, false},
+		{"short text with regex", ShortText, nil, []string{"go ?routines?"}, 0, RegexMatch, false},
+		{"short text with invalid regex", ShortText, nil, []string{"go("}, 0, RegexMatch, true},
+		{"short text with blank answer", ShortText, nil, []string{" "}, 0, TextMatch{}, true},
+		{"text match on numeric", Numeric, nil, []string{"1"}, 0, RegexMatch, true},
+		{"ordering", Ordering, []string{"b", "a", "c"}, []string{"a", "b", "c"}, 0, TextMatch{}, false},
+		{"ordering missing an item", Ordering, []string{"b", "a", "c"}, []string{"a", "b"}, 0, TextMatch{}, true},
+		{"missing kind", Kind{}, []string{"a", "b"}, []string{"a"}, 0, TextMatch{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAnswerKey(tt.kind, tt.options, tt.correct, tt.tolerance, tt.match)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAnswerKeyDefaults(t *testing.T) {
+	t.Parallel()
+	t.Run("true/false always offers true and false", func(t *testing.T) {
+		key, err := NewAnswerKey(TrueFalse, nil, []string{"false"}, 0, TextMatch{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(key.Options()) != 2 || key.Options()[0] != "true" || key.Options()[1] != "false" {
+			t.Errorf("expected options [true false], got %v", key.Options())
+		}
+	})
+
+	t.Run("short text matches normalized answers by default", func(t *testing.T) {
+		key, err := NewAnswerKey(ShortText, nil, []string{"channel"}, 0, TextMatch{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if key.TextMatch() != NormalizedMatch {
+			t.Errorf("expected normalized match, got '%s'", key.TextMatch())
+		}
+	})
+
+	t.Run("empty kind parses as single choice", func(t *testing.T) {
+		key, err := ParseAnswerKey("", []string{"var", "const"}, []string{"const"}, 0, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if key.Kind() != SingleChoice {
+			t.Errorf("expected single choice, got '%s'", key.Kind())
+		}
+	})
+
+	t.Run("unknown kind fails to parse", func(t *testing.T) {
+		if _, err := ParseAnswerKey("essay", nil, []string{"anything"}, 0, ""); err == nil {
+			t.Fatal("expected error for unknown kind, got nil")
+		}
+	})
+}
+
+func TestExerciseCheckAnswer(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		kind     Kind
+		options  []string
+		correct  []string
+		tol      float64
+		match    TextMatch
+		response []string
+		want     bool
+	}{
+		{"single choice correct", SingleChoice, []string{"var", "const"}, []string{"const"}, 0, TextMatch{}, []string{"const"}, true},
+		{"single choice wrong", SingleChoice, []string{"var", "const"}, []string{"const"}, 0, TextMatch{}, []string{"var"}, false},
+		{"multiple choice in any order", MultipleChoice, []string{"a", "b", "c"}, []string{"a", "c"}, 0, TextMatch{}, []string{"c", "a"}, true},
+		{"multiple choice missing an option", MultipleChoice, []string{"a", "b", "c"}, []string{"a", "c"}, 0, TextMatch{}, []string{"a"}, false},
+		{"multiple choice with an extra option", MultipleChoice, []string{"a", "b", "c"}, []string{"a", "c"}, 0, TextMatch{}, []string{"a", "b", "c"}, false},
+		{"true/false ignores case", TrueFalse, nil, []string{"true"}, 0, TextMatch{}, []string{"True"}, true},
+		{"numeric within tolerance", Numeric, nil, []string{"3.14"}, 0.01, TextMatch{}, []string{"3.149"}, true},
+		{"numeric outside tolerance", Numeric, nil, []string{"3.14"}, 0.01, TextMatch{}, []string{"3.2"}, false},
+		{"numeric exact", Numeric, nil, []string{"42"}, 0, TextMatch{}, []string{" 42.0 "}, true},
+		{"numeric not a number", Numeric, nil, []string{"42"}, 0, TextMatch{}, []string{"forty-two"}, false},
+		{"short text normalized", ShortText, nil, []string{"buffered channel"}, 0, TextMatch{}, []string{"  Buffered   CHANNEL "}, true},
+		{"short text any accepted answer", ShortText, nil, []string{"goroutine", "go routine"}, 0, TextMatch{}, []string{"Go routine"}, true},
+		{"short text wrong", ShortText, nil, []string{"goroutine"}, 0, TextMatch{}, []string{"thread"}, false},
+		{"short text regex", ShortText, nil, []string{"go ?routines?"}, 0, RegexMatch, []string{"goroutines"}, true},
+		{"short text regex matches whole answer", ShortText, nil, []string{"go"}, 0, RegexMatch, []string{"golang"}, false},
+		{"ordering correct", Ordering, []string{"c", "a", "b"}, []string{"a", "b", "c"}, 0, TextMatch{}, []string{"a", "b", "c"}, true},
+		{"ordering wrong", Ordering, []string{"c", "a", "b"}, []string{"a", "b", "c"}, 0, TextMatch{}, []string{"a", "c", "b"}, false},
+		{"several values for a single answer", SingleChoice, []string{"var", "const"}, []string{"const"}, 0, TextMatch{}, []string{"const", "var"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewAnswerKey(tt.kind, tt.options, tt.correct, tt.tol, tt.match)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			e, err := NewExerciseWithKey("exercise-1", "lesson-1", "Question?", key, 0)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := e.CheckAnswer(tt.response...); got != tt.want {
+				t.Errorf("expected CheckAnswer(%v) to be %v, got %v", tt.response, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package lesson
+
+import commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+
+// Gate holds the rules a student has to meet before a lesson unlocks. A
+// lesson can require every lesson before it in the course to be completed,
+// a minimum exercise score on an earlier lesson, or both. The zero Gate
+// leaves the lesson open.
+type Gate struct {
+	requirePrevious bool
+	scoreLessonID   string
+	minScore        float64
+}
+
+// NewGate creates a gate. A minimum score only applies together with the
+// lesson it has to be reached on, and ranges from 0 (exclusive) to 100.
+func NewGate(requirePrevious bool, scoreLessonID string, minScore float64) (Gate, error) {
+	var v commonerrors.Validation
+	if scoreLessonID == "" && minScore != 0 {
+		v.Add("scoreLessonId", "a minimum score needs the lesson it has to be reached on")
+	}
+	if scoreLessonID != "" && (minScore <= 0 || minScore > 100) {
+		v.Add("minScore", "minimum score must be greater than 0 and at most 100")
+	}
+	if err := v.Err("invalid-lesson-gate"); err != nil {
+		return Gate{}, err
+	}
+
+	return Gate{
+		requirePrevious: requirePrevious,
+		scoreLessonID:   scoreLessonID,
+		minScore:        minScore,
+	}, nil
+}
+
+func (g Gate) RequiresPrevious() bool { return g.requirePrevious }
+func (g Gate) ScoreLessonID() string  { return g.scoreLessonID }
+func (g Gate) MinScore() float64      { return g.minScore }
+
+// RequiresScore reports whether the gate asks for a minimum exercise score.
+func (g Gate) RequiresScore() bool {
+	return g.scoreLessonID != ""
+}
+
+// IsOpen reports whether the gate has no rules at all.
+func (g Gate) IsOpen() bool {
+	return !g.requirePrevious && !g.RequiresScore()
+}
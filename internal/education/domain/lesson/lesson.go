@@ -1,6 +1,11 @@
 package lesson
 
-import "github.com/pkg/errors"
+import (
+	"fmt"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+)
 
 type Lesson struct {
 	id       string
@@ -11,17 +16,41 @@ type Lesson struct {
 	videoID  string
 	duration int
 	order    int
+	gate     Gate
+	// passScore is the exercise score, from 0 to 100, a student has to reach
+	// before the lesson counts as completed. Zero lets the student complete
+	// the lesson at any time.
+	passScore float64
+	// drawCount is the number of exercises drawn at random from the lesson
+	// for every quiz session, leaving out the bank pools which draw on their
+	// own. Zero draws every exercise.
+	drawCount int
+	// timeLimit is the number of minutes a quiz session on the lesson can
+	// last. Zero leaves sessions untimed.
+	timeLimit int
+	// maxAttempts caps the attempts a student has at the exercises of the
+	// lesson. Zero allows any number of attempts.
+	maxAttempts int
+	// bankQuestionIDs are questions of banks the lesson asks on top of its
+	// own exercises
+	bankQuestionIDs []string
+	// bankPools are the bank questions the lesson draws from at random
+	bankPools []bank.Pool
 }
 
 func NewLesson(id string, moduleID string, title string, overview string, content string, videoID string, duration int, order int) (*Lesson, error) {
+	var v commonerrors.Validation
 	if id == "" {
-		return nil, errors.New("lesson id is required")
+		v.Add("id", "lesson id is required")
 	}
 	if moduleID == "" {
-		return nil, errors.New("module id is required")
+		v.Add("moduleId", "module id is required")
 	}
 	if title == "" {
-		return nil, errors.New("lesson title is required")
+		v.Add("title", "lesson title is required")
+	}
+	if err := v.Err("invalid-lesson"); err != nil {
+		return nil, err
 	}
 
 	return &Lesson{
@@ -37,14 +66,22 @@ func NewLesson(id string, moduleID string, title string, overview string, conten
 }
 
 // Getters (read-only access for serialization/display)
-func (l *Lesson) ID() string       { return l.id }
-func (l *Lesson) ModuleID() string { return l.moduleID }
-func (l *Lesson) Title() string    { return l.title }
-func (l *Lesson) Overview() string { return l.overview }
-func (l *Lesson) Content() string  { return l.content }
-func (l *Lesson) VideoID() string  { return l.videoID }
-func (l *Lesson) Duration() int    { return l.duration }
-func (l *Lesson) Order() int       { return l.order }
+func (l *Lesson) ID() string         { return l.id }
+func (l *Lesson) ModuleID() string   { return l.moduleID }
+func (l *Lesson) Title() string      { return l.title }
+func (l *Lesson) Overview() string   { return l.overview }
+func (l *Lesson) Content() string    { return l.content }
+func (l *Lesson) VideoID() string    { return l.videoID }
+func (l *Lesson) Duration() int      { return l.duration }
+func (l *Lesson) Order() int         { return l.order }
+func (l *Lesson) Gate() Gate         { return l.gate }
+func (l *Lesson) PassScore() float64 { return l.passScore }
+func (l *Lesson) DrawCount() int     { return l.drawCount }
+func (l *Lesson) TimeLimit() int     { return l.timeLimit }
+func (l *Lesson) MaxAttempts() int   { return l.maxAttempts }
+
+func (l *Lesson) BankQuestionIDs() []string { return l.bankQuestionIDs }
+func (l *Lesson) BankPools() []bank.Pool    { return l.bankPools }
 
 // Behavior methods
 func (l *Lesson) HasVideo() bool {
@@ -68,7 +105,7 @@ func (l *Lesson) UpdateVideoID(videoID string) error {
 
 func (l *Lesson) UpdateDuration(duration int) error {
 	if duration < 0 {
-		return errors.New("duration cannot be negative")
+		return commonerrors.NewValidationError("invalid-duration", commonerrors.NewFieldError("duration", "duration cannot be negative"))
 	}
 	l.duration = duration
 	return nil
@@ -76,15 +113,115 @@ func (l *Lesson) UpdateDuration(duration int) error {
 
 func (l *Lesson) UpdateOrder(order int) error {
 	if order < 0 {
-		return errors.New("order cannot be negative")
+		return commonerrors.NewValidationError("invalid-order", commonerrors.NewFieldError("order", "order cannot be negative"))
 	}
 	l.order = order
 	return nil
 }
 
+// SetGate replaces the unlock rules of the lesson. A lesson cannot ask for a
+// minimum score on itself.
+func (l *Lesson) SetGate(gate Gate) error {
+	if gate.scoreLessonID == l.id {
+		return commonerrors.NewValidationError("invalid-lesson-gate", commonerrors.NewFieldError("scoreLessonId", "a lesson cannot require a score on itself"))
+	}
+	l.gate = gate
+	return nil
+}
+
+// SetPassScore sets the exercise score a student has to reach to complete the
+// lesson. Zero removes the pass score.
+func (l *Lesson) SetPassScore(score float64) error {
+	if score < 0 || score > 100 {
+		return commonerrors.NewValidationError("invalid-pass-score", commonerrors.NewFieldError("passScore", "pass score must be between 0 and 100"))
+	}
+	l.passScore = score
+	return nil
+}
+
+// SetDrawCount sets how many exercises a quiz session on the lesson draws
+// from its pool. Zero draws every exercise.
+func (l *Lesson) SetDrawCount(count int) error {
+	if count < 0 {
+		return commonerrors.NewValidationError("invalid-draw-count", commonerrors.NewFieldError("drawCount", "draw count cannot be negative"))
+	}
+	l.drawCount = count
+	return nil
+}
+
+// SetTimeLimit sets the minutes a quiz session on the lesson can last. Zero
+// removes the time limit.
+func (l *Lesson) SetTimeLimit(minutes int) error {
+	if minutes < 0 {
+		return commonerrors.NewValidationError("invalid-time-limit", commonerrors.NewFieldError("timeLimit", "time limit cannot be negative"))
+	}
+	l.timeLimit = minutes
+	return nil
+}
+
+// SetMaxAttempts caps the attempts a student has at the exercises of the
+// lesson. Zero removes the cap.
+func (l *Lesson) SetMaxAttempts(attempts int) error {
+	if attempts < 0 {
+		return commonerrors.NewValidationError("invalid-max-attempts", commonerrors.NewFieldError("maxAttempts", "max attempts cannot be negative"))
+	}
+	l.maxAttempts = attempts
+	return nil
+}
+
+// SetBankQuestions replaces the bank questions the lesson refers to: the
+// questions it always asks and the pools it draws from. The questions are
+// copied into the exercises of the lesson when the course is published, so
+// until then they follow every edit made in their bank.
+func (l *Lesson) SetBankQuestions(questionIDs []string, pools []bank.Pool) error {
+	var v commonerrors.Validation
+	seenQuestions := make(map[string]bool, len(questionIDs))
+	for _, id := range questionIDs {
+		if id == "" {
+			v.Add("questionIds", "question id is required")
+			continue
+		}
+		if seenQuestions[id] {
+			v.Add("questionIds", fmt.Sprintf("question '%s' is referred to twice", id))
+		}
+		seenQuestions[id] = true
+	}
+	seenPools := make(map[string]bool, len(pools))
+	for _, p := range pools {
+		if seenPools[p.Key()] {
+			v.Add("pools", fmt.Sprintf("bank '%s' is drawn from twice with the same filter", p.BankID()))
+		}
+		seenPools[p.Key()] = true
+	}
+	if err := v.Err("invalid-bank-questions"); err != nil {
+		return err
+	}
+
+	l.bankQuestionIDs = questionIDs
+	l.bankPools = pools
+	return nil
+}
+
+// PoolDraws returns how many questions every quiz session on the lesson
+// draws from each of its bank pools, keyed by pool.
+func (l *Lesson) PoolDraws() map[string]int {
+	draws := make(map[string]int, len(l.bankPools))
+	for _, p := range l.bankPools {
+		draws[p.Key()] = p.Count()
+	}
+	return draws
+}
+
+// IsRestricted reports whether the exercises of the lesson can only be
+// answered in a quiz session, which enforces its time limit and attempt cap
+// and draws from its bank pools.
+func (l *Lesson) IsRestricted() bool {
+	return l.timeLimit > 0 || l.maxAttempts > 0 || len(l.bankPools) > 0
+}
+
 func (l *Lesson) UpdateTitle(title string) error {
 	if title == "" {
-		return errors.New("title is required")
+		return commonerrors.NewValidationError("invalid-title", commonerrors.NewFieldError("title", "title is required"))
 	}
 	l.title = title
 	return nil
@@ -2,6 +2,8 @@ package lesson
 
 import (
 	"testing"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
 )
 
 func TestNewLesson(t *testing.T) {
@@ -454,6 +456,164 @@ func TestLesson_UpdateTitle(t *testing.T) {
 	})
 }
 
+func TestNewGate(t *testing.T) {
+	t.Parallel()
+	t.Run("zero gate is open", func(t *testing.T) {
+		if !(Gate{}).IsOpen() {
+			t.Error("expected zero gate to be open")
+		}
+	})
+
+	t.Run("successfully creates gate with both rules", func(t *testing.T) {
+		gate, err := NewGate(true, "lesson-1", 80)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !gate.RequiresPrevious() || !gate.RequiresScore() {
+			t.Error("expected gate to require previous lessons and a score")
+		}
+		if gate.ScoreLessonID() != "lesson-1" || gate.MinScore() != 80 {
+			t.Errorf("expected score 80 on 'lesson-1', got %v on '%s'", gate.MinScore(), gate.ScoreLessonID())
+		}
+		if gate.IsOpen() {
+			t.Error("expected gate not to be open")
+		}
+	})
+
+	t.Run("fails when min score has no lesson", func(t *testing.T) {
+		if _, err := NewGate(false, "", 50); err == nil {
+			t.Fatal("expected error for min score without lesson, got nil")
+		}
+	})
+
+	t.Run("fails when min score is out of range", func(t *testing.T) {
+		for _, score := range []float64{0, -1, 101} {
+			if _, err := NewGate(false, "lesson-1", score); err == nil {
+				t.Errorf("expected error for min score %v, got nil", score)
+			}
+		}
+	})
+}
+
+func TestLesson_SetGate(t *testing.T) {
+	t.Parallel()
+	lesson, _ := NewLesson("lesson-123", "module-456", "Title", "", "", "", 0, 1)
+
+	t.Run("successfully sets gate", func(t *testing.T) {
+		gate, _ := NewGate(true, "lesson-1", 60)
+		if err := lesson.SetGate(gate); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lesson.Gate() != gate {
+			t.Errorf("expected gate %+v, got %+v", gate, lesson.Gate())
+		}
+	})
+
+	t.Run("fails when lesson requires a score on itself", func(t *testing.T) {
+		original := lesson.Gate()
+		gate, _ := NewGate(false, "lesson-123", 60)
+		if err := lesson.SetGate(gate); err == nil {
+			t.Fatal("expected error for self-referencing gate, got nil")
+		}
+		if lesson.Gate() != original {
+			t.Error("expected gate to remain unchanged after failed update")
+		}
+	})
+}
+
+func TestLesson_SetPassScore(t *testing.T) {
+	t.Parallel()
+	lesson, _ := NewLesson("lesson-123", "module-456", "Title", "", "", "", 0, 1)
+
+	t.Run("successfully sets pass score", func(t *testing.T) {
+		if err := lesson.SetPassScore(80); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lesson.PassScore() != 80 {
+			t.Errorf("expected pass score 80, got %v", lesson.PassScore())
+		}
+	})
+
+	t.Run("fails when pass score is above 100", func(t *testing.T) {
+		if err := lesson.SetPassScore(120); err == nil {
+			t.Fatal("expected error for pass score above 100, got nil")
+		}
+		if lesson.PassScore() != 80 {
+			t.Error("expected pass score to remain unchanged after failed update")
+		}
+	})
+
+	t.Run("zero removes the pass score", func(t *testing.T) {
+		if err := lesson.SetPassScore(0); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lesson.PassScore() != 0 {
+			t.Errorf("expected no pass score, got %v", lesson.PassScore())
+		}
+	})
+}
+
+func TestLesson_SetDrawCount(t *testing.T) {
+	t.Parallel()
+	lesson, _ := NewLesson("lesson-123", "module-456", "Title", "", "", "", 0, 1)
+
+	t.Run("successfully sets draw count", func(t *testing.T) {
+		if err := lesson.SetDrawCount(5); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if lesson.DrawCount() != 5 {
+			t.Errorf("expected draw count 5, got %d", lesson.DrawCount())
+		}
+	})
+
+	t.Run("fails when draw count is negative", func(t *testing.T) {
+		if err := lesson.SetDrawCount(-1); err == nil {
+			t.Fatal("expected error for negative draw count, got nil")
+		}
+		if lesson.DrawCount() != 5 {
+			t.Error("expected draw count to remain unchanged after failed update")
+		}
+	})
+}
+
+func TestLesson_SetBankQuestions(t *testing.T) {
+	t.Parallel()
+	lesson, _ := NewLesson("lesson-123", "module-456", "Title", "", "", "", 0, 1)
+	pool, _ := bank.NewPool("bank-1", bank.NewFilter("Go", bank.Easy), 2)
+
+	t.Run("successfully sets bank questions and pools", func(t *testing.T) {
+		if err := lesson.SetBankQuestions([]string{"question-1"}, []bank.Pool{pool}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(lesson.BankQuestionIDs()) != 1 || len(lesson.BankPools()) != 1 {
+			t.Errorf("expected 1 question and 1 pool, got %d and %d", len(lesson.BankQuestionIDs()), len(lesson.BankPools()))
+		}
+		if lesson.PoolDraws()[pool.Key()] != 2 {
+			t.Errorf("expected 2 questions drawn from the pool, got %v", lesson.PoolDraws())
+		}
+		if !lesson.IsRestricted() {
+			t.Error("expected a lesson drawing from a pool to need a quiz session")
+		}
+	})
+
+	t.Run("fails when a question is referred to twice", func(t *testing.T) {
+		if err := lesson.SetBankQuestions([]string{"question-2", "question-2"}, nil); err == nil {
+			t.Fatal("expected error for duplicate question, got nil")
+		}
+		if lesson.BankQuestionIDs()[0] != "question-1" {
+			t.Error("expected bank questions to remain unchanged after failed update")
+		}
+	})
+
+	t.Run("fails when a pool is drawn from twice", func(t *testing.T) {
+		same, _ := bank.NewPool("bank-1", bank.NewFilter("go ", bank.Easy), 1)
+		if err := lesson.SetBankQuestions(nil, []bank.Pool{pool, same}); err == nil {
+			t.Fatal("expected error for duplicate pool, got nil")
+		}
+	})
+}
+
 func TestLesson_Getters(t *testing.T) {
 	t.Parallel()
 	lesson, _ := NewLesson(
@@ -1,23 +1,29 @@
 package module
 
-import "github.com/pkg/errors"
+import commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 
 type Module struct {
 	id       string
 	courseID string
 	title    string
 	order    int
+	duration int
+	release  Release
 }
 
 func NewModule(id string, courseID string, title string, order int) (*Module, error) {
+	var v commonerrors.Validation
 	if id == "" {
-		return nil, errors.New("module id is required")
+		v.Add("id", "module id is required")
 	}
 	if courseID == "" {
-		return nil, errors.New("course id is required")
+		v.Add("courseId", "course id is required")
 	}
 	if title == "" {
-		return nil, errors.New("module title is required")
+		v.Add("title", "module title is required")
+	}
+	if err := v.Err("invalid-module"); err != nil {
+		return nil, err
 	}
 
 	return &Module{
@@ -28,16 +34,31 @@ func NewModule(id string, courseID string, title string, order int) (*Module, er
 	}, nil
 }
 
+// UnmarshalModuleFromDatabase restores a module together with its duration,
+// which the database derives from the durations of its lessons. It should
+// only be used by repositories.
+func UnmarshalModuleFromDatabase(id string, courseID string, title string, order int, duration int) (*Module, error) {
+	m, err := NewModule(id, courseID, title, order)
+	if err != nil {
+		return nil, err
+	}
+
+	m.duration = duration
+
+	return m, nil
+}
+
 // Getters (read-only access for serialization/display)
 func (m *Module) ID() string       { return m.id }
 func (m *Module) CourseID() string { return m.courseID }
 func (m *Module) Title() string    { return m.title }
 func (m *Module) Order() int       { return m.order }
+func (m *Module) Duration() int    { return m.duration }
 
 // Behavior methods
 func (m *Module) UpdateOrder(order int) error {
 	if order < 0 {
-		return errors.New("order cannot be negative")
+		return commonerrors.NewValidationError("invalid-order", commonerrors.NewFieldError("order", "order cannot be negative"))
 	}
 	m.order = order
 	return nil
@@ -45,7 +66,7 @@ func (m *Module) UpdateOrder(order int) error {
 
 func (m *Module) UpdateTitle(title string) error {
 	if title == "" {
-		return errors.New("title is required")
+		return commonerrors.NewValidationError("invalid-title", commonerrors.NewFieldError("title", "title is required"))
 	}
 	m.title = title
 	return nil
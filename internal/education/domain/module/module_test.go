@@ -2,6 +2,7 @@ package module
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNewModule(t *testing.T) {
@@ -124,6 +125,32 @@ func TestNewModule(t *testing.T) {
 	})
 }
 
+func TestUnmarshalModuleFromDatabase(t *testing.T) {
+	t.Parallel()
+	t.Run("restores the derived duration", func(t *testing.T) {
+		module, err := UnmarshalModuleFromDatabase("module-123", "course-456", "Introduction to Go", 1, 45)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if module.Duration() != 45 {
+			t.Errorf("expected Duration 45, got %d", module.Duration())
+		}
+	})
+
+	t.Run("new modules have no duration until lessons are added", func(t *testing.T) {
+		module, _ := NewModule("module-123", "course-456", "Introduction to Go", 1)
+		if module.Duration() != 0 {
+			t.Errorf("expected Duration 0, got %d", module.Duration())
+		}
+	})
+
+	t.Run("fails with invalid data", func(t *testing.T) {
+		if _, err := UnmarshalModuleFromDatabase("", "course-456", "Introduction to Go", 1, 45); err == nil {
+			t.Fatal("expected error for empty ID, got nil")
+		}
+	})
+}
+
 func TestModule_UpdateOrder(t *testing.T) {
 	t.Parallel()
 	module, _ := NewModule(
@@ -272,3 +299,59 @@ func TestModule_Getters(t *testing.T) {
 		}
 	})
 }
+
+func TestNewRelease(t *testing.T) {
+	t.Parallel()
+	enrolledAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("zero release opens on enrollment", func(t *testing.T) {
+		release := Release{}
+		if !release.IsImmediate() {
+			t.Error("expected zero release to be immediate")
+		}
+		if !release.IsReleased(enrolledAt, enrolledAt) {
+			t.Error("expected zero release to be open on enrollment")
+		}
+	})
+
+	t.Run("releases days after enrollment", func(t *testing.T) {
+		release, err := NewRelease(7, time.Time{})
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if want := enrolledAt.AddDate(0, 0, 7); !release.UnlockAt(enrolledAt).Equal(want) {
+			t.Errorf("expected unlock at %v, got %v", want, release.UnlockAt(enrolledAt))
+		}
+		if release.IsReleased(enrolledAt, enrolledAt.AddDate(0, 0, 6)) {
+			t.Error("expected module to be locked after 6 days")
+		}
+		if !release.IsReleased(enrolledAt, enrolledAt.AddDate(0, 0, 7)) {
+			t.Error("expected module to be released after 7 days")
+		}
+	})
+
+	t.Run("releases on a date regardless of enrollment", func(t *testing.T) {
+		date := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		release, err := NewRelease(0, date)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !release.UnlockAt(enrolledAt).Equal(date) || !release.UnlockAt(date.AddDate(0, 1, 0)).Equal(date) {
+			t.Errorf("expected unlock at %v for every enrollment", date)
+		}
+	})
+
+	t.Run("fails when days are negative", func(t *testing.T) {
+		if _, err := NewRelease(-1, time.Time{}); err == nil {
+			t.Fatal("expected error for negative days, got nil")
+		}
+	})
+
+	t.Run("fails when both days and date are set", func(t *testing.T) {
+		if _, err := NewRelease(7, enrolledAt); err == nil {
+			t.Fatal("expected error for days and date, got nil")
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package module
+
+import (
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+// Release schedules when a module opens to a student: a number of days after
+// the student enrolled, or on a fixed date for the whole cohort. The zero
+// Release opens the module as soon as the student enrolls.
+type Release struct {
+	daysAfterEnrollment int
+	date                time.Time
+}
+
+// NewRelease creates a release rule. At most one of daysAfterEnrollment and
+// date can be set, and both unset releases the module immediately.
+func NewRelease(daysAfterEnrollment int, date time.Time) (Release, error) {
+	var v commonerrors.Validation
+	if daysAfterEnrollment < 0 {
+		v.Add("daysAfterEnrollment", "days after enrollment cannot be negative")
+	}
+	if daysAfterEnrollment > 0 && !date.IsZero() {
+		v.Add("date", "release either a number of days after enrollment or on a date, not both")
+	}
+	if err := v.Err("invalid-module-release"); err != nil {
+		return Release{}, err
+	}
+
+	return Release{daysAfterEnrollment: daysAfterEnrollment, date: date}, nil
+}
+
+func (r Release) DaysAfterEnrollment() int { return r.daysAfterEnrollment }
+func (r Release) Date() time.Time          { return r.date }
+
+// IsImmediate reports whether the module opens as soon as the student enrolls.
+func (r Release) IsImmediate() bool {
+	return r.daysAfterEnrollment == 0 && r.date.IsZero()
+}
+
+// UnlockAt returns when the module opens for a student who enrolled at
+// enrolledAt.
+func (r Release) UnlockAt(enrolledAt time.Time) time.Time {
+	if !r.date.IsZero() {
+		return r.date
+	}
+	return enrolledAt.AddDate(0, 0, r.daysAfterEnrollment)
+}
+
+// IsReleased reports whether the module is open at now for a student who
+// enrolled at enrolledAt.
+func (r Release) IsReleased(enrolledAt time.Time, now time.Time) bool {
+	return !now.Before(r.UnlockAt(enrolledAt))
+}
+
+func (m *Module) Release() Release { return m.release }
+
+// SetRelease replaces the release rule of the module.
+func (m *Module) SetRelease(release Release) {
+	m.release = release
+}
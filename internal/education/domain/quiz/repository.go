@@ -0,0 +1,24 @@
+package quiz
+
+import (
+	"context"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+)
+
+// SessionRepository manages quiz Session persistence
+type SessionRepository interface {
+	// Create saves a new quiz session together with the changes
+	// updateEnrollment makes to the enrollment it belongs to. The enrollment
+	// is locked while updateEnrollment runs, and nothing is saved when it fails.
+	Create(ctx context.Context, session *Session, updateEnrollment func(e *enrollment.Enrollment) error) error
+
+	// Get retrieves a quiz session by ID
+	Get(ctx context.Context, id string) (*Session, error)
+
+	// MarkSubmitted records the attempt grading the session, the attempt itself
+	// and the changes updateEnrollment makes to the enrollment, all or nothing.
+	// It fails with a conflict when the session was already submitted.
+	MarkSubmitted(ctx context.Context, session *Session, attempt *attempt.Attempt, updateEnrollment func(e *enrollment.Enrollment) error) error
+}
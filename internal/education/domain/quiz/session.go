@@ -0,0 +1,350 @@
+package quiz
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/pkg/errors"
+)
+
+// SubmissionGrace is how long past its deadline a timed session still
+// accepts a submission, making up for the time the answers take to reach
+// the server.
+const SubmissionGrace = 10 * time.Second
+
+// Session is a quiz on the exercises of a lesson, delivered to one student.
+// It draws its exercises from the lesson in a random order and shuffles their
+// answer options. Both come from the seed of the session, which never leaves
+// the server, so the same questions are presented every time the session is
+// fetched and submissions can be graded against what the student saw.
+type Session struct {
+	id           string
+	enrollmentID string
+	lessonID     string
+	// contentVersion is the course content version the exercises were drawn
+	// from. Submissions are graded against it.
+	contentVersion int
+	seed           int64
+	exerciseIDs    []string
+	startedAt      time.Time
+	// deadline is when a timed session stops accepting answers, zero for
+	// untimed sessions
+	deadline time.Time
+	// attemptID is the attempt grading the session once it was submitted
+	attemptID   string
+	submittedAt time.Time
+}
+
+// Question is an exercise of a session with its options in the order they
+// were presented to the student.
+type Question struct {
+	exercise *exercise.Exercise
+	// order maps the presented position of an option to its position in the
+	// exercise
+	order []int
+}
+
+// Response is the answer given to a question of a session. Choices are the
+// positions of the selected options as presented; when there are none, values
+// holds the typed answer or the options themselves.
+type Response struct {
+	Choices []int
+	Values  []string
+}
+
+// NewSession draws drawCount exercises from the pool of the lesson, or every
+// exercise when drawCount is zero or exceeds the pool. Exercises from a bank
+// pool of the lesson are drawn separately, as many as poolDraws holds for
+// their pool. A session with a time limit has to be submitted before the
+// limit runs out.
+func NewSession(
+	id string,
+	enrollmentID string,
+	lessonID string,
+	contentVersion int,
+	pool []*exercise.Exercise,
+	drawCount int,
+	poolDraws map[string]int,
+	timeLimit time.Duration,
+	seed int64,
+) (*Session, error) {
+	var v commonerrors.Validation
+	if id == "" {
+		v.Add("id", "quiz session id is required")
+	}
+	if enrollmentID == "" {
+		v.Add("enrollmentId", "enrollment id is required")
+	}
+	if lessonID == "" {
+		v.Add("lessonId", "lesson id is required")
+	}
+	if drawCount < 0 {
+		v.Add("drawCount", "draw count cannot be negative")
+	}
+	if timeLimit < 0 {
+		v.Add("timeLimit", "time limit cannot be negative")
+	}
+	if err := v.Err("invalid-quiz-session"); err != nil {
+		return nil, err
+	}
+	if len(pool) == 0 {
+		return nil, commonerrors.NewIncorrectInputError("lesson has no exercises", "lesson-has-no-exercises")
+	}
+
+	groups := make(map[string][]string)
+	for _, e := range pool {
+		if e.LessonID() != lessonID {
+			return nil, errors.Errorf("exercise '%s' does not belong to the lesson", e.ID())
+		}
+		groups[e.Pool()] = append(groups[e.Pool()], e.ID())
+	}
+	// Pools and their exercises are drawn from in a stable order, so the seed
+	// alone decides which exercises are drawn
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	random := rand.New(rand.NewSource(seed))
+	drawn := make([]string, 0, len(pool))
+	for _, key := range keys {
+		ids := groups[key]
+		sort.Strings(ids)
+
+		count := drawCount
+		if key != "" {
+			count = poolDraws[key]
+		}
+		if count == 0 || count > len(ids) {
+			count = len(ids)
+		}
+		for _, i := range random.Perm(len(ids))[:count] {
+			drawn = append(drawn, ids[i])
+		}
+	}
+	if len(keys) > 1 {
+		random.Shuffle(len(drawn), func(i, j int) { drawn[i], drawn[j] = drawn[j], drawn[i] })
+	}
+
+	startedAt := time.Now()
+	var deadline time.Time
+	if timeLimit > 0 {
+		deadline = startedAt.Add(timeLimit)
+	}
+
+	return &Session{
+		id:             id,
+		enrollmentID:   enrollmentID,
+		lessonID:       lessonID,
+		contentVersion: contentVersion,
+		seed:           seed,
+		exerciseIDs:    drawn,
+		startedAt:      startedAt,
+		deadline:       deadline,
+	}, nil
+}
+
+// UnmarshalSessionFromDatabase restores a quiz session.
+// It should only be used by repositories.
+func UnmarshalSessionFromDatabase(
+	id string,
+	enrollmentID string,
+	lessonID string,
+	contentVersion int,
+	seed int64,
+	exerciseIDs []string,
+	startedAt time.Time,
+	deadline time.Time,
+	attemptID string,
+	submittedAt time.Time,
+) *Session {
+	return &Session{
+		id:             id,
+		enrollmentID:   enrollmentID,
+		lessonID:       lessonID,
+		contentVersion: contentVersion,
+		seed:           seed,
+		exerciseIDs:    exerciseIDs,
+		startedAt:      startedAt,
+		deadline:       deadline,
+		attemptID:      attemptID,
+		submittedAt:    submittedAt,
+	}
+}
+
+// Getters (read-only access for serialization/display)
+func (s *Session) ID() string             { return s.id }
+func (s *Session) EnrollmentID() string   { return s.enrollmentID }
+func (s *Session) LessonID() string       { return s.lessonID }
+func (s *Session) ContentVersion() int    { return s.contentVersion }
+func (s *Session) ExerciseIDs() []string  { return s.exerciseIDs }
+func (s *Session) StartedAt() time.Time   { return s.startedAt }
+func (s *Session) Deadline() time.Time    { return s.deadline }
+func (s *Session) AttemptID() string      { return s.attemptID }
+func (s *Session) SubmittedAt() time.Time { return s.submittedAt }
+
+// SeedForStorage returns the seed of the session.
+// It should only be used by repositories; clients must never see it.
+func (s *Session) SeedForStorage() int64 { return s.seed }
+
+// Submitted reports whether the session was already graded.
+func (s *Session) Submitted() bool { return s.attemptID != "" }
+
+// IsTimed reports whether the session has a deadline.
+func (s *Session) IsTimed() bool { return !s.deadline.IsZero() }
+
+// Remaining returns the time left at now to submit a timed session, never
+// less than zero.
+func (s *Session) Remaining(now time.Time) time.Duration {
+	if !s.IsTimed() || !now.Before(s.deadline) {
+		return 0
+	}
+	return s.deadline.Sub(now)
+}
+
+// Expired reports whether a timed session no longer accepts a submission at
+// now, once its deadline and the grace period have passed.
+func (s *Session) Expired(now time.Time) bool {
+	return s.IsTimed() && now.After(s.deadline.Add(SubmissionGrace))
+}
+
+// Questions presents the drawn exercises, in the order of the session, with
+// their options shuffled. exercises are the exercises of the lesson in the
+// content version of the session.
+func (s *Session) Questions(exercises []*exercise.Exercise) ([]Question, error) {
+	byID := make(map[string]*exercise.Exercise, len(exercises))
+	for _, e := range exercises {
+		byID[e.ID()] = e
+	}
+
+	questions := make([]Question, 0, len(s.exerciseIDs))
+	for _, exerciseID := range s.exerciseIDs {
+		e, ok := byID[exerciseID]
+		if !ok {
+			return nil, commonerrors.NewConflictError(fmt.Sprintf("exercise '%s' of the quiz session is no longer in the lesson", exerciseID), "quiz-session-outdated")
+		}
+		questions = append(questions, Question{
+			exercise: e,
+			order:    s.optionOrder(e),
+		})
+	}
+
+	return questions, nil
+}
+
+// Submit grades the responses against the questions as they were presented
+// and closes the session. Responses are keyed by exercise ID; drawn exercises
+// left unanswered earn nothing and exercises that were not drawn are refused.
+// Timed sessions refuse submissions past their deadline.
+func (s *Session) Submit(attemptID string, exercises []*exercise.Exercise, responses map[string]Response) (*attempt.Attempt, error) {
+	if s.Submitted() {
+		return nil, commonerrors.NewConflictError("quiz session was already submitted", "quiz-session-submitted")
+	}
+	if s.Expired(time.Now()) {
+		return nil, commonerrors.NewConflictError(
+			fmt.Sprintf("quiz session ended at %s", s.deadline.Format(time.RFC3339)),
+			"quiz-session-expired",
+		)
+	}
+
+	questions, err := s.Questions(exercises)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]Question, len(questions))
+	drawn := make([]*exercise.Exercise, 0, len(questions))
+	for _, q := range questions {
+		byID[q.exercise.ID()] = q
+		drawn = append(drawn, q.exercise)
+	}
+
+	exerciseIDs := make([]string, 0, len(responses))
+	for exerciseID := range responses {
+		exerciseIDs = append(exerciseIDs, exerciseID)
+	}
+	sort.Strings(exerciseIDs)
+
+	var v commonerrors.Validation
+	submitted := make(map[string][]string, len(responses))
+	for _, exerciseID := range exerciseIDs {
+		q, ok := byID[exerciseID]
+		if !ok {
+			v.Add("answers", fmt.Sprintf("exercise '%s' is not part of the quiz session", exerciseID))
+			continue
+		}
+		response := responses[exerciseID]
+		if len(response.Choices) == 0 {
+			submitted[exerciseID] = response.Values
+			continue
+		}
+		values, err := q.resolve(response.Choices)
+		if err != nil {
+			v.Add("answers", err.Error())
+			continue
+		}
+		submitted[exerciseID] = values
+	}
+	if err := v.Err("invalid-answers"); err != nil {
+		return nil, err
+	}
+
+	graded, err := attempt.NewAttempt(attemptID, s.enrollmentID, s.lessonID, drawn, submitted)
+	if err != nil {
+		return nil, err
+	}
+
+	s.attemptID = graded.ID()
+	s.submittedAt = graded.SubmittedAt()
+
+	return graded, nil
+}
+
+// optionOrder shuffles the options of an exercise with a source derived from
+// the seed of the session and the exercise, so every exercise gets its own
+// order. True/false options keep their order.
+func (s *Session) optionOrder(e *exercise.Exercise) []int {
+	n := len(e.Answers())
+	if e.Kind() == exercise.TrueFalse {
+		order := make([]int, n)
+		for i := range order {
+			order[i] = i
+		}
+		return order
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(e.ID()))
+	return rand.New(rand.NewSource(s.seed ^ int64(h.Sum64()))).Perm(n)
+}
+
+func (q Question) Exercise() *exercise.Exercise { return q.exercise }
+
+// Options returns the answer options in the order they are presented.
+func (q Question) Options() []string {
+	answers := q.exercise.Answers()
+	options := make([]string, 0, len(q.order))
+	for _, i := range q.order {
+		options = append(options, answers[i])
+	}
+	return options
+}
+
+// resolve maps the positions of presented options back to the options.
+func (q Question) resolve(choices []int) ([]string, error) {
+	options := q.Options()
+	values := make([]string, 0, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(options) {
+			return nil, errors.Errorf("exercise '%s' has no option at position %d", q.exercise.ID(), choice)
+		}
+		values = append(values, options[choice])
+	}
+	return values, nil
+}
@@ -0,0 +1,307 @@
+package quiz
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+)
+
+func newTestPool(t *testing.T, size int) []*exercise.Exercise {
+	t.Helper()
+
+	pool := make([]*exercise.Exercise, 0, size)
+	for i := 0; i < size; i++ {
+		e, err := exercise.NewExercise(
+			fmt.Sprintf("exercise-%d", i),
+			"lesson-1",
+			fmt.Sprintf("Question %d?", i),
+			[]string{"a", "b", "c", "d"},
+			"c",
+			i,
+		)
+		if err != nil {
+			t.Fatalf("failed to create exercise: %v", err)
+		}
+		pool = append(pool, e)
+	}
+
+	return pool
+}
+
+func TestNewSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("draws a random subset of the pool", func(t *testing.T) {
+		s, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, newTestPool(t, 10), 3, nil, 0, 42)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(s.ExerciseIDs()) != 3 {
+			t.Fatalf("expected 3 exercises, got %d", len(s.ExerciseIDs()))
+		}
+		seen := make(map[string]bool)
+		for _, id := range s.ExerciseIDs() {
+			if seen[id] {
+				t.Errorf("expected exercise '%s' to be drawn once", id)
+			}
+			seen[id] = true
+		}
+	})
+
+	t.Run("draws every exercise without a draw count", func(t *testing.T) {
+		s, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, newTestPool(t, 4), 0, nil, 0, 42)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(s.ExerciseIDs()) != 4 {
+			t.Errorf("expected 4 exercises, got %d", len(s.ExerciseIDs()))
+		}
+	})
+
+	t.Run("the seed decides the draw", func(t *testing.T) {
+		pool := newTestPool(t, 10)
+		first, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 5, nil, 0, 7)
+		// The order the pool is given in does not matter
+		reversed := make([]*exercise.Exercise, len(pool))
+		for i, e := range pool {
+			reversed[len(pool)-1-i] = e
+		}
+		second, _ := NewSession("session-2", "enrollment-1", "lesson-1", 1, reversed, 5, nil, 0, 7)
+		if fmt.Sprint(first.ExerciseIDs()) != fmt.Sprint(second.ExerciseIDs()) {
+			t.Errorf("expected the same draw, got %v and %v", first.ExerciseIDs(), second.ExerciseIDs())
+		}
+	})
+
+	t.Run("draws from every bank pool separately", func(t *testing.T) {
+		pool := newTestPool(t, 9)
+		for _, e := range pool[3:6] {
+			e.SetPool("bank-1/go/")
+		}
+		for _, e := range pool[6:] {
+			e.SetPool("bank-1/sql/")
+		}
+
+		s, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 0, map[string]int{"bank-1/go/": 1, "bank-1/sql/": 2}, 0, 42)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		drawn := make(map[string]int)
+		for _, id := range s.ExerciseIDs() {
+			for _, e := range pool {
+				if e.ID() == id {
+					drawn[e.Pool()]++
+				}
+			}
+		}
+		if drawn[""] != 3 || drawn["bank-1/go/"] != 1 || drawn["bank-1/sql/"] != 2 {
+			t.Errorf("expected 3 own exercises, 1 and 2 from the pools, got %v", drawn)
+		}
+	})
+
+	t.Run("fails on an empty pool", func(t *testing.T) {
+		if _, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, nil, 0, nil, 0, 42); err == nil {
+			t.Fatal("expected error for empty pool, got nil")
+		}
+	})
+
+	t.Run("fails without enrollment", func(t *testing.T) {
+		if _, err := NewSession("session-1", "", "lesson-1", 1, newTestPool(t, 2), 0, nil, 0, 42); err == nil {
+			t.Fatal("expected error for missing enrollment, got nil")
+		}
+	})
+}
+
+func TestSession_Questions(t *testing.T) {
+	t.Parallel()
+	pool := newTestPool(t, 5)
+	s, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 0, nil, 0, 42)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	t.Run("presents the same questions every time", func(t *testing.T) {
+		first, err := s.Questions(pool)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		second, _ := s.Questions(pool)
+		for i := range first {
+			if first[i].Exercise().ID() != second[i].Exercise().ID() {
+				t.Errorf("expected question %d to stay '%s', got '%s'", i, first[i].Exercise().ID(), second[i].Exercise().ID())
+			}
+			if fmt.Sprint(first[i].Options()) != fmt.Sprint(second[i].Options()) {
+				t.Errorf("expected options %v to stay, got %v", first[i].Options(), second[i].Options())
+			}
+		}
+	})
+
+	t.Run("shuffles the options", func(t *testing.T) {
+		questions, _ := s.Questions(pool)
+		shuffled := false
+		for _, q := range questions {
+			options := q.Options()
+			if fmt.Sprint(options) != fmt.Sprint(q.Exercise().Answers()) {
+				shuffled = true
+			}
+			sorted := append([]string(nil), options...)
+			sort.Strings(sorted)
+			if fmt.Sprint(sorted) != "[a b c d]" {
+				t.Errorf("expected every option once, got %v", options)
+			}
+		}
+		if !shuffled {
+			t.Error("expected the options of at least one question to be shuffled")
+		}
+	})
+
+	t.Run("fails when a drawn exercise left the lesson", func(t *testing.T) {
+		if _, err := s.Questions(pool[:1]); err == nil {
+			t.Fatal("expected error for missing exercise, got nil")
+		}
+	})
+}
+
+func TestSession_Submit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("grades choices against the presented options", func(t *testing.T) {
+		pool := newTestPool(t, 3)
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 0, nil, 0, 42)
+		questions, _ := s.Questions(pool)
+
+		responses := make(map[string]Response)
+		for _, q := range questions {
+			for i, option := range q.Options() {
+				if option == "c" {
+					responses[q.Exercise().ID()] = Response{Choices: []int{i}}
+				}
+			}
+		}
+
+		a, err := s.Submit("attempt-1", pool, responses)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if a.Score() != 100 {
+			t.Errorf("expected score 100, got %v", a.Score())
+		}
+		if !s.Submitted() || s.AttemptID() != "attempt-1" {
+			t.Error("expected the session to be submitted with the attempt")
+		}
+	})
+
+	t.Run("only the drawn exercises count", func(t *testing.T) {
+		pool := newTestPool(t, 4)
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 2, nil, 0, 42)
+		drawn := s.ExerciseIDs()
+
+		a, err := s.Submit("attempt-1", pool, map[string]Response{
+			drawn[0]: {Values: []string{"c"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if a.TotalPoints() != 2 {
+			t.Errorf("expected 2 points, got %d", a.TotalPoints())
+		}
+		if a.Score() != 50 {
+			t.Errorf("expected score 50, got %v", a.Score())
+		}
+	})
+
+	t.Run("fails for an exercise that was not drawn", func(t *testing.T) {
+		pool := newTestPool(t, 4)
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 1, nil, 0, 42)
+		drawn := map[string]bool{s.ExerciseIDs()[0]: true}
+		responses := make(map[string]Response)
+		for _, e := range pool {
+			if !drawn[e.ID()] {
+				responses[e.ID()] = Response{Values: []string{"c"}}
+			}
+		}
+
+		if _, err := s.Submit("attempt-1", pool, responses); err == nil {
+			t.Fatal("expected error for exercise outside the session, got nil")
+		}
+		if s.Submitted() {
+			t.Error("expected the session to stay open after a failed submission")
+		}
+	})
+
+	t.Run("fails for a choice outside the options", func(t *testing.T) {
+		pool := newTestPool(t, 1)
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 0, nil, 0, 42)
+
+		if _, err := s.Submit("attempt-1", pool, map[string]Response{
+			"exercise-0": {Choices: []int{4}},
+		}); err == nil {
+			t.Fatal("expected error for unknown choice, got nil")
+		}
+	})
+
+	t.Run("fails when submitted twice", func(t *testing.T) {
+		pool := newTestPool(t, 1)
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, pool, 0, nil, 0, 42)
+		answers := map[string]Response{"exercise-0": {Values: []string{"c"}}}
+
+		if _, err := s.Submit("attempt-1", pool, answers); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := s.Submit("attempt-2", pool, answers); err == nil {
+			t.Fatal("expected error for second submission, got nil")
+		}
+	})
+}
+
+func TestSession_Deadline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a time limit sets the deadline", func(t *testing.T) {
+		s, err := NewSession("session-1", "enrollment-1", "lesson-1", 1, newTestPool(t, 1), 0, nil, 30*time.Minute, 42)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !s.IsTimed() {
+			t.Fatal("expected the session to be timed")
+		}
+		if remaining := s.Remaining(time.Now()); remaining <= 29*time.Minute || remaining > 30*time.Minute {
+			t.Errorf("expected about 30 minutes left, got %v", remaining)
+		}
+	})
+
+	t.Run("untimed sessions never expire", func(t *testing.T) {
+		s, _ := NewSession("session-1", "enrollment-1", "lesson-1", 1, newTestPool(t, 1), 0, nil, 0, 42)
+		if s.IsTimed() || s.Expired(time.Now().Add(24*time.Hour)) {
+			t.Error("expected the session to be untimed")
+		}
+	})
+
+	t.Run("refuses a submission past the deadline", func(t *testing.T) {
+		pool := newTestPool(t, 1)
+		started := time.Now().Add(-time.Hour)
+		s := UnmarshalSessionFromDatabase("session-1", "enrollment-1", "lesson-1", 1, 42, []string{"exercise-0"}, started, started.Add(30*time.Minute), "", time.Time{})
+
+		if s.Remaining(time.Now()) != 0 {
+			t.Errorf("expected no time left, got %v", s.Remaining(time.Now()))
+		}
+		if _, err := s.Submit("attempt-1", pool, map[string]Response{"exercise-0": {Values: []string{"c"}}}); err == nil {
+			t.Fatal("expected error for late submission, got nil")
+		}
+		if s.Submitted() {
+			t.Error("expected the late submission not to be recorded")
+		}
+	})
+
+	t.Run("accepts a submission within the grace period", func(t *testing.T) {
+		pool := newTestPool(t, 1)
+		deadline := time.Now().Add(-SubmissionGrace / 2)
+		s := UnmarshalSessionFromDatabase("session-1", "enrollment-1", "lesson-1", 1, 42, []string{"exercise-0"}, deadline.Add(-time.Minute), deadline, "", time.Time{})
+
+		if _, err := s.Submit("attempt-1", pool, map[string]Response{"exercise-0": {Values: []string{"c"}}}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package review
+
+import "context"
+
+// ReviewRepository manages Review persistence. Saving a review recomputes the
+// rating and review count of its course in the same transaction.
+type ReviewRepository interface {
+	// Create saves a new review
+	Create(ctx context.Context, review *Review) error
+
+	// Update saves the edited rating and text of a review
+	Update(ctx context.Context, review *Review) error
+
+	// Get retrieves a review by ID
+	Get(ctx context.Context, id string) (*Review, error)
+
+	// GetAllByCourseID retrieves the reviews of a course, newest first
+	GetAllByCourseID(ctx context.Context, courseID string) ([]*Review, error)
+}
@@ -0,0 +1,112 @@
+package review
+
+import (
+	"time"
+	"unicode/utf8"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+const (
+	MinRating     = 1
+	MaxRating     = 5
+	MaxTextLength = 5000
+)
+
+// Review is a student's star rating and written opinion of a course they are enrolled in.
+// A student reviews a course at most once and edits that review afterwards.
+type Review struct {
+	id        string
+	courseID  string
+	userID    string
+	rating    int
+	text      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewReview(id string, courseID string, userID string, rating int, text string) (*Review, error) {
+	var v commonerrors.Validation
+	if id == "" {
+		v.Add("id", "review id is required")
+	}
+	if courseID == "" {
+		v.Add("courseId", "course id is required")
+	}
+	if userID == "" {
+		v.Add("userId", "user id is required")
+	}
+	validateContent(&v, rating, text)
+	if err := v.Err("invalid-review"); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Review{
+		id:        id,
+		courseID:  courseID,
+		userID:    userID,
+		rating:    rating,
+		text:      text,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// UnmarshalReviewFromDatabase restores a stored review.
+// It should only be used by repositories.
+func UnmarshalReviewFromDatabase(
+	id string,
+	courseID string,
+	userID string,
+	rating int,
+	text string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Review {
+	return &Review{
+		id:        id,
+		courseID:  courseID,
+		userID:    userID,
+		rating:    rating,
+		text:      text,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Getters (read-only access for serialization/display)
+func (r *Review) ID() string           { return r.id }
+func (r *Review) CourseID() string     { return r.courseID }
+func (r *Review) UserID() string       { return r.userID }
+func (r *Review) Rating() int          { return r.rating }
+func (r *Review) Text() string         { return r.text }
+func (r *Review) CreatedAt() time.Time { return r.createdAt }
+func (r *Review) UpdatedAt() time.Time { return r.updatedAt }
+
+// Behavior methods
+func (r *Review) IsWrittenBy(userID string) bool {
+	return r.userID == userID
+}
+
+func (r *Review) Edit(rating int, text string) error {
+	var v commonerrors.Validation
+	validateContent(&v, rating, text)
+	if err := v.Err("invalid-review"); err != nil {
+		return err
+	}
+
+	r.rating = rating
+	r.text = text
+	r.updatedAt = time.Now()
+	return nil
+}
+
+func validateContent(v *commonerrors.Validation, rating int, text string) {
+	if rating < MinRating || rating > MaxRating {
+		v.Add("rating", "rating must be between 1 and 5 stars")
+	}
+	if utf8.RuneCountInString(text) > MaxTextLength {
+		v.Add("text", "review text cannot exceed 5000 characters")
+	}
+}
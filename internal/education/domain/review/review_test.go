@@ -0,0 +1,111 @@
+package review
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+)
+
+func TestNewReview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates review with valid data", func(t *testing.T) {
+		r, err := NewReview("review-1", "course-1", "user-1", 4, "Clear and well paced")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if r.Rating() != 4 {
+			t.Errorf("expected rating 4, got %d", r.Rating())
+		}
+		if r.Text() != "Clear and well paced" {
+			t.Errorf("expected text 'Clear and well paced', got '%s'", r.Text())
+		}
+		if !r.IsWrittenBy("user-1") {
+			t.Error("expected review to be written by user-1")
+		}
+		if r.CreatedAt().IsZero() || !r.CreatedAt().Equal(r.UpdatedAt()) {
+			t.Error("expected createdAt and updatedAt to be set to the same time")
+		}
+	})
+
+	t.Run("allows a rating without text", func(t *testing.T) {
+		if _, err := NewReview("review-1", "course-1", "user-1", 5, ""); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("fails when rating is out of range", func(t *testing.T) {
+		for _, rating := range []int{0, 6, -1} {
+			if _, err := NewReview("review-1", "course-1", "user-1", rating, ""); err == nil {
+				t.Errorf("expected error for rating %d, got nil", rating)
+			}
+		}
+	})
+
+	t.Run("fails when text is too long", func(t *testing.T) {
+		_, err := NewReview("review-1", "course-1", "user-1", 3, strings.Repeat("a", MaxTextLength+1))
+		if err == nil {
+			t.Fatal("expected error for long text, got nil")
+		}
+	})
+
+	t.Run("reports every invalid field at once", func(t *testing.T) {
+		_, err := NewReview("", "", "", 0, "")
+		if err == nil {
+			t.Fatal("expected error for empty fields, got nil")
+		}
+
+		var slugErr commonerrors.SlugError
+		if !errors.As(err, &slugErr) {
+			t.Fatalf("expected slug error, got %T", err)
+		}
+		if slugErr.Slug() != "invalid-review" {
+			t.Errorf("expected slug 'invalid-review', got '%s'", slugErr.Slug())
+		}
+
+		fields := make([]string, 0, len(slugErr.Fields()))
+		for _, f := range slugErr.Fields() {
+			fields = append(fields, f.Field())
+		}
+		if strings.Join(fields, ",") != "id,courseId,userId,rating" {
+			t.Errorf("expected fields 'id,courseId,userId,rating', got '%s'", strings.Join(fields, ","))
+		}
+	})
+}
+
+func TestReview_Edit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changes rating and text", func(t *testing.T) {
+		r, _ := NewReview("review-1", "course-1", "user-1", 2, "Too fast")
+
+		if err := r.Edit(4, "Better after the second read"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if r.Rating() != 4 {
+			t.Errorf("expected rating 4, got %d", r.Rating())
+		}
+		if r.Text() != "Better after the second read" {
+			t.Errorf("expected edited text, got '%s'", r.Text())
+		}
+		if r.UpdatedAt().Before(r.CreatedAt()) {
+			t.Error("expected updatedAt not to be before createdAt")
+		}
+	})
+
+	t.Run("keeps the review unchanged when invalid", func(t *testing.T) {
+		r, _ := NewReview("review-1", "course-1", "user-1", 2, "Too fast")
+
+		if err := r.Edit(7, "Great"); err == nil {
+			t.Fatal("expected error for rating 7, got nil")
+		}
+
+		if r.Rating() != 2 || r.Text() != "Too fast" {
+			t.Errorf("expected review unchanged, got %d '%s'", r.Rating(), r.Text())
+		}
+	})
+}
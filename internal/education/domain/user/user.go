@@ -1,6 +1,6 @@
 package user
 
-import "github.com/pkg/errors"
+import commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
 
 type User struct {
 	id       string
@@ -11,17 +11,21 @@ type User struct {
 }
 
 func NewUser(id string, username string, email string, role Role, profile string) (*User, error) {
+	var v commonerrors.Validation
 	if id == "" {
-		return nil, errors.New("id is required")
+		v.Add("id", "id is required")
 	}
 	if username == "" {
-		return nil, errors.New("username is required")
+		v.Add("username", "username is required")
 	}
 	if email == "" {
-		return nil, errors.New("email is required")
+		v.Add("email", "email is required")
 	}
 	if profile == "" && role == RoleTeacher {
-		return nil, errors.New("profile is required")
+		v.Add("profile", "profile is required")
+	}
+	if err := v.Err("invalid-user"); err != nil {
+		return nil, err
 	}
 	return &User{
 		id:       id,
@@ -34,7 +38,7 @@ func NewUser(id string, username string, email string, role Role, profile string
 
 func (u *User) UpdateUsername(username string) error {
 	if username == "" {
-		return errors.New("username is required")
+		return commonerrors.NewValidationError("invalid-username", commonerrors.NewFieldError("username", "username is required"))
 	}
 	u.username = username
 	return nil
@@ -42,7 +46,7 @@ func (u *User) UpdateUsername(username string) error {
 
 func (u *User) UpdateEmail(email string) error {
 	if email == "" {
-		return errors.New("email is required")
+		return commonerrors.NewValidationError("invalid-email", commonerrors.NewFieldError("email", "email is required"))
 	}
 	u.email = email
 	return nil
@@ -50,7 +54,7 @@ func (u *User) UpdateEmail(email string) error {
 
 func (u *User) UpdateProfile(profile string) error {
 	if profile == "" && u.role == RoleTeacher {
-		return errors.New("teacher profile is required")
+		return commonerrors.NewValidationError("invalid-profile", commonerrors.NewFieldError("profile", "teacher profile is required"))
 	}
 	u.profile = profile
 	return nil
@@ -0,0 +1,17 @@
+package version
+
+import "context"
+
+// VersionRepository manages Version persistence. Versions are never updated
+// once created.
+type VersionRepository interface {
+	// Create saves a new version
+	Create(ctx context.Context, version *Version) error
+
+	// Get retrieves a version of a course by its number
+	Get(ctx context.Context, courseID string, number int) (*Version, error)
+
+	// LatestNumber returns the number of the latest version of a course,
+	// or 0 when the course was never published
+	LatestNumber(ctx context.Context, courseID string) (int, error)
+}
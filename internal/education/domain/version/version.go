@@ -0,0 +1,220 @@
+package version
+
+import (
+	"fmt"
+	"time"
+
+	commonerrors "github.com/maixuanbach174/online-course-app/internal/common/errors"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+)
+
+// Version is an immutable snapshot of the content of a course: its modules,
+// their lessons and the exercises of every lesson. Teachers edit a working
+// copy of the content, and publishing freezes it into the next version.
+type Version struct {
+	courseID    string
+	number      int
+	modules     []ModuleContent
+	publishedAt time.Time
+}
+
+// ModuleContent is a module of a version together with its lessons.
+type ModuleContent struct {
+	module  *module.Module
+	lessons []LessonContent
+}
+
+// LessonContent is a lesson of a version together with its exercises.
+type LessonContent struct {
+	lesson    *lesson.Lesson
+	exercises []*exercise.Exercise
+}
+
+// NewVersion freezes the given content as version number of the course.
+// Version numbers start at 1 and grow by one with every publish.
+func NewVersion(courseID string, number int, modules []ModuleContent) (*Version, error) {
+	var v commonerrors.Validation
+	if courseID == "" {
+		v.Add("courseId", "course id is required")
+	}
+	if number < 1 {
+		v.Add("version", "version must be at least 1")
+	}
+	if err := v.Err("invalid-course-version"); err != nil {
+		return nil, err
+	}
+
+	return &Version{
+		courseID:    courseID,
+		number:      number,
+		modules:     modules,
+		publishedAt: time.Now(),
+	}, nil
+}
+
+// UnmarshalVersionFromDatabase restores a version from persisted state.
+// It should only be used by repositories.
+func UnmarshalVersionFromDatabase(courseID string, number int, modules []ModuleContent, publishedAt time.Time) (*Version, error) {
+	v, err := NewVersion(courseID, number, modules)
+	if err != nil {
+		return nil, err
+	}
+
+	v.publishedAt = publishedAt
+
+	return v, nil
+}
+
+func NewModuleContent(m *module.Module, lessons []LessonContent) ModuleContent {
+	return ModuleContent{
+		module:  m,
+		lessons: lessons,
+	}
+}
+
+func NewLessonContent(l *lesson.Lesson, exercises []*exercise.Exercise) LessonContent {
+	return LessonContent{
+		lesson:    l,
+		exercises: exercises,
+	}
+}
+
+// FreezeLessonContent freezes a lesson of the working copy with its own
+// exercises. The bank questions the lesson refers to, found in questions by
+// ID and in pools by pool key, are copied into exercises ordered after its
+// own ones, so later edits to the bank only reach the next version. Questions
+// of a pool are tagged with the pool, so quiz sessions draw from it
+// separately; a question is only copied once, even when it matches several
+// pools.
+func FreezeLessonContent(
+	l *lesson.Lesson,
+	own []*exercise.Exercise,
+	questions map[string]*bank.Question,
+	pools map[string][]*bank.Question,
+) (LessonContent, error) {
+	order := 0
+	used := make(map[string]bool, len(own))
+	for _, e := range own {
+		if e.Order() >= order {
+			order = e.Order() + 1
+		}
+		used[e.ID()] = true
+	}
+
+	exercises := own
+	add := func(q *bank.Question, pool string) error {
+		e, err := q.ToExercise(l.ID(), order)
+		if err != nil {
+			return err
+		}
+		e.SetPool(pool)
+		exercises = append(exercises, e)
+		used[q.ID()] = true
+		order++
+		return nil
+	}
+
+	for _, id := range l.BankQuestionIDs() {
+		q, ok := questions[id]
+		if !ok {
+			return LessonContent{}, commonerrors.NewNotFoundError("a bank question of lesson '"+l.Title()+"' was deleted", "bank-question-not-found")
+		}
+		if used[id] {
+			continue
+		}
+		if err := add(q, ""); err != nil {
+			return LessonContent{}, err
+		}
+	}
+
+	for _, p := range l.BankPools() {
+		for _, q := range pools[p.Key()] {
+			if used[q.ID()] {
+				continue
+			}
+			if err := add(q, p.Key()); err != nil {
+				return LessonContent{}, err
+			}
+		}
+	}
+
+	return NewLessonContent(l, exercises), nil
+}
+
+// Getters (read-only access for serialization/display)
+func (v *Version) CourseID() string         { return v.courseID }
+func (v *Version) Number() int              { return v.number }
+func (v *Version) Modules() []ModuleContent { return v.modules }
+func (v *Version) PublishedAt() time.Time   { return v.publishedAt }
+
+func (m ModuleContent) Module() *module.Module   { return m.module }
+func (m ModuleContent) Lessons() []LessonContent { return m.lessons }
+
+func (l LessonContent) Lesson() *lesson.Lesson          { return l.lesson }
+func (l LessonContent) Exercises() []*exercise.Exercise { return l.exercises }
+
+// QuestionCount returns how many questions a student answers on the lesson.
+// Quiz sessions draw the draw count of the lesson's own exercises and the
+// count of every bank pool, and every exercise when nothing is drawn.
+func (l LessonContent) QuestionCount() int {
+	groups := make(map[string]int)
+	for _, e := range l.exercises {
+		groups[e.Pool()]++
+	}
+
+	draws := l.lesson.PoolDraws()
+	total := 0
+	for pool, size := range groups {
+		count := l.lesson.DrawCount()
+		if pool != "" {
+			count = draws[pool]
+		}
+		if count == 0 || count > size {
+			count = size
+		}
+		total += count
+	}
+	return total
+}
+
+// Lesson returns the content of a lesson of the version.
+func (v *Version) Lesson(lessonID string) (LessonContent, error) {
+	for _, m := range v.modules {
+		for _, l := range m.lessons {
+			if l.lesson.ID() == lessonID {
+				return l, nil
+			}
+		}
+	}
+	return LessonContent{}, commonerrors.NewNotFoundError(
+		fmt.Sprintf("lesson '%s' is not part of version %d of the course", lessonID, v.number),
+		"lesson-not-in-version",
+	)
+}
+
+// ReleasedTo returns a copy of the version as seen by a student who enrolled
+// at enrolledAt: modules that are not released yet at now keep their place
+// but come without their lessons. Lessons answered in quiz sessions come
+// without their exercises, which sessions draw and shuffle.
+func (v *Version) ReleasedTo(enrolledAt time.Time, now time.Time) *Version {
+	released := *v
+	released.modules = make([]ModuleContent, 0, len(v.modules))
+	for _, m := range v.modules {
+		if !m.module.Release().IsReleased(enrolledAt, now) {
+			released.modules = append(released.modules, NewModuleContent(m.module, nil))
+			continue
+		}
+		lessons := make([]LessonContent, 0, len(m.lessons))
+		for _, l := range m.lessons {
+			if l.lesson.IsRestricted() || l.lesson.DrawCount() > 0 {
+				l = NewLessonContent(l.lesson, nil)
+			}
+			lessons = append(lessons, l)
+		}
+		released.modules = append(released.modules, NewModuleContent(m.module, lessons))
+	}
+	return &released
+}
@@ -0,0 +1,196 @@
+package version
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+)
+
+func TestNewVersion(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewVersion("", 1, nil); err == nil {
+		t.Error("expected error for missing course id")
+	}
+	if _, err := NewVersion("course-1", 0, nil); err == nil {
+		t.Error("expected error for version 0")
+	}
+
+	v, err := NewVersion("course-1", 1, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if v.PublishedAt().IsZero() {
+		t.Error("expected publishedAt to be set")
+	}
+}
+
+func TestVersion_Lesson(t *testing.T) {
+	t.Parallel()
+
+	m, _ := module.NewModule("module-1", "course-1", "Basics", 0)
+	l, _ := lesson.NewLesson("lesson-1", "module-1", "Intro", "", "", "", 10, 0)
+	v, _ := NewVersion("course-1", 1, []ModuleContent{
+		NewModuleContent(m, []LessonContent{NewLessonContent(l, nil)}),
+	})
+
+	got, err := v.Lesson("lesson-1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Lesson().Title() != "Intro" {
+		t.Errorf("expected lesson Intro, got %s", got.Lesson().Title())
+	}
+
+	if _, err := v.Lesson("lesson-2"); err == nil {
+		t.Error("expected error for lesson outside the version")
+	}
+}
+
+func TestVersion_ReleasedTo(t *testing.T) {
+	t.Parallel()
+
+	enrolledAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	open, _ := module.NewModule("module-1", "course-1", "Basics", 0)
+	later, _ := module.NewModule("module-2", "course-1", "Advanced", 1)
+	release, _ := module.NewRelease(7, time.Time{})
+	later.SetRelease(release)
+	l1, _ := lesson.NewLesson("lesson-1", "module-1", "Intro", "", "", "", 10, 0)
+	l2, _ := lesson.NewLesson("lesson-2", "module-2", "Deep dive", "", "", "", 10, 0)
+	v, _ := NewVersion("course-1", 1, []ModuleContent{
+		NewModuleContent(open, []LessonContent{NewLessonContent(l1, nil)}),
+		NewModuleContent(later, []LessonContent{NewLessonContent(l2, nil)}),
+	})
+
+	released := v.ReleasedTo(enrolledAt, enrolledAt.AddDate(0, 0, 1))
+	if len(released.Modules()) != 2 {
+		t.Fatalf("expected 2 modules, got %d", len(released.Modules()))
+	}
+	if len(released.Modules()[0].Lessons()) != 1 || len(released.Modules()[1].Lessons()) != 0 {
+		t.Error("expected only the lessons of the released module")
+	}
+	if len(v.Modules()[1].Lessons()) != 1 {
+		t.Error("expected the original version to keep every lesson")
+	}
+
+	if got := v.ReleasedTo(enrolledAt, enrolledAt.AddDate(0, 0, 7)); len(got.Modules()[1].Lessons()) != 1 {
+		t.Error("expected the lessons of module-2 once it is released")
+	}
+}
+
+func TestVersion_ReleasedTo_QuizLessons(t *testing.T) {
+	t.Parallel()
+
+	m, _ := module.NewModule("module-1", "course-1", "Basics", 0)
+	e, _ := exercise.NewExercise("exercise-1", "lesson-1", "2 + 2?", []string{"3", "4"}, "4", 0)
+	open, _ := lesson.NewLesson("lesson-1", "module-1", "Intro", "", "", "", 10, 0)
+	drawn, _ := lesson.NewLesson("lesson-2", "module-1", "Drawn", "", "", "", 10, 1)
+	_ = drawn.SetDrawCount(1)
+	timed, _ := lesson.NewLesson("lesson-3", "module-1", "Timed", "", "", "", 10, 2)
+	_ = timed.SetTimeLimit(20)
+	v, _ := NewVersion("course-1", 1, []ModuleContent{
+		NewModuleContent(m, []LessonContent{
+			NewLessonContent(open, []*exercise.Exercise{e}),
+			NewLessonContent(drawn, []*exercise.Exercise{e}),
+			NewLessonContent(timed, []*exercise.Exercise{e}),
+		}),
+	})
+
+	now := time.Now()
+	lessons := v.ReleasedTo(now, now).Modules()[0].Lessons()
+	if len(lessons[0].Exercises()) != 1 {
+		t.Error("expected the exercises of a lesson answered directly")
+	}
+	if len(lessons[1].Exercises()) != 0 || len(lessons[2].Exercises()) != 0 {
+		t.Error("expected lessons answered in quiz sessions to come without exercises")
+	}
+	if len(v.Modules()[0].Lessons()[1].Exercises()) != 1 {
+		t.Error("expected the original version to keep every exercise")
+	}
+}
+
+func TestLessonContent_QuestionCount(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := bank.NewPool("bank-1", bank.NewFilter("Go", bank.Easy), 2)
+	newExercises := func(lessonID string, count int, pool string) []*exercise.Exercise {
+		exercises := make([]*exercise.Exercise, 0, count)
+		for i := 0; i < count; i++ {
+			e, _ := exercise.NewExercise(fmt.Sprintf("%s-%s-%d", lessonID, pool, i), lessonID, "2 + 2?", []string{"3", "4"}, "4", i)
+			e.SetPool(pool)
+			exercises = append(exercises, e)
+		}
+		return exercises
+	}
+
+	all, _ := lesson.NewLesson("lesson-1", "module-1", "All", "", "", "", 10, 0)
+	drawn, _ := lesson.NewLesson("lesson-2", "module-1", "Drawn", "", "", "", 10, 1)
+	_ = drawn.SetDrawCount(2)
+	banked, _ := lesson.NewLesson("lesson-3", "module-1", "Banked", "", "", "", 10, 2)
+	_ = banked.SetDrawCount(5)
+	_ = banked.SetBankQuestions([]string{"question-1"}, []bank.Pool{pool})
+
+	tests := []struct {
+		name      string
+		content   LessonContent
+		wantCount int
+	}{
+		{"no exercises", NewLessonContent(all, nil), 0},
+		{"every exercise", NewLessonContent(all, newExercises("lesson-1", 3, "")), 3},
+		{"drawn exercises", NewLessonContent(drawn, newExercises("lesson-2", 4, "")), 2},
+		{"bank pool draws", NewLessonContent(banked, append(newExercises("lesson-3", 3, ""), newExercises("lesson-3", 6, pool.Key())...)), 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.content.QuestionCount(); got != tt.wantCount {
+				t.Errorf("expected %d questions, got %d", tt.wantCount, got)
+			}
+		})
+	}
+}
+
+func TestFreezeLessonContent(t *testing.T) {
+	t.Parallel()
+
+	key, _ := exercise.ParseAnswerKey("", []string{"3", "4"}, []string{"4"}, 0, "")
+	newQuestion := func(id string) *bank.Question {
+		q, _ := bank.NewQuestion(id, "bank-1", "2 + 2?", key, []string{"go"}, bank.Easy)
+		return q
+	}
+	own, _ := exercise.NewExercise("exercise-1", "lesson-1", "2 + 2?", []string{"3", "4"}, "4", 3)
+	pool, _ := bank.NewPool("bank-1", bank.NewFilter("go", bank.Easy), 1)
+	l, _ := lesson.NewLesson("lesson-1", "module-1", "Intro", "", "", "", 10, 0)
+	_ = l.SetBankQuestions([]string{"question-1"}, []bank.Pool{pool})
+
+	t.Run("copies picked questions and pools after the own exercises", func(t *testing.T) {
+		questions := map[string]*bank.Question{"question-1": newQuestion("question-1")}
+		pools := map[string][]*bank.Question{pool.Key(): {newQuestion("question-1"), newQuestion("question-2")}}
+
+		lc, err := FreezeLessonContent(l, []*exercise.Exercise{own}, questions, pools)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		exercises := lc.Exercises()
+		if len(exercises) != 3 {
+			t.Fatalf("expected 3 exercises, got %d", len(exercises))
+		}
+		if exercises[1].ID() != "question-1" || exercises[1].Pool() != "" || exercises[1].Order() != 4 {
+			t.Errorf("expected the picked question after the own exercise, got %s in pool '%s' at %d", exercises[1].ID(), exercises[1].Pool(), exercises[1].Order())
+		}
+		if exercises[2].ID() != "question-2" || exercises[2].Pool() != pool.Key() {
+			t.Errorf("expected the pool question once, got %s in pool '%s'", exercises[2].ID(), exercises[2].Pool())
+		}
+	})
+
+	t.Run("fails when a picked question was deleted", func(t *testing.T) {
+		if _, err := FreezeLessonContent(l, nil, nil, nil); err == nil {
+			t.Error("expected error for a deleted bank question")
+		}
+	})
+}
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS exercises (
+    id VARCHAR(255) PRIMARY KEY,
+    lesson_id VARCHAR(255) NOT NULL,
+    question TEXT NOT NULL,
+    answers TEXT[] NOT NULL,
+    correct_answer TEXT NOT NULL,
+    order_index INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercises_lesson_id ON exercises(lesson_id);
+CREATE INDEX IF NOT EXISTS idx_exercises_order ON exercises(lesson_id, order_index);
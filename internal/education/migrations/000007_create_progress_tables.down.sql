@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lesson_progress;
+DROP TABLE IF EXISTS module_progress;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS module_progress (
+    enrollment_id VARCHAR(255) NOT NULL,
+    module_id VARCHAR(255) NOT NULL,
+    progress_percentage DECIMAL(5, 2) DEFAULT 0.0,
+    progress_status VARCHAR(50) NOT NULL DEFAULT 'started',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (enrollment_id, module_id),
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE,
+    FOREIGN KEY (module_id) REFERENCES modules(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_module_progress_enrollment ON module_progress(enrollment_id);
+
+CREATE TABLE IF NOT EXISTS lesson_progress (
+    enrollment_id VARCHAR(255) NOT NULL,
+    lesson_id VARCHAR(255) NOT NULL,
+    progress_percentage DECIMAL(5, 2) DEFAULT 0.0,
+    progress_status VARCHAR(50) NOT NULL DEFAULT 'started',
+    exercise_score DECIMAL(5, 2) DEFAULT 0.0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (enrollment_id, lesson_id),
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE,
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_lesson_progress_enrollment ON lesson_progress(enrollment_id);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS lesson_attempts (
+    id VARCHAR(255) PRIMARY KEY,
+    enrollment_id VARCHAR(255) NOT NULL,
+    lesson_id VARCHAR(255) NOT NULL,
+    answers JSONB NOT NULL,
+    score DECIMAL(5, 2) NOT NULL DEFAULT 0.0,
+    submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE,
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_lesson_attempts_enrollment_lesson ON lesson_attempts(enrollment_id, lesson_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_course_tags_tag;
+
+DROP INDEX IF EXISTS idx_courses_duration_id;
+DROP INDEX IF EXISTS idx_courses_rating_id;
+DROP INDEX IF EXISTS idx_courses_title_id;
+DROP INDEX IF EXISTS idx_courses_created_at_id;
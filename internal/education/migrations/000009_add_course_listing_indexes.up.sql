@@ -0,0 +1,7 @@
+-- Keyset pagination orders by the sort column with the course ID as tie-breaker
+CREATE INDEX IF NOT EXISTS idx_courses_created_at_id ON courses(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_courses_title_id ON courses(title, id);
+CREATE INDEX IF NOT EXISTS idx_courses_rating_id ON courses((COALESCE(rating, 0)), id);
+CREATE INDEX IF NOT EXISTS idx_courses_duration_id ON courses(duration, id);
+
+CREATE INDEX IF NOT EXISTS idx_course_tags_tag ON course_tags(tag);
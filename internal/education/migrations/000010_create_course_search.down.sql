@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS trg_course_search_lesson ON lessons;
+DROP TRIGGER IF EXISTS trg_course_search_module ON modules;
+DROP TRIGGER IF EXISTS trg_course_search_course ON courses;
+
+DROP FUNCTION IF EXISTS sync_course_search_from_lesson();
+DROP FUNCTION IF EXISTS sync_course_search_from_module();
+DROP FUNCTION IF EXISTS sync_course_search_from_course();
+DROP FUNCTION IF EXISTS refresh_course_lesson_text(VARCHAR);
+
+DROP TABLE IF EXISTS course_search;
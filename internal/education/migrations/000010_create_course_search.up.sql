@@ -0,0 +1,86 @@
+-- Search document of a course. Title and description are copied from the course,
+-- lesson_text aggregates the titles and overviews of its lessons. Triggers keep the
+-- copies current, the generated column keeps the weighted tsvector current.
+CREATE TABLE IF NOT EXISTS course_search (
+    course_id VARCHAR(255) PRIMARY KEY,
+    title VARCHAR(500) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    lesson_text TEXT NOT NULL DEFAULT '',
+    document TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', description), 'B') ||
+        setweight(to_tsvector('english', lesson_text), 'C')
+    ) STORED,
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_search_document ON course_search USING GIN (document);
+
+CREATE OR REPLACE FUNCTION refresh_course_lesson_text(p_course_id VARCHAR) RETURNS VOID AS $$
+BEGIN
+    UPDATE course_search
+    SET lesson_text = COALESCE((
+        SELECT string_agg(concat_ws(' ', l.title, l.overview), ' ' ORDER BY m.order_index, l.order_index)
+        FROM lessons l
+        JOIN modules m ON m.id = l.module_id
+        WHERE m.course_id = p_course_id
+    ), '')
+    WHERE course_id = p_course_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_course() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO course_search (course_id, title, description)
+    VALUES (NEW.id, NEW.title, COALESCE(NEW.description, ''))
+    ON CONFLICT (course_id) DO UPDATE
+    SET title = EXCLUDED.title,
+        description = EXCLUDED.description;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_module() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_course_lesson_text(OLD.course_id);
+    END IF;
+    IF TG_OP = 'UPDATE' AND NEW.course_id <> OLD.course_id THEN
+        PERFORM refresh_course_lesson_text(NEW.course_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_lesson() RETURNS TRIGGER AS $$
+BEGIN
+    -- When a module is deleted its lessons go with it; the module trigger then refreshes the course
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_course_lesson_text(m.course_id) FROM modules m WHERE m.id = OLD.module_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM refresh_course_lesson_text(m.course_id) FROM modules m WHERE m.id = NEW.module_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_search_course
+AFTER INSERT OR UPDATE OF title, description ON courses
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_course();
+
+CREATE TRIGGER trg_course_search_module
+AFTER UPDATE OF course_id OR DELETE ON modules
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_module();
+
+CREATE TRIGGER trg_course_search_lesson
+AFTER INSERT OR UPDATE OF module_id, title, overview OR DELETE ON lessons
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_lesson();
+
+-- Backfill documents of existing courses
+INSERT INTO course_search (course_id, title, description)
+SELECT id, title, COALESCE(description, '')
+FROM courses
+ON CONFLICT (course_id) DO NOTHING;
+
+SELECT refresh_course_lesson_text(id) FROM courses;
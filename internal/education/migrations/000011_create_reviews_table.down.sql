@@ -0,0 +1,2 @@
+ALTER TABLE courses DROP COLUMN IF EXISTS review_count;
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS reviews (
+    id VARCHAR(255) PRIMARY KEY,
+    course_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating >= 1 AND rating <= 5),
+    text TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (course_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_course_created_at ON reviews(course_id, created_at DESC);
+
+-- The course rating is now the average of its reviews. Ratings set by teachers
+-- are not backed by any review, so every course is backfilled from its reviews,
+-- and courses without reviews start over from zero.
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS review_count INTEGER NOT NULL DEFAULT 0;
+UPDATE courses
+SET rating = COALESCE((SELECT ROUND(AVG(r.rating), 2) FROM reviews r WHERE r.course_id = courses.id), 0),
+    review_count = (SELECT COUNT(*) FROM reviews r WHERE r.course_id = courses.id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_courses_status;
+ALTER TABLE courses DROP COLUMN IF EXISTS status;
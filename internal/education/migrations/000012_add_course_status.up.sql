@@ -0,0 +1,7 @@
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS status VARCHAR(50) NOT NULL DEFAULT 'draft'
+    CHECK (status IN ('draft', 'in_review', 'published', 'archived'));
+
+-- Courses created before the publishing workflow were already visible in the catalog
+UPDATE courses SET status = 'published';
+
+CREATE INDEX IF NOT EXISTS idx_courses_status ON courses(status);
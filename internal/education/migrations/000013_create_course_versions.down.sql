@@ -0,0 +1,13 @@
+DELETE FROM lesson_attempts WHERE lesson_id NOT IN (SELECT id FROM lessons);
+DELETE FROM lesson_progress WHERE lesson_id NOT IN (SELECT id FROM lessons);
+DELETE FROM module_progress WHERE module_id NOT IN (SELECT id FROM modules);
+ALTER TABLE lesson_attempts ADD CONSTRAINT lesson_attempts_lesson_id_fkey
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE;
+ALTER TABLE lesson_progress ADD CONSTRAINT lesson_progress_lesson_id_fkey
+    FOREIGN KEY (lesson_id) REFERENCES lessons(id) ON DELETE CASCADE;
+ALTER TABLE module_progress ADD CONSTRAINT module_progress_module_id_fkey
+    FOREIGN KEY (module_id) REFERENCES modules(id) ON DELETE CASCADE;
+
+ALTER TABLE enrollments DROP CONSTRAINT IF EXISTS enrollments_course_version_fkey;
+ALTER TABLE enrollments DROP COLUMN IF EXISTS content_version;
+DROP TABLE IF EXISTS course_versions;
@@ -0,0 +1,59 @@
+CREATE TABLE IF NOT EXISTS course_versions (
+    course_id VARCHAR(255) NOT NULL,
+    version INTEGER NOT NULL CHECK (version >= 1),
+    content JSONB NOT NULL,
+    published_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (course_id, version),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE
+);
+
+-- Courses that are already live get their current content frozen as version 1
+INSERT INTO course_versions (course_id, version, content)
+SELECT c.id, 1, jsonb_build_object('modules', COALESCE((
+    SELECT jsonb_agg(jsonb_build_object(
+        'id', m.id,
+        'title', m.title,
+        'order', m.order_index,
+        'lessons', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                'id', l.id,
+                'title', l.title,
+                'overview', COALESCE(l.overview, ''),
+                'content', COALESCE(l.content, ''),
+                'video_id', COALESCE(l.video_id, ''),
+                'duration', l.duration,
+                'order', l.order_index,
+                'exercises', COALESCE((
+                    SELECT jsonb_agg(jsonb_build_object(
+                        'id', e.id,
+                        'question', e.question,
+                        'answers', to_jsonb(e.answers),
+                        'correct_answer', e.correct_answer,
+                        'order', e.order_index
+                    ) ORDER BY e.order_index)
+                    FROM exercises e
+                    WHERE e.lesson_id = l.id
+                ), '[]'::jsonb)
+            ) ORDER BY l.order_index)
+            FROM lessons l
+            WHERE l.module_id = m.id
+        ), '[]'::jsonb)
+    ) ORDER BY m.order_index)
+    FROM modules m
+    WHERE m.course_id = c.id
+), '[]'::jsonb))
+FROM courses c
+WHERE c.status IN ('published', 'archived')
+ON CONFLICT DO NOTHING;
+
+-- Every existing enrollment started on that first version
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS content_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE enrollments ALTER COLUMN content_version DROP DEFAULT;
+ALTER TABLE enrollments ADD CONSTRAINT enrollments_course_version_fkey
+    FOREIGN KEY (course_id, content_version) REFERENCES course_versions(course_id, version) ON DELETE CASCADE;
+
+-- Progress and attempts point into the version an enrollment is pinned to, so
+-- they must survive lessons and modules being removed from the working copy
+ALTER TABLE module_progress DROP CONSTRAINT IF EXISTS module_progress_module_id_fkey;
+ALTER TABLE lesson_progress DROP CONSTRAINT IF EXISTS lesson_progress_lesson_id_fkey;
+ALTER TABLE lesson_attempts DROP CONSTRAINT IF EXISTS lesson_attempts_lesson_id_fkey;
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS trg_duration_module ON modules;
+DROP TRIGGER IF EXISTS trg_duration_lesson ON lessons;
+
+DROP FUNCTION IF EXISTS sync_duration_from_module();
+DROP FUNCTION IF EXISTS sync_duration_from_lesson();
+DROP FUNCTION IF EXISTS refresh_module_duration(VARCHAR);
+DROP FUNCTION IF EXISTS refresh_course_duration(VARCHAR);
+
+ALTER TABLE modules DROP COLUMN IF EXISTS duration;
@@ -0,0 +1,56 @@
+-- Module and course durations are derived from the durations of their lessons.
+-- Triggers on lessons and modules keep the sums current, the API only reads them.
+ALTER TABLE modules ADD COLUMN IF NOT EXISTS duration INT NOT NULL DEFAULT 0 CHECK (duration >= 0);
+
+CREATE OR REPLACE FUNCTION refresh_course_duration(p_course_id VARCHAR) RETURNS VOID AS $$
+BEGIN
+    UPDATE courses
+    SET duration = COALESCE((SELECT SUM(m.duration) FROM modules m WHERE m.course_id = p_course_id), 0)
+    WHERE id = p_course_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_module_duration(p_module_id VARCHAR) RETURNS VOID AS $$
+BEGIN
+    UPDATE modules
+    SET duration = COALESCE((SELECT SUM(l.duration) FROM lessons l WHERE l.module_id = p_module_id), 0)
+    WHERE id = p_module_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_duration_from_lesson() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_module_duration(OLD.module_id);
+    END IF;
+    IF TG_OP = 'INSERT' OR (TG_OP = 'UPDATE' AND NEW.module_id <> OLD.module_id) THEN
+        PERFORM refresh_module_duration(NEW.module_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_duration_from_module() RETURNS TRIGGER AS $$
+BEGIN
+    -- When a course is deleted its modules go with it; refreshing it is then a no-op
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_course_duration(OLD.course_id);
+    END IF;
+    IF TG_OP = 'UPDATE' AND NEW.course_id <> OLD.course_id THEN
+        PERFORM refresh_course_duration(NEW.course_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_duration_lesson
+AFTER INSERT OR UPDATE OF module_id, duration OR DELETE ON lessons
+FOR EACH ROW EXECUTE FUNCTION sync_duration_from_lesson();
+
+CREATE TRIGGER trg_duration_module
+AFTER UPDATE OF course_id, duration OR DELETE ON modules
+FOR EACH ROW EXECUTE FUNCTION sync_duration_from_module();
+
+-- Replace the durations typed by teachers with the derived ones
+SELECT refresh_module_duration(id) FROM modules;
+SELECT refresh_course_duration(id) FROM courses;
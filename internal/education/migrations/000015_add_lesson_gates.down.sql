@@ -0,0 +1,5 @@
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_min_score_pair;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS min_score;
+ALTER TABLE lessons DROP COLUMN IF EXISTS min_score_lesson_id;
+ALTER TABLE lessons DROP COLUMN IF EXISTS requires_previous;
@@ -0,0 +1,10 @@
+-- Lessons can be locked behind the lessons before them in the course, and
+-- behind a minimum exercise score on an earlier lesson. The score lesson is
+-- not a foreign key: published versions keep referencing it after the working
+-- copy drops it, and gates pointing at missing lessons are ignored.
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS requires_previous BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS min_score_lesson_id VARCHAR(255);
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS min_score DECIMAL(5,2) CHECK (min_score > 0 AND min_score <= 100);
+
+ALTER TABLE lessons ADD CONSTRAINT lessons_min_score_pair
+    CHECK ((min_score_lesson_id IS NULL) = (min_score IS NULL));
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS prerequisite_waivers;
+DROP TABLE IF EXISTS course_prerequisites;
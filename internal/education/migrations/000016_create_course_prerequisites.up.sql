@@ -0,0 +1,24 @@
+-- Courses declare the courses students should take before them. Required
+-- prerequisites gate enrollment, recommended ones are informational.
+CREATE TABLE IF NOT EXISTS course_prerequisites (
+    course_id VARCHAR(255) NOT NULL,
+    prerequisite_id VARCHAR(255) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (course_id, prerequisite_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (prerequisite_id) REFERENCES courses(id) ON DELETE CASCADE,
+    CHECK (course_id <> prerequisite_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_prerequisites_prerequisite_id ON course_prerequisites(prerequisite_id);
+
+-- Students the course owner or an admin let in without the required prerequisites
+CREATE TABLE IF NOT EXISTS prerequisite_waivers (
+    course_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    granted_by VARCHAR(255) NOT NULL,
+    granted_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (course_id, user_id),
+    FOREIGN KEY (course_id) REFERENCES courses(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
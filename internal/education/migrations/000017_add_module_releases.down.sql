@@ -0,0 +1,4 @@
+ALTER TABLE modules DROP CONSTRAINT IF EXISTS modules_single_release;
+
+ALTER TABLE modules DROP COLUMN IF EXISTS release_at;
+ALTER TABLE modules DROP COLUMN IF EXISTS release_after_days;
@@ -0,0 +1,8 @@
+-- Modules can be released to a student a number of days after the student
+-- enrolled, or on a fixed date for the whole cohort. Modules without either
+-- are open as soon as the student enrolls.
+ALTER TABLE modules ADD COLUMN IF NOT EXISTS release_after_days INT CHECK (release_after_days > 0);
+ALTER TABLE modules ADD COLUMN IF NOT EXISTS release_at TIMESTAMP;
+
+ALTER TABLE modules ADD CONSTRAINT modules_single_release
+    CHECK (release_after_days IS NULL OR release_at IS NULL);
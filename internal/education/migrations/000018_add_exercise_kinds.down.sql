@@ -0,0 +1,12 @@
+-- Only the first correct answer survives the rollback, which is exact for
+-- single-choice, true/false and numeric questions.
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS correct_answer TEXT NOT NULL DEFAULT '';
+
+UPDATE exercises SET correct_answer = COALESCE(correct_answers[1], '');
+
+ALTER TABLE exercises ALTER COLUMN correct_answer DROP DEFAULT;
+
+ALTER TABLE exercises DROP COLUMN IF EXISTS text_match;
+ALTER TABLE exercises DROP COLUMN IF EXISTS tolerance;
+ALTER TABLE exercises DROP COLUMN IF EXISTS correct_answers;
+ALTER TABLE exercises DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,13 @@
+-- Exercises can ask more than single-choice questions. The correct answer
+-- becomes a list: every correct option of a multiple-choice question, the
+-- accepted answers of a short text question or the items of an ordering
+-- question in the correct order. Existing exercises stay single choice.
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS kind VARCHAR(32) NOT NULL DEFAULT 'single_choice'
+    CHECK (kind IN ('single_choice', 'multiple_choice', 'true_false', 'numeric', 'short_text', 'ordering'));
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS correct_answers TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0);
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS text_match VARCHAR(32) CHECK (text_match IN ('normalized', 'regex'));
+
+UPDATE exercises SET correct_answers = ARRAY[correct_answer];
+
+ALTER TABLE exercises DROP COLUMN IF EXISTS correct_answer;
@@ -0,0 +1,4 @@
+ALTER TABLE lesson_progress DROP CONSTRAINT IF EXISTS lesson_progress_exercise_score_range;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS pass_score;
+ALTER TABLE exercises DROP COLUMN IF EXISTS points;
@@ -0,0 +1,10 @@
+-- Exercises are weighted by points in the score of their lesson, and lessons
+-- can ask for a pass score before they count as completed. Existing exercises
+-- are worth one point each, which keeps the scores of earlier attempts.
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS points INT NOT NULL DEFAULT 1 CHECK (points > 0);
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS pass_score DECIMAL(5,2) CHECK (pass_score > 0 AND pass_score <= 100);
+
+-- exercise_score is the best attempt score on the lesson, as a percentage of
+-- the points of its exercises
+ALTER TABLE lesson_progress ADD CONSTRAINT lesson_progress_exercise_score_range
+    CHECK (exercise_score >= 0 AND exercise_score <= 100);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS quiz_sessions;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS draw_count;
@@ -0,0 +1,20 @@
+-- Quiz sessions deliver the exercises of a lesson in a random order with
+-- shuffled answer options. The seed the order is derived from stays on the
+-- server, so submissions are graded against what the student was shown.
+-- draw_count lets a lesson draw only some of its exercises for every session.
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS draw_count INT CHECK (draw_count > 0);
+
+CREATE TABLE IF NOT EXISTS quiz_sessions (
+    id VARCHAR(255) PRIMARY KEY,
+    enrollment_id VARCHAR(255) NOT NULL,
+    lesson_id VARCHAR(255) NOT NULL,
+    content_version INT NOT NULL,
+    seed BIGINT NOT NULL,
+    exercise_ids TEXT[] NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    attempt_id VARCHAR(255),
+    submitted_at TIMESTAMP,
+    FOREIGN KEY (enrollment_id) REFERENCES enrollments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_quiz_sessions_enrollment_lesson ON quiz_sessions(enrollment_id, lesson_id);
@@ -0,0 +1,6 @@
+ALTER TABLE lesson_progress DROP COLUMN IF EXISTS attempts;
+
+ALTER TABLE quiz_sessions DROP COLUMN IF EXISTS deadline;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS max_attempts;
+ALTER TABLE lessons DROP COLUMN IF EXISTS time_limit;
@@ -0,0 +1,17 @@
+-- Lessons can limit how long a quiz session lasts, in minutes, and how many
+-- attempts a student has at their exercises. Quiz sessions of timed lessons
+-- have a deadline, and lesson progress counts the attempts started. Attempts
+-- graded so far are counted in.
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS time_limit INT CHECK (time_limit > 0);
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS max_attempts INT CHECK (max_attempts > 0);
+
+ALTER TABLE quiz_sessions ADD COLUMN IF NOT EXISTS deadline TIMESTAMP;
+
+ALTER TABLE lesson_progress ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0 CHECK (attempts >= 0);
+
+UPDATE lesson_progress lp
+SET attempts = (
+    SELECT COUNT(*)
+    FROM lesson_attempts la
+    WHERE la.enrollment_id = lp.enrollment_id AND la.lesson_id = lp.lesson_id
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_lessons_bank_question_ids;
+
+ALTER TABLE lessons DROP COLUMN IF EXISTS bank_pools;
+ALTER TABLE lessons DROP COLUMN IF EXISTS bank_question_ids;
+
+DROP TABLE IF EXISTS bank_questions;
+DROP TABLE IF EXISTS question_banks;
@@ -0,0 +1,40 @@
+-- Question banks hold questions a teacher reuses across lessons and courses,
+-- tagged with topics and a difficulty. Lessons refer to bank questions they
+-- always ask and to pools of tagged questions they draw from. The questions
+-- are copied into the course version on publish, so lessons follow every
+-- edit of their bank questions until then.
+CREATE TABLE IF NOT EXISTS question_banks (
+    id VARCHAR(255) PRIMARY KEY,
+    teacher_id VARCHAR(255) NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (teacher_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_banks_teacher_id ON question_banks(teacher_id);
+
+CREATE TABLE IF NOT EXISTS bank_questions (
+    id VARCHAR(255) PRIMARY KEY,
+    bank_id VARCHAR(255) NOT NULL,
+    question TEXT NOT NULL,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('single_choice', 'multiple_choice', 'true_false', 'numeric', 'short_text', 'ordering')),
+    answers TEXT[] NOT NULL,
+    correct_answers TEXT[] NOT NULL DEFAULT '{}',
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    text_match VARCHAR(32) CHECK (text_match IN ('normalized', 'regex')),
+    points INT NOT NULL DEFAULT 1 CHECK (points > 0),
+    topics TEXT[] NOT NULL DEFAULT '{}',
+    difficulty VARCHAR(32) NOT NULL CHECK (difficulty IN ('easy', 'medium', 'hard')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (bank_id) REFERENCES question_banks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_questions_bank_id ON bank_questions(bank_id);
+CREATE INDEX IF NOT EXISTS idx_bank_questions_topics ON bank_questions USING GIN (topics);
+
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS bank_question_ids TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS bank_pools JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_lessons_bank_question_ids ON lessons USING GIN (bank_question_ids);
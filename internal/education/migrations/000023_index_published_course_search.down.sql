@@ -0,0 +1,51 @@
+DROP TRIGGER IF EXISTS trg_course_search_version ON course_versions;
+
+DROP FUNCTION IF EXISTS sync_course_search_from_version();
+
+CREATE OR REPLACE FUNCTION refresh_course_lesson_text(p_course_id VARCHAR) RETURNS VOID AS $$
+BEGIN
+    UPDATE course_search
+    SET lesson_text = COALESCE((
+        SELECT string_agg(concat_ws(' ', l.title, l.overview), ' ' ORDER BY m.order_index, l.order_index)
+        FROM lessons l
+        JOIN modules m ON m.id = l.module_id
+        WHERE m.course_id = p_course_id
+    ), '')
+    WHERE course_id = p_course_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_module() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_course_lesson_text(OLD.course_id);
+    END IF;
+    IF TG_OP = 'UPDATE' AND NEW.course_id <> OLD.course_id THEN
+        PERFORM refresh_course_lesson_text(NEW.course_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_lesson() RETURNS TRIGGER AS $$
+BEGIN
+    -- When a module is deleted its lessons go with it; the module trigger then refreshes the course
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM refresh_course_lesson_text(m.course_id) FROM modules m WHERE m.id = OLD.module_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM refresh_course_lesson_text(m.course_id) FROM modules m WHERE m.id = NEW.module_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_search_module
+AFTER UPDATE OF course_id OR DELETE ON modules
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_module();
+
+CREATE TRIGGER trg_course_search_lesson
+AFTER INSERT OR UPDATE OF module_id, title, overview OR DELETE ON lessons
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_lesson();
+
+SELECT refresh_course_lesson_text(id) FROM courses;
@@ -0,0 +1,38 @@
+-- Search documents index the lessons of the latest published version instead of
+-- the working copy, so edits teachers have not published yet never show up in
+-- search results or their snippets. Title and description stay copied from the
+-- course, whose metadata is not versioned.
+DROP TRIGGER IF EXISTS trg_course_search_lesson ON lessons;
+DROP TRIGGER IF EXISTS trg_course_search_module ON modules;
+
+DROP FUNCTION IF EXISTS sync_course_search_from_lesson();
+DROP FUNCTION IF EXISTS sync_course_search_from_module();
+
+CREATE OR REPLACE FUNCTION refresh_course_lesson_text(p_course_id VARCHAR) RETURNS VOID AS $$
+BEGIN
+    UPDATE course_search
+    SET lesson_text = COALESCE((
+        SELECT string_agg(concat_ws(' ', l.lesson->>'title', l.lesson->>'overview'), ' ' ORDER BY m.position, l.position)
+        FROM course_versions v
+        CROSS JOIN LATERAL jsonb_array_elements(v.content->'modules') WITH ORDINALITY AS m(module, position)
+        CROSS JOIN LATERAL jsonb_array_elements(m.module->'lessons') WITH ORDINALITY AS l(lesson, position)
+        WHERE v.course_id = p_course_id
+          AND v.version = (SELECT MAX(version) FROM course_versions WHERE course_id = p_course_id)
+    ), '')
+    WHERE course_id = p_course_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_course_search_from_version() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_course_lesson_text(NEW.course_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_search_version
+AFTER INSERT ON course_versions
+FOR EACH ROW EXECUTE FUNCTION sync_course_search_from_version();
+
+-- Reindex existing courses from their published content
+SELECT refresh_course_lesson_text(id) FROM courses;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_course_rating_review_delete ON reviews;
+
+DROP FUNCTION IF EXISTS refresh_course_rating_from_review();
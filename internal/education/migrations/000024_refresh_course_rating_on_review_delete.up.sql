@@ -0,0 +1,26 @@
+-- Reviews are deleted along with their author or their course without going
+-- through the review repository, which refreshes the course rating on every
+-- write. The trigger keeps the rating and the review count of the course
+-- current when that happens.
+CREATE OR REPLACE FUNCTION refresh_course_rating_from_review() RETURNS TRIGGER AS $$
+BEGIN
+    -- Lock the course like the review repository does, so the aggregate is
+    -- read after concurrent review writes on the course are committed
+    PERFORM 1 FROM courses WHERE id = OLD.course_id FOR UPDATE;
+
+    UPDATE courses
+    SET rating = COALESCE((SELECT ROUND(AVG(r.rating), 2) FROM reviews r WHERE r.course_id = courses.id), 0),
+        review_count = (SELECT COUNT(*) FROM reviews r WHERE r.course_id = courses.id)
+    WHERE id = OLD.course_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_course_rating_review_delete
+AFTER DELETE ON reviews
+FOR EACH ROW EXECUTE FUNCTION refresh_course_rating_from_review();
+
+-- Backfill the aggregates that went stale before the trigger existed
+UPDATE courses
+SET rating = COALESCE((SELECT ROUND(AVG(r.rating), 2) FROM reviews r WHERE r.course_id = courses.id), 0),
+    review_count = (SELECT COUNT(*) FROM reviews r WHERE r.course_id = courses.id);
@@ -0,0 +1,127 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/attempt"
+	"github.com/pkg/errors"
+)
+
+func (h HttpServer) GetLessonAttempts(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	attempts, err := h.app.Queries.GetLessonAttempts.Handle(r.Context(), query.GetLessonAttempts{
+		Actor:    actor,
+		UserID:   actor.UserID,
+		CourseID: courseId,
+		LessonID: lessonId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Attempt, 0, len(attempts))
+	for _, a := range attempts {
+		response = append(response, mapAttemptToResponse(a))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) SubmitLessonAnswers(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req SubmitAnswersRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	answers := make(map[string][]string, len(req.Answers))
+	for _, a := range req.Answers {
+		if _, ok := answers[a.ExerciseId]; ok {
+			httperr.BadRequest("duplicate-answer", errors.Errorf("exercise '%s' answered more than once", a.ExerciseId), w, r)
+			return
+		}
+		if a.Choices != nil {
+			httperr.BadRequest("choices-outside-quiz-session", errors.Errorf("exercise '%s' answered with choices outside a quiz session", a.ExerciseId), w, r)
+			return
+		}
+		switch {
+		case a.Answers != nil:
+			answers[a.ExerciseId] = *a.Answers
+		case a.Answer != nil:
+			answers[a.ExerciseId] = []string{*a.Answer}
+		default:
+			answers[a.ExerciseId] = nil
+		}
+	}
+
+	cmd := command.SubmitLessonAnswers{
+		Actor:     actor,
+		AttemptID: uuid.New().String(),
+		UserID:    actor.UserID,
+		CourseID:  courseId,
+		LessonID:  lessonId,
+		Answers:   answers,
+	}
+
+	if err := h.app.Commands.SubmitLessonAnswers.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	a, err := h.app.Queries.GetAttempt.Handle(r.Context(), query.GetAttempt{AttemptID: cmd.AttemptID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/me/enrollments/"+courseId+"/lessons/"+lessonId+"/attempts")
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapAttemptToResponse(a))
+}
+
+// Helper function to map domain Attempt to API Attempt response.
+// Only correctness is reported, never the correct answer itself.
+func mapAttemptToResponse(a *attempt.Attempt) Attempt {
+	results := make([]AnswerResult, 0, len(a.Answers()))
+	for _, ans := range a.Answers() {
+		response := ans.Response()
+		result := AnswerResult{
+			ExerciseId:   ans.ExerciseID(),
+			Answers:      &response,
+			Correct:      ans.Correct(),
+			EarnedPoints: ans.Earned(),
+			Points:       ans.Points(),
+		}
+		if len(response) == 1 {
+			result.Answer = response[0]
+		}
+		results = append(results, result)
+	}
+
+	return Attempt{
+		Id:           a.ID(),
+		LessonId:     a.LessonID(),
+		Score:        a.Score(),
+		EarnedPoints: a.EarnedPoints(),
+		TotalPoints:  a.TotalPoints(),
+		SubmittedAt:  a.SubmittedAt(),
+		Results:      results,
+	}
+}
@@ -0,0 +1,291 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/bank_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/lesson_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/bank_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bank"
+)
+
+func (h HttpServer) GetMyQuestionBanks(w http.ResponseWriter, r *http.Request) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	banks, err := h.app.Queries.BanksByTeacher.Handle(r.Context(), bank_query.BanksByTeacherQuery{
+		Actor: actor,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]QuestionBank, 0, len(banks))
+	for _, b := range banks {
+		response = append(response, mapQuestionBankToResponse(b))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateQuestionBank(w http.ResponseWriter, r *http.Request) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateQuestionBankRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := bank_command.CreateQuestionBank{
+		Actor:     actor,
+		BankID:    uuid.New().String(),
+		TeacherID: actor.UserID,
+		Title:     req.Title,
+	}
+
+	if err := h.app.Commands.CreateQuestionBank.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/question-banks/"+cmd.BankID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) GetQuestionBank(w http.ResponseWriter, r *http.Request, bankId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	b, err := h.app.Queries.GetQuestionBank.Handle(r.Context(), bank_query.GetQuestionBank{
+		Actor:  actor,
+		BankID: bankId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapQuestionBankToResponse(b))
+}
+
+func (h HttpServer) GetBankQuestions(w http.ResponseWriter, r *http.Request, bankId string, params GetBankQuestionsParams) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	questions, err := h.app.Queries.BankQuestions.Handle(r.Context(), bank_query.BankQuestionsQuery{
+		Actor:      actor,
+		BankID:     bankId,
+		Topic:      getStringValue(params.Topic),
+		Difficulty: getDifficultyValue(params.Difficulty),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]BankQuestion, 0, len(questions))
+	for _, q := range questions {
+		response = append(response, mapBankQuestionToResponse(q))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateBankQuestion(w http.ResponseWriter, r *http.Request, bankId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateBankQuestionRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := bank_command.CreateBankQuestion{
+		Actor:          actor,
+		QuestionID:     uuid.New().String(),
+		BankID:         bankId,
+		Question:       req.Question,
+		Kind:           getKindValue(req.Kind),
+		Answers:        req.Answers,
+		CorrectAnswers: getCorrectAnswers(req.CorrectAnswer, req.CorrectAnswers),
+		Tolerance:      getFloatValue(req.Tolerance),
+		TextMatch:      getTextMatchValue(req.TextMatch),
+		Points:         getIntValue(req.Points),
+		Topics:         getTopicsValue(req.Topics),
+		Difficulty:     string(req.Difficulty),
+	}
+
+	if err := h.app.Commands.CreateBankQuestion.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/bank-questions/"+cmd.QuestionID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) UpdateBankQuestion(w http.ResponseWriter, r *http.Request, questionId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req UpdateBankQuestionRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpdateBankQuestion.Handle(r.Context(), bank_command.UpdateBankQuestion{
+		Actor:          actor,
+		QuestionID:     questionId,
+		Question:       req.Question,
+		Kind:           getKindValue(req.Kind),
+		Answers:        req.Answers,
+		CorrectAnswers: getCorrectAnswers(req.CorrectAnswer, req.CorrectAnswers),
+		Tolerance:      getFloatValue(req.Tolerance),
+		TextMatch:      getTextMatchValue(req.TextMatch),
+		Points:         getIntValue(req.Points),
+		Topics:         getTopicsValue(req.Topics),
+		Difficulty:     string(req.Difficulty),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) DeleteBankQuestion(w http.ResponseWriter, r *http.Request, questionId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.DeleteBankQuestion.Handle(r.Context(), bank_command.DeleteBankQuestion{
+		Actor:      actor,
+		QuestionID: questionId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) SetLessonBankQuestions(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req SetLessonBankQuestionsRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	pools := make([]lesson_command.LessonBankPool, 0, len(req.Pools))
+	for _, p := range req.Pools {
+		pools = append(pools, lesson_command.LessonBankPool{
+			BankID:     p.BankId,
+			Topic:      getStringValue(p.Topic),
+			Difficulty: getDifficultyValue(p.Difficulty),
+			Count:      p.Count,
+		})
+	}
+
+	err = h.app.Commands.SetLessonBankQuestions.Handle(r.Context(), lesson_command.SetLessonBankQuestions{
+		Actor:       actor,
+		LessonID:    lessonId,
+		QuestionIDs: req.QuestionIds,
+		Pools:       pools,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Bank to API response
+func mapQuestionBankToResponse(b *bank.Bank) QuestionBank {
+	return QuestionBank{
+		Id:        b.ID(),
+		TeacherId: b.TeacherID(),
+		Title:     b.Title(),
+		CreatedAt: b.CreatedAt(),
+	}
+}
+
+// Helper function to map a domain Question to API response, leaving out
+// its answer key
+func mapBankQuestionToResponse(q *bank.Question) BankQuestion {
+	return BankQuestion{
+		Id:         q.ID(),
+		BankId:     q.BankID(),
+		Question:   q.Question(),
+		Kind:       ExerciseKind(q.Kind().String()),
+		Answers:    q.Answers(),
+		Points:     q.Points(),
+		Topics:     q.Topics(),
+		Difficulty: Difficulty(q.Difficulty().String()),
+	}
+}
+
+func mapBankPoolToResponse(p bank.Pool) BankPool {
+	response := BankPool{
+		BankId: p.BankID(),
+		Count:  p.Count(),
+	}
+	if topic := p.Filter().Topic(); topic != "" {
+		response.Topic = &topic
+	}
+	if difficulty := p.Filter().Difficulty(); !difficulty.IsZero() {
+		d := Difficulty(difficulty.String())
+		response.Difficulty = &d
+	}
+	return response
+}
+
+func getDifficultyValue(d *Difficulty) string {
+	if d == nil {
+		return ""
+	}
+	return string(*d)
+}
+
+func getTopicsValue(topics *[]string) []string {
+	if topics == nil {
+		return nil
+	}
+	return *topics
+}
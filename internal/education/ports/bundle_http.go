@@ -0,0 +1,264 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/bundle"
+)
+
+func (h HttpServer) CloneCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	cmd := course_command.CloneCourse{
+		Actor:          actor,
+		SourceCourseID: courseId,
+		CourseID:       uuid.New().String(),
+	}
+	if err := h.app.Commands.CloneCourse.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	c, err := h.app.Queries.GetCourseDetails.Handle(r.Context(), course_query.GetCourseDetails{
+		Actor:    actor,
+		CourseID: cmd.CourseID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/courses/"+cmd.CourseID)
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapCourseToResponse(c))
+}
+
+func (h HttpServer) ExportCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	b, err := h.app.Queries.ExportCourse.Handle(r.Context(), course_query.ExportCourse{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapBundleToResponse(b))
+}
+
+func (h HttpServer) ImportCourse(w http.ResponseWriter, r *http.Request) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CourseBundle
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := mapBundleToCommand(req)
+	cmd.Actor = actor
+	cmd.CourseID = uuid.New().String()
+	cmd.TeacherID = actor.UserID
+
+	if err := h.app.Commands.ImportCourse.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	c, err := h.app.Queries.GetCourseDetails.Handle(r.Context(), course_query.GetCourseDetails{
+		Actor:    actor,
+		CourseID: cmd.CourseID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/courses/"+cmd.CourseID)
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapCourseToResponse(c))
+}
+
+// Helper function to map a domain Bundle to API CourseBundle response
+func mapBundleToResponse(b *bundle.Bundle) CourseBundle {
+	c := b.Course()
+	description := c.Description()
+	thumbnail := c.Thumbnail()
+
+	tags := make([]CourseTag, 0, len(c.Tags()))
+	for _, tag := range c.Tags() {
+		tags = append(tags, CourseTag(tag.String()))
+	}
+
+	modules := make([]BundleModule, 0, len(b.Modules()))
+	for _, mc := range b.Modules() {
+		lessons := make([]BundleLesson, 0, len(mc.Lessons()))
+		for _, lc := range mc.Lessons() {
+			l := lc.Lesson()
+			overview := l.Overview()
+			content := l.Content()
+			videoID := l.VideoID()
+			var passScore *float64
+			if score := l.PassScore(); score != 0 {
+				passScore = &score
+			}
+			var drawCount *int
+			if count := l.DrawCount(); count != 0 {
+				drawCount = &count
+			}
+			var timeLimit *int
+			if minutes := l.TimeLimit(); minutes != 0 {
+				timeLimit = &minutes
+			}
+			var maxAttempts *int
+			if attempts := l.MaxAttempts(); attempts != 0 {
+				maxAttempts = &attempts
+			}
+
+			exercises := make([]CreateExerciseRequest, 0, len(lc.Exercises()))
+			for _, e := range lc.Exercises() {
+				points := e.Points()
+				req := CreateExerciseRequest{
+					Question: e.Question(),
+					Order:    e.Order(),
+					Points:   &points,
+				}
+				mapAnswerKeyToRequest(e.AnswerKeyForStorage(), &req)
+				exercises = append(exercises, req)
+			}
+
+			lessonID := l.ID()
+			lessons = append(lessons, BundleLesson{
+				Id:          &lessonID,
+				Title:       l.Title(),
+				Overview:    &overview,
+				Content:     &content,
+				VideoId:     &videoID,
+				Duration:    l.Duration(),
+				Order:       l.Order(),
+				PassScore:   passScore,
+				DrawCount:   drawCount,
+				TimeLimit:   timeLimit,
+				MaxAttempts: maxAttempts,
+				Gate:        mapGateToResponse(l.Gate()),
+				Exercises:   exercises,
+			})
+		}
+
+		modules = append(modules, BundleModule{
+			Title:   mc.Module().Title(),
+			Order:   mc.Module().Order(),
+			Release: mapReleaseToResponse(mc.Module().Release()),
+			Lessons: lessons,
+		})
+	}
+
+	return CourseBundle{
+		FormatVersion: bundle.FormatVersion,
+		Course: CreateCourseRequest{
+			Title:       c.Title(),
+			Description: &description,
+			Thumbnail:   &thumbnail,
+			Domain:      CourseDomain(c.Domain().String()),
+			Level:       CourseLevel(c.Level().String()),
+			Tags:        &tags,
+		},
+		Modules: modules,
+	}
+}
+
+// Helper function to map an API CourseBundle request to the import command
+func mapBundleToCommand(req CourseBundle) course_command.ImportCourse {
+	var tags []string
+	if req.Course.Tags != nil {
+		for _, tag := range *req.Course.Tags {
+			tags = append(tags, string(tag))
+		}
+	}
+
+	modules := make([]course_command.ImportedModule, 0, len(req.Modules))
+	for _, m := range req.Modules {
+		lessons := make([]course_command.ImportedLesson, 0, len(m.Lessons))
+		for _, l := range m.Lessons {
+			exercises := make([]course_command.ImportedExercise, 0, len(l.Exercises))
+			for _, e := range l.Exercises {
+				exercises = append(exercises, course_command.ImportedExercise{
+					Question:       e.Question,
+					Kind:           getKindValue(e.Kind),
+					Answers:        e.Answers,
+					CorrectAnswers: getCorrectAnswers(e.CorrectAnswer, e.CorrectAnswers),
+					Tolerance:      getFloatValue(e.Tolerance),
+					TextMatch:      getTextMatchValue(e.TextMatch),
+					Order:          e.Order,
+					Points:         getIntValue(e.Points),
+				})
+			}
+
+			importedLesson := course_command.ImportedLesson{
+				ID:          getStringValue(l.Id),
+				Title:       l.Title,
+				Overview:    getStringValue(l.Overview),
+				Content:     getStringValue(l.Content),
+				VideoID:     getStringValue(l.VideoId),
+				Duration:    l.Duration,
+				Order:       l.Order,
+				PassScore:   getFloatValue(l.PassScore),
+				DrawCount:   getIntValue(l.DrawCount),
+				TimeLimit:   getIntValue(l.TimeLimit),
+				MaxAttempts: getIntValue(l.MaxAttempts),
+				Exercises:   exercises,
+			}
+			if l.Gate != nil {
+				importedLesson.RequirePrevious = l.Gate.RequirePrevious
+				importedLesson.ScoreLessonID = getStringValue(l.Gate.ScoreLessonId)
+				importedLesson.MinScore = getFloatValue(l.Gate.MinScore)
+			}
+			lessons = append(lessons, importedLesson)
+		}
+
+		importedModule := course_command.ImportedModule{
+			Title:   m.Title,
+			Order:   m.Order,
+			Lessons: lessons,
+		}
+		if m.Release != nil {
+			if m.Release.DaysAfterEnrollment != nil {
+				importedModule.DaysAfterEnrollment = *m.Release.DaysAfterEnrollment
+			}
+			if m.Release.Date != nil {
+				importedModule.Date = *m.Release.Date
+			}
+		}
+		modules = append(modules, importedModule)
+	}
+
+	return course_command.ImportCourse{
+		FormatVersion: req.FormatVersion,
+		Title:         req.Course.Title,
+		Description:   getStringValue(req.Course.Description),
+		Thumbnail:     getStringValue(req.Course.Thumbnail),
+		Domain:        string(req.Course.Domain),
+		Level:         string(req.Course.Level),
+		Tags:          tags,
+		Modules:       modules,
+	}
+}
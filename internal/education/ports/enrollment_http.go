@@ -0,0 +1,155 @@
+package ports
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/auth"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+)
+
+func (h HttpServer) GetMyEnrollments(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	enrollments, err := h.app.Queries.GetMyEnrollments.Handle(r.Context(), query.GetMyEnrollments{
+		UserID: user.UUID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Enrollment, 0, len(enrollments))
+	for _, e := range enrollments {
+		response = append(response, mapEnrollmentToResponse(e))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) EnrollInCourse(w http.ResponseWriter, r *http.Request) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req EnrollRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := command.EnrollInCourse{
+		Actor:        actor,
+		EnrollmentID: uuid.New().String(),
+		UserID:       actor.UserID,
+		CourseID:     req.CourseId,
+	}
+
+	if err := h.app.Commands.EnrollInCourse.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/me/enrollments/"+cmd.CourseID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) UnenrollFromCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UnenrollFromCourse.Handle(r.Context(), command.UnenrollFromCourse{
+		Actor:    actor,
+		UserID:   actor.UserID,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) CompleteLesson(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.CompleteLesson.Handle(r.Context(), command.CompleteLesson{
+		Actor:    actor,
+		UserID:   actor.UserID,
+		CourseID: courseId,
+		LessonID: lessonId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Enrollment to API Enrollment response
+func mapEnrollmentToResponse(e *enrollment.Enrollment) Enrollment {
+	modules := make([]ModuleProgress, 0, len(e.ModuleProgress()))
+	for _, mp := range e.ModuleProgress() {
+		modules = append(modules, ModuleProgress{
+			ModuleId: mp.ModuleID(),
+			Progress: mapProgressToResponse(mp.Progress()),
+		})
+	}
+
+	lessons := make([]LessonProgress, 0, len(e.LessonProgress()))
+	for _, lp := range e.LessonProgress() {
+		lessons = append(lessons, LessonProgress{
+			LessonId:      lp.LessonID(),
+			Progress:      mapProgressToResponse(lp.Progress()),
+			ExerciseScore: lp.ExerciseScore(),
+			Attempts:      lp.Attempts(),
+		})
+	}
+
+	return Enrollment{
+		Id:             e.ID(),
+		StudentId:      e.UserID(),
+		CourseId:       e.CourseID(),
+		ContentVersion: e.ContentVersion(),
+		EnrolledAt:     e.EnrolledAt(),
+		StartedAt:      timePtrOrNil(e.StartedAt()),
+		CompletedAt:    timePtrOrNil(e.CompletedAt()),
+		Progress:       mapProgressToResponse(e.CourseProgress().Progress()),
+		Modules:        modules,
+		Lessons:        lessons,
+	}
+}
+
+func mapProgressToResponse(p enrollment.Progress) Progress {
+	return Progress{
+		Percentage: p.ProgressPercentage(),
+		Status:     ProgressStatus(p.Status().String()),
+	}
+}
+
+func timePtrOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
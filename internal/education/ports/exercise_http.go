@@ -0,0 +1,214 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/exercise_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/exercise_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/exercise"
+)
+
+func (h HttpServer) GetLessonExercises(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	exercises, err := h.app.Queries.ExercisesByLesson.Handle(r.Context(), exercise_query.ExercisesByLesson{
+		Actor:    actor,
+		LessonID: lessonId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Exercise, 0, len(exercises))
+	for _, e := range exercises {
+		response = append(response, mapExerciseToResponse(e))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateExercise(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateExerciseRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := exercise_command.CreateExercise{
+		Actor:          actor,
+		ExerciseID:     uuid.New().String(),
+		LessonID:       lessonId,
+		Question:       req.Question,
+		Kind:           getKindValue(req.Kind),
+		Answers:        req.Answers,
+		CorrectAnswers: getCorrectAnswers(req.CorrectAnswer, req.CorrectAnswers),
+		Tolerance:      getFloatValue(req.Tolerance),
+		TextMatch:      getTextMatchValue(req.TextMatch),
+		Order:          req.Order,
+		Points:         getIntValue(req.Points),
+	}
+
+	if err := h.app.Commands.CreateExercise.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/exercises/"+cmd.ExerciseID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) ReorderExercises(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ReorderRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ReorderExercises.Handle(r.Context(), exercise_command.ReorderExercises{
+		Actor:    actor,
+		LessonID: lessonId,
+		Orders:   mapReorderRequest(req),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) UpdateExercise(w http.ResponseWriter, r *http.Request, exerciseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req UpdateExerciseRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpdateExercise.Handle(r.Context(), exercise_command.UpdateExercise{
+		Actor:          actor,
+		ExerciseID:     exerciseId,
+		Question:       req.Question,
+		Kind:           getKindValue(req.Kind),
+		Answers:        req.Answers,
+		CorrectAnswers: getCorrectAnswers(req.CorrectAnswer, req.CorrectAnswers),
+		Tolerance:      getFloatValue(req.Tolerance),
+		TextMatch:      getTextMatchValue(req.TextMatch),
+		Order:          req.Order,
+		Points:         getIntValue(req.Points),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) DeleteExercise(w http.ResponseWriter, r *http.Request, exerciseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.DeleteExercise.Handle(r.Context(), exercise_command.DeleteExercise{
+		Actor:      actor,
+		ExerciseID: exerciseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Exercise to API Exercise response.
+// The correct answer is intentionally never exposed.
+func mapExerciseToResponse(e *exercise.Exercise) Exercise {
+	return Exercise{
+		Id:       e.ID(),
+		LessonId: e.LessonID(),
+		Question: e.Question(),
+		Kind:     ExerciseKind(e.Kind().String()),
+		Answers:  e.Answers(),
+		Order:    e.Order(),
+		Points:   e.Points(),
+	}
+}
+
+// Helper function to map a domain AnswerKey to the fields of an API exercise
+// request, using the correctAnswer shorthand where one answer is correct
+func mapAnswerKeyToRequest(key exercise.AnswerKey, req *CreateExerciseRequest) {
+	kind := ExerciseKind(key.Kind().String())
+	req.Kind = &kind
+	req.Answers = key.Options()
+
+	correct := key.Correct()
+	switch key.Kind() {
+	case exercise.SingleChoice, exercise.TrueFalse, exercise.Numeric:
+		req.CorrectAnswer = &correct[0]
+	default:
+		req.CorrectAnswers = &correct
+	}
+	if key.Kind() == exercise.Numeric {
+		tolerance := key.Tolerance()
+		req.Tolerance = &tolerance
+	}
+	if key.Kind() == exercise.ShortText {
+		match := TextMatch(key.TextMatch().String())
+		req.TextMatch = &match
+	}
+}
+
+// Helper function to merge the correctAnswer shorthand into the list of
+// correct answers
+func getCorrectAnswers(correctAnswer *string, correctAnswers *[]string) []string {
+	if correctAnswers != nil {
+		return *correctAnswers
+	}
+	if correctAnswer != nil {
+		return []string{*correctAnswer}
+	}
+	return nil
+}
+
+func getKindValue(k *ExerciseKind) string {
+	if k == nil {
+		return ""
+	}
+	return string(*k)
+}
+
+func getTextMatchValue(m *TextMatch) string {
+	if m == nil {
+		return ""
+	}
+	return string(*m)
+}
@@ -1,12 +1,16 @@
 package ports
 
 import (
+	"html"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/render"
+	"github.com/maixuanbach174/online-course-app/internal/common/auth"
 	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
 	"github.com/maixuanbach174/online-course-app/internal/education/app"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
 	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
 )
@@ -22,41 +26,85 @@ func NewHttpServer(application app.Application) HttpServer {
 }
 
 func (h HttpServer) GetCourses(w http.ResponseWriter, r *http.Request, params GetCoursesParams) {
-	context := r.Context()
+	query := course_query.GetAllCourses{
+		MinDuration: params.MinDuration,
+		MaxDuration: params.MaxDuration,
+	}
 
 	// Handle optional parameters safely
-	var domain, level, tag string
 	if params.Domain != nil {
-		domain = string(*params.Domain)
+		query.Domain = string(*params.Domain)
 	}
 	if params.Level != nil {
-		level = string(*params.Level)
+		query.Level = string(*params.Level)
 	}
 	if params.Tag != nil {
-		tag = string(*params.Tag)
+		for _, tag := range *params.Tag {
+			query.Tags = append(query.Tags, string(tag))
+		}
+	}
+	if params.TeacherId != nil {
+		query.TeacherID = *params.TeacherId
+	}
+	if params.MinRating != nil {
+		minRating := float64(*params.MinRating)
+		query.MinRating = &minRating
+	}
+	if params.MaxRating != nil {
+		maxRating := float64(*params.MaxRating)
+		query.MaxRating = &maxRating
+	}
+	if params.Sort != nil {
+		query.Sort = string(*params.Sort)
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
 	}
 
-	courses, err := h.app.Queries.GetAllCourses.Handle(context, course_query.GetAllCourses{
-		Domain: domain,
-		Level:  level,
-		Tag:    tag,
-	})
+	page, err := h.app.Queries.GetAllCourses.Handle(r.Context(), query)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapCoursePageToResponse(page, query.Limit))
+}
 
+func (h HttpServer) SearchCourses(w http.ResponseWriter, r *http.Request, params SearchCoursesParams) {
+	query := course_query.SearchCourses{Query: params.Q}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	results, err := h.app.Queries.SearchCourses.Handle(r.Context(), query)
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	// Map domain courses to API response
-	var response []Course
-	for _, c := range courses {
-		response = append(response, mapCourseToResponse(c))
+	items := make([]CourseSearchHit, 0, len(results))
+	for _, result := range results {
+		items = append(items, CourseSearchHit{
+			Course:         mapCourseToResponse(result.Course),
+			Rank:           float32(result.Rank),
+			TitleHighlight: highlightHTML(result.TitleHighlight),
+			Snippet:        highlightHTML(result.Snippet),
+		})
 	}
 
-	render.Respond(w, r, response)
+	render.Respond(w, r, CourseSearchResults{Items: items})
 }
 
 func (h HttpServer) CreateCourse(w http.ResponseWriter, r *http.Request) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	var req CreateCourseRequest
 	if err := render.Decode(r, &req); err != nil {
 		httperr.BadRequest("invalid-request", err, w, r)
@@ -70,20 +118,14 @@ func (h HttpServer) CreateCourse(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var rating float64
-	if req.Rating != nil {
-		rating = float64(*req.Rating)
-	}
-
-	err := h.app.Commands.CreateCourse.Handle(r.Context(), course_command.CreateCourse{
-		TeacherID:   req.TeacherId,
+	err = h.app.Commands.CreateCourse.Handle(r.Context(), course_command.CreateCourse{
+		Actor:       actor,
+		TeacherID:   actor.UserID,
 		Title:       req.Title,
 		Description: getStringValue(req.Description),
 		Thumbnail:   getStringValue(req.Thumbnail),
-		Duration:    req.Duration,
 		Domain:      string(req.Domain),
 		Tags:        tags,
-		Rating:      rating,
 		Level:       string(req.Level),
 	})
 
@@ -96,7 +138,14 @@ func (h HttpServer) CreateCourse(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h HttpServer) DeleteCourse(w http.ResponseWriter, r *http.Request, courseId string) {
-	err := h.app.Commands.DeleteCourse.Handle(r.Context(), course_command.DeleteCourse{
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.DeleteCourse.Handle(r.Context(), course_command.DeleteCourse{
+		Actor:    actor,
 		CourseID: courseId,
 	})
 
@@ -109,7 +158,14 @@ func (h HttpServer) DeleteCourse(w http.ResponseWriter, r *http.Request, courseI
 }
 
 func (h HttpServer) GetCourseById(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := optionalActorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	c, err := h.app.Queries.GetCourseDetails.Handle(r.Context(), course_query.GetCourseDetails{
+		Actor:    actor,
 		CourseID: courseId,
 	})
 
@@ -123,6 +179,12 @@ func (h HttpServer) GetCourseById(w http.ResponseWriter, r *http.Request, course
 }
 
 func (h HttpServer) UpdateCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	var req UpdateCourseRequest
 	if err := render.Decode(r, &req); err != nil {
 		httperr.BadRequest("invalid-request", err, w, r)
@@ -131,6 +193,7 @@ func (h HttpServer) UpdateCourse(w http.ResponseWriter, r *http.Request, courseI
 
 	// Get the existing course first to populate fields that aren't being updated
 	existingCourse, err := h.app.Queries.GetCourseDetails.Handle(r.Context(), course_query.GetCourseDetails{
+		Actor:    actor,
 		CourseID: courseId,
 	})
 	if err != nil {
@@ -140,15 +203,14 @@ func (h HttpServer) UpdateCourse(w http.ResponseWriter, r *http.Request, courseI
 
 	// Build the update command, using existing values for fields not provided in the request
 	updateCmd := course_command.UpdateCourse{
+		Actor:       actor,
 		CourseID:    courseId,
 		TeacherID:   existingCourse.TeacherID(),
 		Title:       getStringValueWithDefault(req.Title, existingCourse.Title()),
 		Description: getStringValueWithDefault(req.Description, existingCourse.Description()),
 		Thumbnail:   getStringValueWithDefault(req.Thumbnail, existingCourse.Thumbnail()),
-		Duration:    getIntValueWithDefault(req.Duration, existingCourse.Duration()),
 		Domain:      getStringValueWithDefault((*string)(req.Domain), existingCourse.Domain().String()),
 		Level:       getStringValueWithDefault((*string)(req.Level), existingCourse.Level().String()),
-		Rating:      getFloat64ValueWithDefault(req.Rating, existingCourse.Rating()),
 	}
 
 	// Handle tags
@@ -176,8 +238,50 @@ func (h HttpServer) UpdateCourse(w http.ResponseWriter, r *http.Request, courseI
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h HttpServer) ChangeCourseStatus(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ChangeCourseStatusRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ChangeCourseStatus.Handle(r.Context(), course_command.ChangeCourseStatus{
+		Actor:    actor,
+		CourseID: courseId,
+		Status:   string(req.Status),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	c, err := h.app.Queries.GetCourseDetails.Handle(r.Context(), course_query.GetCourseDetails{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapCourseToResponse(c))
+}
+
 func (h HttpServer) GetCoursesByTeacher(w http.ResponseWriter, r *http.Request, teacherId string) {
+	actor, err := optionalActorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	courses, err := h.app.Queries.CoursesByTeacher.Handle(r.Context(), course_query.CourseByTeacherQuery{
+		Actor:     actor,
 		TeacherID: teacherId,
 	})
 
@@ -199,6 +303,8 @@ func (h HttpServer) GetCoursesByTeacher(w http.ResponseWriter, r *http.Request,
 func mapCourseToResponse(c *course.Course) Course {
 	description := c.Description()
 	thumbnail := c.Thumbnail()
+	duration := c.Duration()
+	prerequisites := mapPrerequisitesToResponse(c.Prerequisites())
 
 	var tags []CourseTag
 	for _, tag := range c.Tags() {
@@ -211,42 +317,97 @@ func mapCourseToResponse(c *course.Course) Course {
 		Title:       c.Title(),
 		Description: &description,
 		Thumbnail:   &thumbnail,
-		Duration:    c.Duration(),
+		Duration:    &duration,
 		Domain:      CourseDomain(c.Domain().String()),
 		Level:       CourseLevel(c.Level().String()),
 		Rating:      float32(c.Rating()),
+		ReviewCount: c.ReviewCount(),
+		Status:      CourseStatus(c.Status().String()),
 		Tags:        &tags,
+
+		Prerequisites: &prerequisites,
 	}
 }
 
-// Helper function to safely get string value from pointer
-func getStringValue(s *string) string {
-	if s == nil {
-		return ""
+// Helper function to map a domain course listing page to API CoursePage response
+func mapCoursePageToResponse(page course.ListPage, limit int) CoursePage {
+	if limit == 0 {
+		limit = course.DefaultListLimit
 	}
-	return *s
+
+	items := make([]Course, 0, len(page.Courses()))
+	for _, c := range page.Courses() {
+		items = append(items, mapCourseToResponse(c))
+	}
+
+	response := CoursePage{
+		Items: items,
+		Limit: limit,
+	}
+	if page.HasMore() {
+		nextCursor := page.NextCursor()
+		response.NextCursor = &nextCursor
+	}
+
+	return response
 }
 
-// Helper function to get string value from pointer with default
-func getStringValueWithDefault(s *string, defaultValue string) string {
+// highlightHTML escapes the text of a search highlight and wraps its matched
+// terms in mark tags
+func highlightHTML(highlight string) string {
+	return strings.NewReplacer(
+		course_query.HighlightStart, "<mark>",
+		course_query.HighlightStop, "</mark>",
+	).Replace(html.EscapeString(highlight))
+}
+
+// Helper function to build the command actor from the authenticated user
+func actorFromRequest(r *http.Request) (policy.Actor, error) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		return policy.Actor{}, err
+	}
+
+	return policy.NewActor(user.UUID, user.Role)
+}
+
+// Helper function to get the caller of a public endpoint, anonymous when no token was sent
+func optionalActorFromRequest(r *http.Request) (policy.Actor, error) {
+	if _, err := auth.UserFromCtx(r.Context()); err != nil {
+		return policy.Actor{}, nil
+	}
+
+	return actorFromRequest(r)
+}
+
+// Helper function to safely get string value from pointer
+func getStringValue(s *string) string {
 	if s == nil {
-		return defaultValue
+		return ""
 	}
 	return *s
 }
 
-// Helper function to get int value from pointer with default
-func getIntValueWithDefault(i *int, defaultValue int) int {
+// Helper function to get int value from pointer
+func getIntValue(i *int) int {
 	if i == nil {
-		return defaultValue
+		return 0
 	}
 	return *i
 }
 
-// Helper function to get float64 value from pointer with default
-func getFloat64ValueWithDefault(f *float32, defaultValue float64) float64 {
+// Helper function to get float value from pointer
+func getFloatValue(f *float64) float64 {
 	if f == nil {
+		return 0
+	}
+	return *f
+}
+
+// Helper function to get string value from pointer with default
+func getStringValueWithDefault(s *string, defaultValue string) string {
+	if s == nil {
 		return defaultValue
 	}
-	return float64(*f)
+	return *s
 }
@@ -0,0 +1,264 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/lesson_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/lesson_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/lesson"
+)
+
+func (h HttpServer) GetModuleLessons(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	lessons, err := h.app.Queries.LessonsByModule.Handle(r.Context(), lesson_query.LessonsByModule{
+		Actor:    actor,
+		ModuleID: moduleId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Lesson, 0, len(lessons))
+	for _, l := range lessons {
+		response = append(response, mapLessonToResponse(l))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateLesson(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateLessonRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := lesson_command.CreateLesson{
+		Actor:       actor,
+		LessonID:    uuid.New().String(),
+		ModuleID:    moduleId,
+		Title:       req.Title,
+		Overview:    getStringValue(req.Overview),
+		Content:     getStringValue(req.Content),
+		VideoID:     getStringValue(req.VideoId),
+		Duration:    req.Duration,
+		Order:       req.Order,
+		PassScore:   getFloatValue(req.PassScore),
+		DrawCount:   getIntValue(req.DrawCount),
+		TimeLimit:   getIntValue(req.TimeLimit),
+		MaxAttempts: getIntValue(req.MaxAttempts),
+	}
+
+	if err := h.app.Commands.CreateLesson.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/lessons/"+cmd.LessonID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) ReorderLessons(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ReorderRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ReorderLessons.Handle(r.Context(), lesson_command.ReorderLessons{
+		Actor:    actor,
+		ModuleID: moduleId,
+		Orders:   mapReorderRequest(req),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) GetLessonById(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	l, err := h.app.Queries.GetLesson.Handle(r.Context(), lesson_query.GetLesson{
+		Actor:    actor,
+		LessonID: lessonId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapLessonToResponse(l))
+}
+
+func (h HttpServer) UpdateLesson(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req UpdateLessonRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpdateLesson.Handle(r.Context(), lesson_command.UpdateLesson{
+		Actor:       actor,
+		LessonID:    lessonId,
+		Title:       req.Title,
+		Overview:    getStringValue(req.Overview),
+		Content:     getStringValue(req.Content),
+		VideoID:     getStringValue(req.VideoId),
+		Duration:    req.Duration,
+		Order:       req.Order,
+		PassScore:   getFloatValue(req.PassScore),
+		DrawCount:   getIntValue(req.DrawCount),
+		TimeLimit:   getIntValue(req.TimeLimit),
+		MaxAttempts: getIntValue(req.MaxAttempts),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) DeleteLesson(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.DeleteLesson.Handle(r.Context(), lesson_command.DeleteLesson{
+		Actor:    actor,
+		LessonID: lessonId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) SetLessonGate(w http.ResponseWriter, r *http.Request, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req LessonGate
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := lesson_command.SetLessonGate{
+		Actor:           actor,
+		LessonID:        lessonId,
+		RequirePrevious: req.RequirePrevious,
+		ScoreLessonID:   getStringValue(req.ScoreLessonId),
+	}
+	if req.MinScore != nil {
+		cmd.MinScore = *req.MinScore
+	}
+
+	if err := h.app.Commands.SetLessonGate.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Lesson to API Lesson response
+func mapLessonToResponse(l *lesson.Lesson) Lesson {
+	overview := l.Overview()
+	content := l.Content()
+	videoID := l.VideoID()
+
+	response := Lesson{
+		Id:       l.ID(),
+		ModuleId: l.ModuleID(),
+		Title:    l.Title(),
+		Overview: &overview,
+		Content:  &content,
+		VideoId:  &videoID,
+		Duration: l.Duration(),
+		Order:    l.Order(),
+	}
+	response.Gate = mapGateToResponse(l.Gate())
+	if passScore := l.PassScore(); passScore != 0 {
+		response.PassScore = &passScore
+	}
+	if drawCount := l.DrawCount(); drawCount != 0 {
+		response.DrawCount = &drawCount
+	}
+	if timeLimit := l.TimeLimit(); timeLimit != 0 {
+		response.TimeLimit = &timeLimit
+	}
+	if maxAttempts := l.MaxAttempts(); maxAttempts != 0 {
+		response.MaxAttempts = &maxAttempts
+	}
+	if questionIDs := l.BankQuestionIDs(); len(questionIDs) > 0 {
+		response.BankQuestionIds = &questionIDs
+	}
+	if pools := l.BankPools(); len(pools) > 0 {
+		bankPools := make([]BankPool, 0, len(pools))
+		for _, p := range pools {
+			bankPools = append(bankPools, mapBankPoolToResponse(p))
+		}
+		response.BankPools = &bankPools
+	}
+
+	return response
+}
+
+// Helper function to map a lesson gate to API LessonGate response, nil for
+// open lessons
+func mapGateToResponse(gate lesson.Gate) *LessonGate {
+	if gate.IsOpen() {
+		return nil
+	}
+
+	response := &LessonGate{RequirePrevious: gate.RequiresPrevious()}
+	if gate.RequiresScore() {
+		scoreLessonID := gate.ScoreLessonID()
+		minScore := gate.MinScore()
+		response.ScoreLessonId = &scoreLessonID
+		response.MinScore = &minScore
+	}
+	return response
+}
@@ -0,0 +1,229 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/module_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/module_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/module"
+)
+
+func (h HttpServer) GetCourseModules(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	modules, err := h.app.Queries.ModulesByCourse.Handle(r.Context(), module_query.ModulesByCourse{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Module, 0, len(modules))
+	for _, m := range modules {
+		response = append(response, mapModuleToResponse(m))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateModule(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateModuleRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := module_command.CreateModule{
+		Actor:    actor,
+		ModuleID: uuid.New().String(),
+		CourseID: courseId,
+		Title:    req.Title,
+		Order:    req.Order,
+	}
+
+	if err := h.app.Commands.CreateModule.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/modules/"+cmd.ModuleID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h HttpServer) ReorderModules(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ReorderRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ReorderModules.Handle(r.Context(), module_command.ReorderModules{
+		Actor:    actor,
+		CourseID: courseId,
+		Orders:   mapReorderRequest(req),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) GetModuleById(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	m, err := h.app.Queries.GetModule.Handle(r.Context(), module_query.GetModule{
+		Actor:    actor,
+		ModuleID: moduleId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapModuleToResponse(m))
+}
+
+func (h HttpServer) UpdateModule(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req UpdateModuleRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpdateModule.Handle(r.Context(), module_command.UpdateModule{
+		Actor:    actor,
+		ModuleID: moduleId,
+		Title:    req.Title,
+		Order:    req.Order,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) DeleteModule(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.DeleteModule.Handle(r.Context(), module_command.DeleteModule{
+		Actor:    actor,
+		ModuleID: moduleId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) SetModuleRelease(w http.ResponseWriter, r *http.Request, moduleId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ModuleRelease
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := module_command.SetModuleRelease{
+		Actor:    actor,
+		ModuleID: moduleId,
+	}
+	if req.DaysAfterEnrollment != nil {
+		cmd.DaysAfterEnrollment = *req.DaysAfterEnrollment
+	}
+	if req.Date != nil {
+		cmd.Date = *req.Date
+	}
+
+	if err := h.app.Commands.SetModuleRelease.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Module to API Module response
+func mapModuleToResponse(m *module.Module) Module {
+	duration := m.Duration()
+
+	return Module{
+		Id:       m.ID(),
+		CourseId: m.CourseID(),
+		Title:    m.Title(),
+		Order:    m.Order(),
+		Duration: &duration,
+		Release:  mapReleaseToResponse(m.Release()),
+	}
+}
+
+// Helper function to map a module release to API ModuleRelease response, nil
+// for modules opening on enrollment
+func mapReleaseToResponse(release module.Release) *ModuleRelease {
+	if release.IsImmediate() {
+		return nil
+	}
+
+	response := &ModuleRelease{}
+	if days := release.DaysAfterEnrollment(); days > 0 {
+		response.DaysAfterEnrollment = &days
+	}
+	if date := release.Date(); !date.IsZero() {
+		response.Date = &date
+	}
+	return response
+}
+
+// Helper function to map API reorder request to child ID -> order map
+func mapReorderRequest(req ReorderRequest) map[string]int {
+	orders := make(map[string]int, len(req.Items))
+	for _, item := range req.Items {
+		orders[item.Id] = item.Order
+	}
+	return orders
+}
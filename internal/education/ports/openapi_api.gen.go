@@ -4,6 +4,7 @@
 package ports
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -13,12 +14,24 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Delete a bank question
+	// (DELETE /bank-questions/{questionId})
+	DeleteBankQuestion(w http.ResponseWriter, r *http.Request, questionId string)
+	// Update a bank question
+	// (PUT /bank-questions/{questionId})
+	UpdateBankQuestion(w http.ResponseWriter, r *http.Request, questionId string)
 	// Get all courses
 	// (GET /courses)
 	GetCourses(w http.ResponseWriter, r *http.Request, params GetCoursesParams)
 	// Create a new course
 	// (POST /courses)
 	CreateCourse(w http.ResponseWriter, r *http.Request)
+	// Import a course bundle
+	// (POST /courses/import)
+	ImportCourse(w http.ResponseWriter, r *http.Request)
+	// Search courses
+	// (GET /courses/search)
+	SearchCourses(w http.ResponseWriter, r *http.Request, params SearchCoursesParams)
 	// Delete a course
 	// (DELETE /courses/{courseId})
 	DeleteCourse(w http.ResponseWriter, r *http.Request, courseId string)
@@ -28,6 +41,153 @@ type ServerInterface interface {
 	// Update a course
 	// (PUT /courses/{courseId})
 	UpdateCourse(w http.ResponseWriter, r *http.Request, courseId string)
+	// Clone a course
+	// (POST /courses/{courseId}/clone)
+	CloneCourse(w http.ResponseWriter, r *http.Request, courseId string)
+	// Export a course bundle
+	// (GET /courses/{courseId}/export)
+	ExportCourse(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get course modules
+	// (GET /courses/{courseId}/modules)
+	GetCourseModules(w http.ResponseWriter, r *http.Request, courseId string)
+	// Create a module
+	// (POST /courses/{courseId}/modules)
+	CreateModule(w http.ResponseWriter, r *http.Request, courseId string)
+	// Reorder modules
+	// (PUT /courses/{courseId}/modules/order)
+	ReorderModules(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get course outline
+	// (GET /courses/{courseId}/outline)
+	GetCourseOutline(w http.ResponseWriter, r *http.Request, courseId string)
+	// Revoke a prerequisite waiver
+	// (DELETE /courses/{courseId}/prerequisite-waivers/{userId})
+	RevokePrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string)
+	// Waive the course prerequisites for a student
+	// (PUT /courses/{courseId}/prerequisite-waivers/{userId})
+	GrantPrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string)
+	// Set course prerequisites
+	// (PUT /courses/{courseId}/prerequisites)
+	SetCoursePrerequisites(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get course reviews
+	// (GET /courses/{courseId}/reviews)
+	GetCourseReviews(w http.ResponseWriter, r *http.Request, courseId string)
+	// Review a course
+	// (POST /courses/{courseId}/reviews)
+	PostReview(w http.ResponseWriter, r *http.Request, courseId string)
+	// Change course status
+	// (PUT /courses/{courseId}/status)
+	ChangeCourseStatus(w http.ResponseWriter, r *http.Request, courseId string)
+	// Publish a new course version
+	// (POST /courses/{courseId}/versions)
+	PublishCourseVersion(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get a course version
+	// (GET /courses/{courseId}/versions/{version})
+	GetCourseVersion(w http.ResponseWriter, r *http.Request, courseId string, version int)
+	// Delete an exercise
+	// (DELETE /exercises/{exerciseId})
+	DeleteExercise(w http.ResponseWriter, r *http.Request, exerciseId string)
+	// Update an exercise
+	// (PUT /exercises/{exerciseId})
+	UpdateExercise(w http.ResponseWriter, r *http.Request, exerciseId string)
+	// Delete a lesson
+	// (DELETE /lessons/{lessonId})
+	DeleteLesson(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Get lesson details
+	// (GET /lessons/{lessonId})
+	GetLessonById(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Update a lesson
+	// (PUT /lessons/{lessonId})
+	UpdateLesson(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Set the bank questions of a lesson
+	// (PUT /lessons/{lessonId}/bank-questions)
+	SetLessonBankQuestions(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Get lesson exercises
+	// (GET /lessons/{lessonId}/exercises)
+	GetLessonExercises(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Create an exercise
+	// (POST /lessons/{lessonId}/exercises)
+	CreateExercise(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Reorder exercises
+	// (PUT /lessons/{lessonId}/exercises/order)
+	ReorderExercises(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Set the unlock rules of a lesson
+	// (PUT /lessons/{lessonId}/gate)
+	SetLessonGate(w http.ResponseWriter, r *http.Request, lessonId string)
+	// Get my enrollments
+	// (GET /me/enrollments)
+	GetMyEnrollments(w http.ResponseWriter, r *http.Request)
+	// Enroll in a course
+	// (POST /me/enrollments)
+	EnrollInCourse(w http.ResponseWriter, r *http.Request)
+	// Unenroll from a course
+	// (DELETE /me/enrollments/{courseId})
+	UnenrollFromCourse(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get enrolled course content
+	// (GET /me/enrollments/{courseId}/content)
+	GetEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get lesson attempts
+	// (GET /me/enrollments/{courseId}/lessons/{lessonId}/attempts)
+	GetLessonAttempts(w http.ResponseWriter, r *http.Request, courseId string, lessonId string)
+	// Submit lesson answers
+	// (POST /me/enrollments/{courseId}/lessons/{lessonId}/attempts)
+	SubmitLessonAnswers(w http.ResponseWriter, r *http.Request, courseId string, lessonId string)
+	// Complete a lesson
+	// (POST /me/enrollments/{courseId}/lessons/{lessonId}/complete)
+	CompleteLesson(w http.ResponseWriter, r *http.Request, courseId string, lessonId string)
+	// Start a quiz session
+	// (POST /me/enrollments/{courseId}/lessons/{lessonId}/quiz-sessions)
+	StartQuizSession(w http.ResponseWriter, r *http.Request, courseId string, lessonId string)
+	// Get enrolled course outline
+	// (GET /me/enrollments/{courseId}/outline)
+	GetMyCourseOutline(w http.ResponseWriter, r *http.Request, courseId string)
+	// Get a quiz session
+	// (GET /me/enrollments/{courseId}/quiz-sessions/{sessionId})
+	GetQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string)
+	// Submit a quiz session
+	// (POST /me/enrollments/{courseId}/quiz-sessions/{sessionId}/answers)
+	SubmitQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string)
+	// Upgrade to the latest course content
+	// (POST /me/enrollments/{courseId}/upgrade)
+	UpgradeEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string)
+	// Delete a module
+	// (DELETE /modules/{moduleId})
+	DeleteModule(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Get module details
+	// (GET /modules/{moduleId})
+	GetModuleById(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Update a module
+	// (PUT /modules/{moduleId})
+	UpdateModule(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Get module lessons
+	// (GET /modules/{moduleId}/lessons)
+	GetModuleLessons(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Create a lesson
+	// (POST /modules/{moduleId}/lessons)
+	CreateLesson(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Reorder lessons
+	// (PUT /modules/{moduleId}/lessons/order)
+	ReorderLessons(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Schedule the release of a module
+	// (PUT /modules/{moduleId}/release)
+	SetModuleRelease(w http.ResponseWriter, r *http.Request, moduleId string)
+	// Get my question banks
+	// (GET /question-banks)
+	GetMyQuestionBanks(w http.ResponseWriter, r *http.Request)
+	// Create a question bank
+	// (POST /question-banks)
+	CreateQuestionBank(w http.ResponseWriter, r *http.Request)
+	// Get a question bank
+	// (GET /question-banks/{bankId})
+	GetQuestionBank(w http.ResponseWriter, r *http.Request, bankId string)
+	// Get bank questions
+	// (GET /question-banks/{bankId}/questions)
+	GetBankQuestions(w http.ResponseWriter, r *http.Request, bankId string, params GetBankQuestionsParams)
+	// Create a bank question
+	// (POST /question-banks/{bankId}/questions)
+	CreateBankQuestion(w http.ResponseWriter, r *http.Request, bankId string)
+	// Edit a review
+	// (PUT /reviews/{reviewId})
+	EditReview(w http.ResponseWriter, r *http.Request, reviewId string)
 	// Get courses by teacher
 	// (GET /teachers/{teacherId}/courses)
 	GetCoursesByTeacher(w http.ResponseWriter, r *http.Request, teacherId string)
@@ -37,6 +197,18 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Delete a bank question
+// (DELETE /bank-questions/{questionId})
+func (_ Unimplemented) DeleteBankQuestion(w http.ResponseWriter, r *http.Request, questionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a bank question
+// (PUT /bank-questions/{questionId})
+func (_ Unimplemented) UpdateBankQuestion(w http.ResponseWriter, r *http.Request, questionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all courses
 // (GET /courses)
 func (_ Unimplemented) GetCourses(w http.ResponseWriter, r *http.Request, params GetCoursesParams) {
@@ -49,73 +221,2074 @@ func (_ Unimplemented) CreateCourse(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a course
-// (DELETE /courses/{courseId})
-func (_ Unimplemented) DeleteCourse(w http.ResponseWriter, r *http.Request, courseId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Import a course bundle
+// (POST /courses/import)
+func (_ Unimplemented) ImportCourse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search courses
+// (GET /courses/search)
+func (_ Unimplemented) SearchCourses(w http.ResponseWriter, r *http.Request, params SearchCoursesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a course
+// (DELETE /courses/{courseId})
+func (_ Unimplemented) DeleteCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get course details
+// (GET /courses/{courseId})
+func (_ Unimplemented) GetCourseById(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a course
+// (PUT /courses/{courseId})
+func (_ Unimplemented) UpdateCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clone a course
+// (POST /courses/{courseId}/clone)
+func (_ Unimplemented) CloneCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a course bundle
+// (GET /courses/{courseId}/export)
+func (_ Unimplemented) ExportCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get course modules
+// (GET /courses/{courseId}/modules)
+func (_ Unimplemented) GetCourseModules(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a module
+// (POST /courses/{courseId}/modules)
+func (_ Unimplemented) CreateModule(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reorder modules
+// (PUT /courses/{courseId}/modules/order)
+func (_ Unimplemented) ReorderModules(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get course outline
+// (GET /courses/{courseId}/outline)
+func (_ Unimplemented) GetCourseOutline(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a prerequisite waiver
+// (DELETE /courses/{courseId}/prerequisite-waivers/{userId})
+func (_ Unimplemented) RevokePrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Waive the course prerequisites for a student
+// (PUT /courses/{courseId}/prerequisite-waivers/{userId})
+func (_ Unimplemented) GrantPrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set course prerequisites
+// (PUT /courses/{courseId}/prerequisites)
+func (_ Unimplemented) SetCoursePrerequisites(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get course reviews
+// (GET /courses/{courseId}/reviews)
+func (_ Unimplemented) GetCourseReviews(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Review a course
+// (POST /courses/{courseId}/reviews)
+func (_ Unimplemented) PostReview(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change course status
+// (PUT /courses/{courseId}/status)
+func (_ Unimplemented) ChangeCourseStatus(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish a new course version
+// (POST /courses/{courseId}/versions)
+func (_ Unimplemented) PublishCourseVersion(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a course version
+// (GET /courses/{courseId}/versions/{version})
+func (_ Unimplemented) GetCourseVersion(w http.ResponseWriter, r *http.Request, courseId string, version int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an exercise
+// (DELETE /exercises/{exerciseId})
+func (_ Unimplemented) DeleteExercise(w http.ResponseWriter, r *http.Request, exerciseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an exercise
+// (PUT /exercises/{exerciseId})
+func (_ Unimplemented) UpdateExercise(w http.ResponseWriter, r *http.Request, exerciseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a lesson
+// (DELETE /lessons/{lessonId})
+func (_ Unimplemented) DeleteLesson(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get lesson details
+// (GET /lessons/{lessonId})
+func (_ Unimplemented) GetLessonById(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a lesson
+// (PUT /lessons/{lessonId})
+func (_ Unimplemented) UpdateLesson(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the bank questions of a lesson
+// (PUT /lessons/{lessonId}/bank-questions)
+func (_ Unimplemented) SetLessonBankQuestions(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get lesson exercises
+// (GET /lessons/{lessonId}/exercises)
+func (_ Unimplemented) GetLessonExercises(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an exercise
+// (POST /lessons/{lessonId}/exercises)
+func (_ Unimplemented) CreateExercise(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reorder exercises
+// (PUT /lessons/{lessonId}/exercises/order)
+func (_ Unimplemented) ReorderExercises(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the unlock rules of a lesson
+// (PUT /lessons/{lessonId}/gate)
+func (_ Unimplemented) SetLessonGate(w http.ResponseWriter, r *http.Request, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get my enrollments
+// (GET /me/enrollments)
+func (_ Unimplemented) GetMyEnrollments(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enroll in a course
+// (POST /me/enrollments)
+func (_ Unimplemented) EnrollInCourse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unenroll from a course
+// (DELETE /me/enrollments/{courseId})
+func (_ Unimplemented) UnenrollFromCourse(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get enrolled course content
+// (GET /me/enrollments/{courseId}/content)
+func (_ Unimplemented) GetEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get lesson attempts
+// (GET /me/enrollments/{courseId}/lessons/{lessonId}/attempts)
+func (_ Unimplemented) GetLessonAttempts(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit lesson answers
+// (POST /me/enrollments/{courseId}/lessons/{lessonId}/attempts)
+func (_ Unimplemented) SubmitLessonAnswers(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete a lesson
+// (POST /me/enrollments/{courseId}/lessons/{lessonId}/complete)
+func (_ Unimplemented) CompleteLesson(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a quiz session
+// (POST /me/enrollments/{courseId}/lessons/{lessonId}/quiz-sessions)
+func (_ Unimplemented) StartQuizSession(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get enrolled course outline
+// (GET /me/enrollments/{courseId}/outline)
+func (_ Unimplemented) GetMyCourseOutline(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a quiz session
+// (GET /me/enrollments/{courseId}/quiz-sessions/{sessionId})
+func (_ Unimplemented) GetQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit a quiz session
+// (POST /me/enrollments/{courseId}/quiz-sessions/{sessionId}/answers)
+func (_ Unimplemented) SubmitQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upgrade to the latest course content
+// (POST /me/enrollments/{courseId}/upgrade)
+func (_ Unimplemented) UpgradeEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a module
+// (DELETE /modules/{moduleId})
+func (_ Unimplemented) DeleteModule(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get module details
+// (GET /modules/{moduleId})
+func (_ Unimplemented) GetModuleById(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a module
+// (PUT /modules/{moduleId})
+func (_ Unimplemented) UpdateModule(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get module lessons
+// (GET /modules/{moduleId}/lessons)
+func (_ Unimplemented) GetModuleLessons(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a lesson
+// (POST /modules/{moduleId}/lessons)
+func (_ Unimplemented) CreateLesson(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reorder lessons
+// (PUT /modules/{moduleId}/lessons/order)
+func (_ Unimplemented) ReorderLessons(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Schedule the release of a module
+// (PUT /modules/{moduleId}/release)
+func (_ Unimplemented) SetModuleRelease(w http.ResponseWriter, r *http.Request, moduleId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get my question banks
+// (GET /question-banks)
+func (_ Unimplemented) GetMyQuestionBanks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a question bank
+// (POST /question-banks)
+func (_ Unimplemented) CreateQuestionBank(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a question bank
+// (GET /question-banks/{bankId})
+func (_ Unimplemented) GetQuestionBank(w http.ResponseWriter, r *http.Request, bankId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get bank questions
+// (GET /question-banks/{bankId}/questions)
+func (_ Unimplemented) GetBankQuestions(w http.ResponseWriter, r *http.Request, bankId string, params GetBankQuestionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a bank question
+// (POST /question-banks/{bankId}/questions)
+func (_ Unimplemented) CreateBankQuestion(w http.ResponseWriter, r *http.Request, bankId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit a review
+// (PUT /reviews/{reviewId})
+func (_ Unimplemented) EditReview(w http.ResponseWriter, r *http.Request, reviewId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get courses by teacher
+// (GET /teachers/{teacherId}/courses)
+func (_ Unimplemented) GetCoursesByTeacher(w http.ResponseWriter, r *http.Request, teacherId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DeleteBankQuestion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBankQuestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "questionId" -------------
+	var questionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionId", chi.URLParam(r, "questionId"), &questionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "questionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBankQuestion(w, r, questionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBankQuestion operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBankQuestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "questionId" -------------
+	var questionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionId", chi.URLParam(r, "questionId"), &questionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "questionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBankQuestion(w, r, questionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourses operation middleware
+func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCoursesParams
+
+	// ------------- Optional query parameter "domain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "domain", r.URL.Query(), &params.Domain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "teacherId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teacherId", r.URL.Query(), &params.TeacherId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "teacherId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minRating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minRating", r.URL.Query(), &params.MinRating)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minRating", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxRating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxRating", r.URL.Query(), &params.MaxRating)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxRating", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minDuration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minDuration", r.URL.Query(), &params.MinDuration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minDuration", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxDuration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxDuration", r.URL.Query(), &params.MaxDuration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxDuration", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourses(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCourse operation middleware
+func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCourse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportCourse operation middleware
+func (siw *ServerInterfaceWrapper) ImportCourse(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCourse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchCourses operation middleware
+func (siw *ServerInterfaceWrapper) SearchCourses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchCoursesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchCourses(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCourse operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCourse(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseById operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseById(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCourse operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCourse(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneCourse operation middleware
+func (siw *ServerInterfaceWrapper) CloneCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneCourse(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportCourse operation middleware
+func (siw *ServerInterfaceWrapper) ExportCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportCourse(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseModules operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseModules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseModules(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateModule operation middleware
+func (siw *ServerInterfaceWrapper) CreateModule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateModule(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReorderModules operation middleware
+func (siw *ServerInterfaceWrapper) ReorderModules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderModules(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseOutline operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseOutline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseOutline(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokePrerequisiteWaiver operation middleware
+func (siw *ServerInterfaceWrapper) RevokePrerequisiteWaiver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePrerequisiteWaiver(w, r, courseId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GrantPrerequisiteWaiver operation middleware
+func (siw *ServerInterfaceWrapper) GrantPrerequisiteWaiver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrantPrerequisiteWaiver(w, r, courseId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCoursePrerequisites operation middleware
+func (siw *ServerInterfaceWrapper) SetCoursePrerequisites(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCoursePrerequisites(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseReviews(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReview operation middleware
+func (siw *ServerInterfaceWrapper) PostReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReview(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeCourseStatus operation middleware
+func (siw *ServerInterfaceWrapper) ChangeCourseStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeCourseStatus(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishCourseVersion operation middleware
+func (siw *ServerInterfaceWrapper) PublishCourseVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishCourseVersion(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCourseVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseVersion(w, r, courseId, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteExercise operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExercise(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "exerciseId" -------------
+	var exerciseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exerciseId", chi.URLParam(r, "exerciseId"), &exerciseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exerciseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteExercise(w, r, exerciseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateExercise operation middleware
+func (siw *ServerInterfaceWrapper) UpdateExercise(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "exerciseId" -------------
+	var exerciseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exerciseId", chi.URLParam(r, "exerciseId"), &exerciseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exerciseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateExercise(w, r, exerciseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLesson operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLesson(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLessonById operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLessonById(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateLesson operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLesson(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetLessonBankQuestions operation middleware
+func (siw *ServerInterfaceWrapper) SetLessonBankQuestions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLessonBankQuestions(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLessonExercises operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonExercises(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLessonExercises(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateExercise operation middleware
+func (siw *ServerInterfaceWrapper) CreateExercise(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExercise(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReorderExercises operation middleware
+func (siw *ServerInterfaceWrapper) ReorderExercises(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderExercises(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetLessonGate operation middleware
+func (siw *ServerInterfaceWrapper) SetLessonGate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLessonGate(w, r, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyEnrollments operation middleware
+func (siw *ServerInterfaceWrapper) GetMyEnrollments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyEnrollments(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnrollInCourse operation middleware
+func (siw *ServerInterfaceWrapper) EnrollInCourse(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollInCourse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnenrollFromCourse operation middleware
+func (siw *ServerInterfaceWrapper) UnenrollFromCourse(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnenrollFromCourse(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnrollmentContent operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnrollmentContent(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLessonAttempts operation middleware
+func (siw *ServerInterfaceWrapper) GetLessonAttempts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLessonAttempts(w, r, courseId, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitLessonAnswers operation middleware
+func (siw *ServerInterfaceWrapper) SubmitLessonAnswers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitLessonAnswers(w, r, courseId, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompleteLesson operation middleware
+func (siw *ServerInterfaceWrapper) CompleteLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteLesson(w, r, courseId, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartQuizSession operation middleware
+func (siw *ServerInterfaceWrapper) StartQuizSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lessonId" -------------
+	var lessonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lessonId", chi.URLParam(r, "lessonId"), &lessonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lessonId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartQuizSession(w, r, courseId, lessonId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyCourseOutline operation middleware
+func (siw *ServerInterfaceWrapper) GetMyCourseOutline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyCourseOutline(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuizSession operation middleware
+func (siw *ServerInterfaceWrapper) GetQuizSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuizSession(w, r, courseId, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitQuizSession operation middleware
+func (siw *ServerInterfaceWrapper) SubmitQuizSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitQuizSession(w, r, courseId, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpgradeEnrollmentContent operation middleware
+func (siw *ServerInterfaceWrapper) UpgradeEnrollmentContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "courseId" -------------
+	var courseId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpgradeEnrollmentContent(w, r, courseId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteModule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteModule(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModuleById operation middleware
+func (siw *ServerInterfaceWrapper) GetModuleById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModuleById(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateModule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateModule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateModule(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModuleLessons operation middleware
+func (siw *ServerInterfaceWrapper) GetModuleLessons(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModuleLessons(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLesson operation middleware
+func (siw *ServerInterfaceWrapper) CreateLesson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
 
-// Get course details
-// (GET /courses/{courseId})
-func (_ Unimplemented) GetCourseById(w http.ResponseWriter, r *http.Request, courseId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Update a course
-// (PUT /courses/{courseId})
-func (_ Unimplemented) UpdateCourse(w http.ResponseWriter, r *http.Request, courseId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	r = r.WithContext(ctx)
 
-// Get courses by teacher
-// (GET /teachers/{teacherId}/courses)
-func (_ Unimplemented) GetCoursesByTeacher(w http.ResponseWriter, r *http.Request, teacherId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLesson(w, r, moduleId)
+	}))
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler.ServeHTTP(w, r)
+}
 
-// GetCourses operation middleware
-func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Request) {
+// ReorderLessons operation middleware
+func (siw *ServerInterfaceWrapper) ReorderLessons(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCoursesParams
-
-	// ------------- Optional query parameter "domain" -------------
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
 
-	err = runtime.BindQueryParameter("form", true, false, "domain", r.URL.Query(), &params.Domain)
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "level" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
-		return
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderLessons(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "tag" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+// SetModuleRelease operation middleware
+func (siw *ServerInterfaceWrapper) SetModuleRelease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "moduleId" -------------
+	var moduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "moduleId", chi.URLParam(r, "moduleId"), &moduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "moduleId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetModuleRelease(w, r, moduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyQuestionBanks operation middleware
+func (siw *ServerInterfaceWrapper) GetMyQuestionBanks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCourses(w, r, params)
+		siw.Handler.GetMyQuestionBanks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -125,11 +2298,17 @@ func (siw *ServerInterfaceWrapper) GetCourses(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCourse operation middleware
-func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.Request) {
+// CreateQuestionBank operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuestionBank(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCourse(w, r)
+		siw.Handler.CreateQuestionBank(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -139,22 +2318,28 @@ func (siw *ServerInterfaceWrapper) CreateCourse(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCourse operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCourse(w http.ResponseWriter, r *http.Request) {
+// GetQuestionBank operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestionBank(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "courseId" -------------
-	var courseId string
+	// ------------- Path parameter "bankId" -------------
+	var bankId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "bankId", chi.URLParam(r, "bankId"), &bankId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bankId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCourse(w, r, courseId)
+		siw.Handler.GetQuestionBank(w, r, bankId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -164,22 +2349,47 @@ func (siw *ServerInterfaceWrapper) DeleteCourse(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetCourseById operation middleware
-func (siw *ServerInterfaceWrapper) GetCourseById(w http.ResponseWriter, r *http.Request) {
+// GetBankQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetBankQuestions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "courseId" -------------
-	var courseId string
+	// ------------- Path parameter "bankId" -------------
+	var bankId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "bankId", chi.URLParam(r, "bankId"), &bankId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bankId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBankQuestionsParams
+
+	// ------------- Optional query parameter "topic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topic", r.URL.Query(), &params.Topic)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topic", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "difficulty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "difficulty", r.URL.Query(), &params.Difficulty)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "difficulty", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCourseById(w, r, courseId)
+		siw.Handler.GetBankQuestions(w, r, bankId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -189,22 +2399,59 @@ func (siw *ServerInterfaceWrapper) GetCourseById(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCourse operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCourse(w http.ResponseWriter, r *http.Request) {
+// CreateBankQuestion operation middleware
+func (siw *ServerInterfaceWrapper) CreateBankQuestion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "courseId" -------------
-	var courseId string
+	// ------------- Path parameter "bankId" -------------
+	var bankId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "courseId", chi.URLParam(r, "courseId"), &courseId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "bankId", chi.URLParam(r, "bankId"), &bankId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "courseId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bankId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBankQuestion(w, r, bankId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditReview operation middleware
+func (siw *ServerInterfaceWrapper) EditReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "reviewId" -------------
+	var reviewId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reviewId", chi.URLParam(r, "reviewId"), &reviewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCourse(w, r, courseId)
+		siw.Handler.EditReview(w, r, reviewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -352,12 +2599,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/bank-questions/{questionId}", wrapper.DeleteBankQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/bank-questions/{questionId}", wrapper.UpdateBankQuestion)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/courses", wrapper.GetCourses)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/courses", wrapper.CreateCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/import", wrapper.ImportCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/search", wrapper.SearchCourses)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/courses/{courseId}", wrapper.DeleteCourse)
 	})
@@ -367,6 +2626,153 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/courses/{courseId}", wrapper.UpdateCourse)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/clone", wrapper.CloneCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/export", wrapper.ExportCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/modules", wrapper.GetCourseModules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/modules", wrapper.CreateModule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/modules/order", wrapper.ReorderModules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/outline", wrapper.GetCourseOutline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/courses/{courseId}/prerequisite-waivers/{userId}", wrapper.RevokePrerequisiteWaiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/prerequisite-waivers/{userId}", wrapper.GrantPrerequisiteWaiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/prerequisites", wrapper.SetCoursePrerequisites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/reviews", wrapper.GetCourseReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/reviews", wrapper.PostReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/courses/{courseId}/status", wrapper.ChangeCourseStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/courses/{courseId}/versions", wrapper.PublishCourseVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{courseId}/versions/{version}", wrapper.GetCourseVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/exercises/{exerciseId}", wrapper.DeleteExercise)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/exercises/{exerciseId}", wrapper.UpdateExercise)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/lessons/{lessonId}", wrapper.DeleteLesson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lessons/{lessonId}", wrapper.GetLessonById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/lessons/{lessonId}", wrapper.UpdateLesson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/lessons/{lessonId}/bank-questions", wrapper.SetLessonBankQuestions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lessons/{lessonId}/exercises", wrapper.GetLessonExercises)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lessons/{lessonId}/exercises", wrapper.CreateExercise)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/lessons/{lessonId}/exercises/order", wrapper.ReorderExercises)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/lessons/{lessonId}/gate", wrapper.SetLessonGate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/enrollments", wrapper.GetMyEnrollments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments", wrapper.EnrollInCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/me/enrollments/{courseId}", wrapper.UnenrollFromCourse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/enrollments/{courseId}/content", wrapper.GetEnrollmentContent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/enrollments/{courseId}/lessons/{lessonId}/attempts", wrapper.GetLessonAttempts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments/{courseId}/lessons/{lessonId}/attempts", wrapper.SubmitLessonAnswers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments/{courseId}/lessons/{lessonId}/complete", wrapper.CompleteLesson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments/{courseId}/lessons/{lessonId}/quiz-sessions", wrapper.StartQuizSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/enrollments/{courseId}/outline", wrapper.GetMyCourseOutline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/enrollments/{courseId}/quiz-sessions/{sessionId}", wrapper.GetQuizSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments/{courseId}/quiz-sessions/{sessionId}/answers", wrapper.SubmitQuizSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/enrollments/{courseId}/upgrade", wrapper.UpgradeEnrollmentContent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/modules/{moduleId}", wrapper.DeleteModule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/modules/{moduleId}", wrapper.GetModuleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/modules/{moduleId}", wrapper.UpdateModule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/modules/{moduleId}/lessons", wrapper.GetModuleLessons)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/modules/{moduleId}/lessons", wrapper.CreateLesson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/modules/{moduleId}/lessons/order", wrapper.ReorderLessons)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/modules/{moduleId}/release", wrapper.SetModuleRelease)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/question-banks", wrapper.GetMyQuestionBanks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/question-banks", wrapper.CreateQuestionBank)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/question-banks/{bankId}", wrapper.GetQuestionBank)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/question-banks/{bankId}/questions", wrapper.GetBankQuestions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/question-banks/{bankId}/questions", wrapper.CreateBankQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/reviews/{reviewId}", wrapper.EditReview)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/teachers/{teacherId}/courses", wrapper.GetCoursesByTeacher)
 	})
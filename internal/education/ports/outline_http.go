@@ -0,0 +1,110 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/enrollment"
+)
+
+func (h HttpServer) GetCourseOutline(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := optionalActorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	outline, err := h.app.Queries.GetCourseOutline.Handle(r.Context(), course_query.GetCourseOutline{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapOutlineToResponse(outline, false))
+}
+
+func (h HttpServer) GetMyCourseOutline(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	outline, err := h.app.Queries.GetMyCourseOutline.Handle(r.Context(), query.GetMyCourseOutline{
+		UserID:   actor.UserID,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapOutlineToResponse(outline, true))
+}
+
+// Helper function to map a course outline to API CourseOutline response,
+// with the lock state of every lesson when it is read for an enrollment
+func mapOutlineToResponse(outline course_query.CourseOutline, enrolled bool) CourseOutline {
+	modules := make([]OutlineModule, 0, len(outline.Modules))
+	for _, m := range outline.Modules {
+		lessons := make([]OutlineLesson, 0, len(m.Lessons))
+		for _, l := range m.Lessons {
+			lesson := OutlineLesson{
+				Id:            l.ID,
+				Title:         l.Title,
+				Duration:      l.Duration,
+				Order:         l.Order,
+				HasVideo:      l.HasVideo,
+				ExerciseCount: l.ExerciseCount,
+			}
+			if l.Status != "" {
+				status := ProgressStatus(l.Status)
+				lesson.Status = &status
+			}
+			if enrolled {
+				locked := l.Lock != nil
+				lesson.Locked = &locked
+			}
+			if l.Lock != nil {
+				lesson.Lock = &LessonLock{
+					Reason: LessonLockReason(l.Lock.Reason().String()),
+				}
+				switch l.Lock.Reason() {
+				case enrollment.ModuleNotReleased:
+					unlockAt := l.Lock.UnlockAt()
+					lesson.Lock.UnlockAt = &unlockAt
+				case enrollment.ScoreTooLow:
+					lessonID := l.Lock.LessonID()
+					minScore := l.Lock.MinScore()
+					lesson.Lock.LessonId = &lessonID
+					lesson.Lock.MinScore = &minScore
+				default:
+					lessonID := l.Lock.LessonID()
+					lesson.Lock.LessonId = &lessonID
+				}
+			}
+			lessons = append(lessons, lesson)
+		}
+
+		modules = append(modules, OutlineModule{
+			Id:       m.ID,
+			Title:    m.Title,
+			Order:    m.Order,
+			Duration: m.Duration,
+			Release:  mapReleaseToResponse(m.Release),
+			UnlockAt: m.UnlockAt,
+			Lessons:  lessons,
+		})
+	}
+
+	return CourseOutline{
+		Course:  mapCourseToResponse(outline.Course),
+		Modules: modules,
+	}
+}
@@ -0,0 +1,96 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/course"
+)
+
+func (h HttpServer) SetCoursePrerequisites(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req SetCoursePrerequisitesRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	prerequisites := make([]course_command.CoursePrerequisite, 0, len(req.Prerequisites))
+	for _, p := range req.Prerequisites {
+		prerequisites = append(prerequisites, course_command.CoursePrerequisite{
+			CourseID: p.CourseId,
+			Required: p.Required,
+		})
+	}
+
+	err = h.app.Commands.SetCoursePrerequisites.Handle(r.Context(), course_command.SetCoursePrerequisites{
+		Actor:         actor,
+		CourseID:      courseId,
+		Prerequisites: prerequisites,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) GrantPrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.GrantPrerequisiteWaiver.Handle(r.Context(), course_command.GrantPrerequisiteWaiver{
+		Actor:    actor,
+		CourseID: courseId,
+		UserID:   userId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) RevokePrerequisiteWaiver(w http.ResponseWriter, r *http.Request, courseId string, userId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RevokePrerequisiteWaiver.Handle(r.Context(), course_command.RevokePrerequisiteWaiver{
+		Actor:    actor,
+		CourseID: courseId,
+		UserID:   userId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map the prerequisites of a domain Course to the API response
+func mapPrerequisitesToResponse(prerequisites []course.Prerequisite) []CoursePrerequisite {
+	response := make([]CoursePrerequisite, 0, len(prerequisites))
+	for _, p := range prerequisites {
+		response = append(response, CoursePrerequisite{
+			CourseId: p.CourseID(),
+			Required: p.Required(),
+		})
+	}
+	return response
+}
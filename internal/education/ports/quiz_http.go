@@ -0,0 +1,160 @@
+package ports
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/auth"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/quiz"
+	"github.com/pkg/errors"
+)
+
+func (h HttpServer) StartQuizSession(w http.ResponseWriter, r *http.Request, courseId string, lessonId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	cmd := command.StartQuizSession{
+		Actor:     actor,
+		SessionID: uuid.New().String(),
+		UserID:    actor.UserID,
+		CourseID:  courseId,
+		LessonID:  lessonId,
+	}
+
+	if err := h.app.Commands.StartQuizSession.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	session, err := h.app.Queries.GetQuizSession.Handle(r.Context(), query.GetQuizSession{
+		UserID:    actor.UserID,
+		CourseID:  courseId,
+		SessionID: cmd.SessionID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/me/enrollments/"+courseId+"/quiz-sessions/"+cmd.SessionID)
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapQuizSessionToResponse(session))
+}
+
+func (h HttpServer) GetQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	session, err := h.app.Queries.GetQuizSession.Handle(r.Context(), query.GetQuizSession{
+		UserID:    user.UUID,
+		CourseID:  courseId,
+		SessionID: sessionId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapQuizSessionToResponse(session))
+}
+
+func (h HttpServer) SubmitQuizSession(w http.ResponseWriter, r *http.Request, courseId string, sessionId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req SubmitAnswersRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	responses := make(map[string]quiz.Response, len(req.Answers))
+	for _, a := range req.Answers {
+		if _, ok := responses[a.ExerciseId]; ok {
+			httperr.BadRequest("duplicate-answer", errors.Errorf("exercise '%s' answered more than once", a.ExerciseId), w, r)
+			return
+		}
+		switch {
+		case a.Choices != nil:
+			responses[a.ExerciseId] = quiz.Response{Choices: *a.Choices}
+		case a.Answers != nil:
+			responses[a.ExerciseId] = quiz.Response{Values: *a.Answers}
+		case a.Answer != nil:
+			responses[a.ExerciseId] = quiz.Response{Values: []string{*a.Answer}}
+		default:
+			responses[a.ExerciseId] = quiz.Response{}
+		}
+	}
+
+	cmd := command.SubmitQuizSession{
+		Actor:     actor,
+		SessionID: sessionId,
+		AttemptID: uuid.New().String(),
+		UserID:    actor.UserID,
+		CourseID:  courseId,
+		Responses: responses,
+	}
+
+	if err := h.app.Commands.SubmitQuizSession.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	a, err := h.app.Queries.GetAttempt.Handle(r.Context(), query.GetAttempt{AttemptID: cmd.AttemptID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/me/enrollments/"+courseId+"/lessons/"+a.LessonID()+"/attempts")
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapAttemptToResponse(a))
+}
+
+// Helper function to map a quiz session to the API response.
+// The seed of the session and the correct answers are never exposed.
+func mapQuizSessionToResponse(qs query.QuizSession) QuizSession {
+	questions := make([]QuizQuestion, 0, len(qs.Questions))
+	for _, q := range qs.Questions {
+		e := q.Exercise()
+		questions = append(questions, QuizQuestion{
+			ExerciseId: e.ID(),
+			Question:   e.Question(),
+			Kind:       ExerciseKind(e.Kind().String()),
+			Options:    q.Options(),
+			Points:     e.Points(),
+		})
+	}
+
+	response := QuizSession{
+		Id:        qs.Session.ID(),
+		LessonId:  qs.Session.LessonID(),
+		StartedAt: qs.Session.StartedAt(),
+		Questions: questions,
+	}
+	if qs.Session.IsTimed() {
+		deadline := qs.Session.Deadline()
+		remainingSeconds := int(qs.Session.Remaining(time.Now()).Seconds())
+		response.Deadline = &deadline
+		response.RemainingSeconds = &remainingSeconds
+	}
+	if attemptID := qs.Session.AttemptID(); attemptID != "" {
+		response.AttemptId = &attemptID
+	}
+
+	return response
+}
@@ -0,0 +1,113 @@
+package ports
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/review"
+)
+
+func (h HttpServer) GetCourseReviews(w http.ResponseWriter, r *http.Request, courseId string) {
+	reviews, err := h.app.Queries.GetCourseReviews.Handle(r.Context(), query.GetCourseReviews{
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response := make([]Review, 0, len(reviews))
+	for _, rv := range reviews {
+		response = append(response, mapReviewToResponse(rv))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) PostReview(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ReviewRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	cmd := command.PostReview{
+		Actor:    actor,
+		ReviewID: uuid.New().String(),
+		UserID:   actor.UserID,
+		CourseID: courseId,
+		Rating:   req.Rating,
+		Text:     getStringValue(req.Text),
+	}
+
+	if err := h.app.Commands.PostReview.Handle(r.Context(), cmd); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	rv, err := h.app.Queries.GetReview.Handle(r.Context(), query.GetReview{ReviewID: cmd.ReviewID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/courses/"+courseId+"/reviews")
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapReviewToResponse(rv))
+}
+
+func (h HttpServer) EditReview(w http.ResponseWriter, r *http.Request, reviewId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req ReviewRequest
+	if err := render.Decode(r, &req); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	err = h.app.Commands.EditReview.Handle(r.Context(), command.EditReview{
+		Actor:    actor,
+		ReviewID: reviewId,
+		Rating:   req.Rating,
+		Text:     getStringValue(req.Text),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	rv, err := h.app.Queries.GetReview.Handle(r.Context(), query.GetReview{ReviewID: reviewId})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapReviewToResponse(rv))
+}
+
+// Helper function to map domain Review to API Review response
+func mapReviewToResponse(rv *review.Review) Review {
+	return Review{
+		Id:        rv.ID(),
+		CourseId:  rv.CourseID(),
+		UserId:    rv.UserID(),
+		Rating:    rv.Rating(),
+		Text:      rv.Text(),
+		CreatedAt: rv.CreatedAt(),
+		UpdatedAt: rv.UpdatedAt(),
+	}
+}
@@ -0,0 +1,138 @@
+package ports
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+	"github.com/maixuanbach174/online-course-app/internal/common/server/httperr"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/domain/version"
+	"github.com/pkg/errors"
+)
+
+func (h HttpServer) PublishCourseVersion(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.PublishCourseVersion.Handle(r.Context(), course_command.PublishCourseVersion{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	v, err := h.app.Queries.GetCourseVersion.Handle(r.Context(), course_query.GetCourseVersion{
+		Actor:    actor,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Location", "/courses/"+courseId+"/versions/"+strconv.Itoa(v.Number()))
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, mapVersionToResponse(v))
+}
+
+func (h HttpServer) GetCourseVersion(w http.ResponseWriter, r *http.Request, courseId string, versionNumber int) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if versionNumber < 1 {
+		httperr.BadRequest("invalid-version", errors.New("version must be at least 1"), w, r)
+		return
+	}
+
+	v, err := h.app.Queries.GetCourseVersion.Handle(r.Context(), course_query.GetCourseVersion{
+		Actor:    actor,
+		CourseID: courseId,
+		Version:  versionNumber,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapVersionToResponse(v))
+}
+
+func (h HttpServer) GetEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	v, err := h.app.Queries.GetEnrollmentContent.Handle(r.Context(), query.GetEnrollmentContent{
+		UserID:   actor.UserID,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, mapVersionToResponse(v))
+}
+
+func (h HttpServer) UpgradeEnrollmentContent(w http.ResponseWriter, r *http.Request, courseId string) {
+	actor, err := actorFromRequest(r)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpgradeEnrollmentContent.Handle(r.Context(), command.UpgradeEnrollmentContent{
+		Actor:    actor,
+		UserID:   actor.UserID,
+		CourseID: courseId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to map domain Version to API CourseVersion response
+func mapVersionToResponse(v *version.Version) CourseVersion {
+	modules := make([]CourseVersionModule, 0, len(v.Modules()))
+	for _, m := range v.Modules() {
+		lessons := make([]CourseVersionLesson, 0, len(m.Lessons()))
+		for _, l := range m.Lessons() {
+			exercises := make([]Exercise, 0, len(l.Exercises()))
+			for _, e := range l.Exercises() {
+				exercises = append(exercises, mapExerciseToResponse(e))
+			}
+			lessons = append(lessons, CourseVersionLesson{
+				Lesson:    mapLessonToResponse(l.Lesson()),
+				Exercises: exercises,
+			})
+		}
+		modules = append(modules, CourseVersionModule{
+			Module:  mapModuleToResponse(m.Module()),
+			Lessons: lessons,
+		})
+	}
+
+	return CourseVersion{
+		CourseId:    v.CourseID(),
+		Version:     v.Number(),
+		PublishedAt: v.PublishedAt(),
+		Modules:     modules,
+	}
+}
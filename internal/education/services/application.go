@@ -9,8 +9,18 @@ import (
 	"github.com/maixuanbach174/online-course-app/internal/education/adapters/postgresql"
 	"github.com/maixuanbach174/online-course-app/internal/education/app"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/bank_command"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/command/course_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/exercise_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/lesson_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/command/module_command"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/policy"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/bank_query"
 	"github.com/maixuanbach174/online-course-app/internal/education/app/query/course_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/exercise_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/lesson_query"
+	"github.com/maixuanbach174/online-course-app/internal/education/app/query/module_query"
 	"github.com/sirupsen/logrus"
 )
 
@@ -51,6 +61,18 @@ func NewApplication(ctx context.Context) (*ApplicationContainer, error) {
 	// Create repositories using the shared pool
 	userRepository := postgresql.NewUserRepository(pool)
 	courseRepository := postgresql.NewCourseRepository(pool)
+	moduleRepository := postgresql.NewModuleRepository(pool)
+	lessonRepository := postgresql.NewLessonRepository(pool)
+	exerciseRepository := postgresql.NewExerciseRepository(pool)
+	enrollmentRepository := postgresql.NewEnrollmentRepository(pool)
+	attemptRepository := postgresql.NewAttemptRepository(pool)
+	quizSessionRepository := postgresql.NewQuizSessionRepository(pool)
+	reviewRepository := postgresql.NewReviewRepository(pool)
+	versionRepository := postgresql.NewVersionRepository(pool)
+	bundleRepository := postgresql.NewBundleRepository(pool)
+	bankRepository := postgresql.NewBankRepository(pool)
+
+	courseAuthorizer := policy.NewCourseAuthorizer(courseRepository, moduleRepository, lessonRepository, exerciseRepository)
 
 	application := app.Application{
 		Commands: app.Commands{
@@ -58,11 +80,86 @@ func NewApplication(ctx context.Context) (*ApplicationContainer, error) {
 			CreateCourse: course_command.NewCreateCourseHandler(courseRepository, logger, metricsClient),
 			DeleteCourse: course_command.NewDeleteCourseHandler(courseRepository, logger, metricsClient),
 			UpdateCourse: course_command.NewUpdateCourseHandler(courseRepository, logger, metricsClient),
+
+			ChangeCourseStatus:   course_command.NewChangeCourseStatusHandler(courseRepository, logger, metricsClient),
+			PublishCourseVersion: course_command.NewPublishCourseVersionHandler(courseRepository, logger, metricsClient),
+
+			ImportCourse: course_command.NewImportCourseHandler(bundleRepository, logger, metricsClient),
+			CloneCourse:  course_command.NewCloneCourseHandler(bundleRepository, logger, metricsClient),
+
+			SetCoursePrerequisites:   course_command.NewSetCoursePrerequisitesHandler(courseRepository, logger, metricsClient),
+			GrantPrerequisiteWaiver:  course_command.NewGrantPrerequisiteWaiverHandler(courseRepository, courseRepository, userRepository, logger, metricsClient),
+			RevokePrerequisiteWaiver: course_command.NewRevokePrerequisiteWaiverHandler(courseRepository, courseRepository, logger, metricsClient),
+
+			CreateModule:     module_command.NewCreateModuleHandler(moduleRepository, courseRepository, courseAuthorizer, logger, metricsClient),
+			UpdateModule:     module_command.NewUpdateModuleHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+			DeleteModule:     module_command.NewDeleteModuleHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+			ReorderModules:   module_command.NewReorderModulesHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+			SetModuleRelease: module_command.NewSetModuleReleaseHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+
+			CreateLesson:           lesson_command.NewCreateLessonHandler(lessonRepository, moduleRepository, courseAuthorizer, logger, metricsClient),
+			UpdateLesson:           lesson_command.NewUpdateLessonHandler(lessonRepository, courseAuthorizer, logger, metricsClient),
+			DeleteLesson:           lesson_command.NewDeleteLessonHandler(lessonRepository, courseAuthorizer, logger, metricsClient),
+			ReorderLessons:         lesson_command.NewReorderLessonsHandler(lessonRepository, courseAuthorizer, logger, metricsClient),
+			SetLessonGate:          lesson_command.NewSetLessonGateHandler(lessonRepository, moduleRepository, courseAuthorizer, logger, metricsClient),
+			SetLessonBankQuestions: lesson_command.NewSetLessonBankQuestionsHandler(lessonRepository, bankRepository, courseAuthorizer, logger, metricsClient),
+
+			CreateExercise:   exercise_command.NewCreateExerciseHandler(exerciseRepository, lessonRepository, courseAuthorizer, logger, metricsClient),
+			UpdateExercise:   exercise_command.NewUpdateExerciseHandler(exerciseRepository, courseAuthorizer, logger, metricsClient),
+			DeleteExercise:   exercise_command.NewDeleteExerciseHandler(exerciseRepository, courseAuthorizer, logger, metricsClient),
+			ReorderExercises: exercise_command.NewReorderExercisesHandler(exerciseRepository, courseAuthorizer, logger, metricsClient),
+
+			CreateQuestionBank: bank_command.NewCreateQuestionBankHandler(bankRepository, logger, metricsClient),
+			CreateBankQuestion: bank_command.NewCreateBankQuestionHandler(bankRepository, logger, metricsClient),
+			UpdateBankQuestion: bank_command.NewUpdateBankQuestionHandler(bankRepository, logger, metricsClient),
+			DeleteBankQuestion: bank_command.NewDeleteBankQuestionHandler(bankRepository, logger, metricsClient),
+
+			EnrollInCourse:     command.NewEnrollInCourseHandler(enrollmentRepository, userRepository, courseRepository, courseRepository, versionRepository, logger, metricsClient),
+			UnenrollFromCourse: command.NewUnenrollFromCourseHandler(enrollmentRepository, logger, metricsClient),
+			CompleteLesson:     command.NewCompleteLessonHandler(enrollmentRepository, versionRepository, config.ProgressWeightByDuration, logger, metricsClient),
+
+			UpgradeEnrollmentContent: command.NewUpgradeEnrollmentContentHandler(enrollmentRepository, versionRepository, config.ProgressWeightByDuration, logger, metricsClient),
+
+			SubmitLessonAnswers: command.NewSubmitLessonAnswersHandler(enrollmentRepository, attemptRepository, versionRepository, config.ProgressWeightByDuration, logger, metricsClient),
+
+			StartQuizSession:  command.NewStartQuizSessionHandler(enrollmentRepository, quizSessionRepository, versionRepository, config.ProgressWeightByDuration, logger, metricsClient),
+			SubmitQuizSession: command.NewSubmitQuizSessionHandler(enrollmentRepository, quizSessionRepository, versionRepository, config.ProgressWeightByDuration, logger, metricsClient),
+
+			PostReview: command.NewPostReviewHandler(enrollmentRepository, reviewRepository, logger, metricsClient),
+			EditReview: command.NewEditReviewHandler(reviewRepository, logger, metricsClient),
 		},
 		Queries: app.Queries{
 			GetAllCourses:    course_query.NewGetAllCoursesHandler(courseRepository, logger, metricsClient),
 			GetCourseDetails: course_query.NewGetCourseDetailsHandler(courseRepository, logger, metricsClient),
 			CoursesByTeacher: course_query.NewCourseByTeacherHandler(courseRepository, logger, metricsClient),
+			SearchCourses:    course_query.NewSearchCoursesHandler(courseRepository, logger, metricsClient),
+			GetCourseVersion: course_query.NewGetCourseVersionHandler(courseRepository, versionRepository, logger, metricsClient),
+			ExportCourse:     course_query.NewExportCourseHandler(bundleRepository, logger, metricsClient),
+			GetCourseOutline: course_query.NewGetCourseOutlineHandler(courseRepository, courseRepository, logger, metricsClient),
+
+			GetModule:       module_query.NewGetModuleHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+			ModulesByCourse: module_query.NewModulesByCourseHandler(moduleRepository, courseAuthorizer, logger, metricsClient),
+
+			GetLesson:       lesson_query.NewGetLessonHandler(lessonRepository, courseAuthorizer, logger, metricsClient),
+			LessonsByModule: lesson_query.NewLessonsByModuleHandler(lessonRepository, courseAuthorizer, logger, metricsClient),
+
+			ExercisesByLesson: exercise_query.NewExercisesByLessonHandler(exerciseRepository, courseAuthorizer, logger, metricsClient),
+
+			BanksByTeacher:  bank_query.NewBanksByTeacherHandler(bankRepository, logger, metricsClient),
+			GetQuestionBank: bank_query.NewGetQuestionBankHandler(bankRepository, logger, metricsClient),
+			BankQuestions:   bank_query.NewBankQuestionsHandler(bankRepository, logger, metricsClient),
+
+			GetMyEnrollments:     query.NewGetMyEnrollmentsHandler(enrollmentRepository, logger, metricsClient),
+			GetEnrollmentContent: query.NewGetEnrollmentContentHandler(enrollmentRepository, versionRepository, logger, metricsClient),
+			GetMyCourseOutline:   query.NewGetMyCourseOutlineHandler(enrollmentRepository, courseRepository, courseRepository, logger, metricsClient),
+
+			GetAttempt:        query.NewGetAttemptHandler(attemptRepository, logger, metricsClient),
+			GetLessonAttempts: query.NewGetLessonAttemptsHandler(enrollmentRepository, attemptRepository, logger, metricsClient),
+
+			GetQuizSession: query.NewGetQuizSessionHandler(enrollmentRepository, quizSessionRepository, versionRepository, logger, metricsClient),
+
+			GetReview:        query.NewGetReviewHandler(reviewRepository, logger, metricsClient),
+			GetCourseReviews: query.NewGetCourseReviewsHandler(courseRepository, reviewRepository, logger, metricsClient),
 		},
 	}
 
@@ -14,6 +14,9 @@ type Config struct {
 	PostgresHost     string
 	PostgresPort     string
 	PostgresName     string
+
+	// ProgressWeightByDuration weights lessons by duration when computing progress
+	ProgressWeightByDuration bool
 }
 
 func LoadConfig() *Config {
@@ -28,6 +31,8 @@ func LoadConfig() *Config {
 		PostgresHost:     os.Getenv("POSTGRES_HOST"),
 		PostgresPort:     os.Getenv("POSTGRES_PORT"),
 		PostgresName:     os.Getenv("POSTGRES_DB"),
+
+		ProgressWeightByDuration: os.Getenv("PROGRESS_WEIGHT_BY_DURATION") == "true",
 	}
 }
 